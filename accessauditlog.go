@@ -0,0 +1,77 @@
+package cloudflare
+
+import (
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// AccessAuditLogRecord is a login event for an Access application.
+type AccessAuditLogRecord struct {
+	UserEmail  string    `json:"user_email"`
+	IPAddress  string    `json:"ip_address"`
+	AppUID     string    `json:"app_uid"`
+	AppDomain  string    `json:"app_domain"`
+	Action     string    `json:"action"`
+	Connection string    `json:"connection"`
+	Allowed    bool      `json:"allowed"`
+	CreatedAt  time.Time `json:"created_at"`
+	RayID      string    `json:"ray_id"`
+	Country    string    `json:"country,omitempty"`
+}
+
+// AccessAuditLogFilterOptions filters Access audit logs. Zero values are not
+// used as filters.
+type AccessAuditLogFilterOptions struct {
+	Since     time.Time
+	Until     time.Time
+	Email     string
+	AppUID    string
+	Direction string
+	// Allowed filters by whether access was granted.
+	Allowed *bool
+	// PerPage is the number of records requested at a time.
+	PerPage int
+}
+
+func (o AccessAuditLogFilterOptions) encode() url.Values {
+	v := url.Values{}
+	if !o.Since.IsZero() {
+		v.Set("since", o.Since.UTC().Format(time.RFC3339))
+	}
+	if !o.Until.IsZero() {
+		v.Set("until", o.Until.UTC().Format(time.RFC3339))
+	}
+	if o.Email != "" {
+		v.Set("email", o.Email)
+	}
+	if o.AppUID != "" {
+		v.Set("app_uid", o.AppUID)
+	}
+	if o.Direction != "" {
+		v.Set("direction", o.Direction)
+	}
+	if o.Allowed != nil {
+		if *o.Allowed {
+			v.Set("allowedOp", "eq")
+		} else {
+			v.Set("allowedOp", "neq")
+		}
+	}
+	if o.PerPage > 0 {
+		v.Set("per_page", strconv.Itoa(o.PerPage))
+	}
+	return v
+}
+
+// AccessAuditLogs returns the Access login events of an account matching the
+// filters, across all pages.
+//
+// API reference: https://api.cloudflare.com/#access-requests-access-requests-audit
+func (api *API) AccessAuditLogs(accountID string, opts AccessAuditLogFilterOptions) ([]AccessAuditLogRecord, error) {
+	var records []AccessAuditLogRecord
+	if err := api.paginateInto("/accounts/"+accountID+"/access/logs/access_requests", opts.encode(), &records); err != nil {
+		return nil, err
+	}
+	return records, nil
+}
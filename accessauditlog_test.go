@@ -0,0 +1,55 @@
+package cloudflare
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccessAuditLogs(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/accounts/acc/access/logs/access_requests", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		q := r.URL.Query()
+		assert.Equal(t, "2024-01-01T00:00:00Z", q.Get("since"))
+		assert.Equal(t, "user@example.com", q.Get("email"))
+		assert.Equal(t, "neq", q.Get("allowedOp"))
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": [
+				{
+					"user_email": "user@example.com",
+					"ip_address": "198.51.100.1",
+					"app_uid": "app%s",
+					"app_domain": "app.example.com",
+					"action": "login",
+					"allowed": false,
+					"created_at": "2024-01-02T03:04:05Z",
+					"ray_id": "ray"
+				}
+			],
+			"result_info": {"page": %s, "per_page": 1, "total_count": 2}
+		}`, q.Get("page"), q.Get("page"))
+	})
+
+	denied := false
+	records, err := client.AccessAuditLogs("acc", AccessAuditLogFilterOptions{
+		Since:   time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		Email:   "user@example.com",
+		Allowed: &denied,
+	})
+	if assert.NoError(t, err) && assert.Len(t, records, 2) {
+		assert.Equal(t, "app1", records[0].AppUID)
+		assert.Equal(t, "app2", records[1].AppUID)
+		assert.False(t, records[0].Allowed)
+		assert.Equal(t, time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC), records[0].CreatedAt)
+	}
+}
@@ -0,0 +1,143 @@
+package cloudflare
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// UsingAccount sets the client's AccountID, the account used by Account when
+// it is given no ID, for programs which work within a single account.
+func UsingAccount(accountID string) Option {
+	return func(api *API) error {
+		if accountID == "" {
+			return errors.New("account ID must not be empty")
+		}
+		api.AccountID = accountID
+		return nil
+	}
+}
+
+// AccountClient makes calls to the endpoints of one account, those under
+// /accounts/:account_identifier, without the account ID being passed to every
+// call. Its methods are equivalent to those of API taking an accountID
+// argument. Create one with API.Account.
+type AccountClient struct {
+	api *API
+	// ID is the account's identifier.
+	ID string
+}
+
+// Account returns a client for the endpoints of an account. If accountID is
+// empty the client's AccountID is used (see UsingAccount); calls fail if
+// neither is set.
+func (api *API) Account(accountID string) *AccountClient {
+	if accountID == "" {
+		accountID = api.AccountID
+	}
+	return &AccountClient{api: api, ID: accountID}
+}
+
+// path returns the path of an endpoint of the account, relative to
+// /accounts/:account_identifier.
+func (a *AccountClient) path(path string) (string, error) {
+	if a.ID == "" {
+		return "", errors.New("no account ID: pass one to Account or set it with UsingAccount")
+	}
+	return "/accounts/" + a.ID + path, nil
+}
+
+// check returns an error if the client has no account ID.
+func (a *AccountClient) check() error {
+	_, err := a.path("")
+	return err
+}
+
+// Raw makes a request to an endpoint of the account which this package does
+// not yet support, as API.Raw does. path is relative to
+// /accounts/:account_identifier, e.g. "/rulesets".
+func (a *AccountClient) Raw(ctx context.Context, method, path string, body interface{}, header http.Header) ([]byte, error) {
+	if !strings.HasPrefix(path, "/") {
+		return nil, errors.Errorf("path %q must start with /", path)
+	}
+	uri, err := a.path(path)
+	if err != nil {
+		return nil, err
+	}
+	return a.api.Raw(ctx, method, uri, body, header)
+}
+
+// Devices lists the registered devices of the account.
+func (a *AccountClient) Devices() ([]Device, error) {
+	if err := a.check(); err != nil {
+		return nil, err
+	}
+	return a.api.Devices(a.ID)
+}
+
+// DLPProfiles lists the DLP profiles of the account.
+func (a *AccountClient) DLPProfiles() ([]DLPProfile, error) {
+	if err := a.check(); err != nil {
+		return nil, err
+	}
+	return a.api.DLPProfiles(a.ID)
+}
+
+// AddressMaps lists the address maps of the account.
+func (a *AccountClient) AddressMaps() ([]AddressMap, error) {
+	if err := a.check(); err != nil {
+		return nil, err
+	}
+	return a.api.AddressMaps(a.ID)
+}
+
+// RegistrarDomains lists the domains of the account in Cloudflare Registrar.
+func (a *AccountClient) RegistrarDomains() ([]RegistrarDomain, error) {
+	if err := a.check(); err != nil {
+		return nil, err
+	}
+	return a.api.RegistrarDomains(a.ID)
+}
+
+// Subscriptions lists the subscriptions of the account.
+func (a *AccountClient) Subscriptions() ([]Subscription, error) {
+	if err := a.check(); err != nil {
+		return nil, err
+	}
+	return a.api.AccountSubscriptions(a.ID)
+}
+
+// BillingProfile returns the billing profile of the account.
+func (a *AccountClient) BillingProfile() (BillingProfile, error) {
+	if err := a.check(); err != nil {
+		return BillingProfile{}, err
+	}
+	return a.api.AccountBillingProfile(a.ID)
+}
+
+// WorkerScriptSettings returns the settings of a Workers script of the
+// account.
+func (a *AccountClient) WorkerScriptSettings(scriptName string) (WorkerScriptSettings, error) {
+	if err := a.check(); err != nil {
+		return WorkerScriptSettings{}, err
+	}
+	return a.api.WorkerScriptSettings(a.ID, scriptName)
+}
+
+// WorkerVersions lists the versions of a Workers script of the account.
+func (a *AccountClient) WorkerVersions(scriptName string) ([]WorkerVersion, error) {
+	if err := a.check(); err != nil {
+		return nil, err
+	}
+	return a.api.WorkerVersions(a.ID, scriptName)
+}
+
+// WorkerDeployments lists the deployments of a Workers script of the account.
+func (a *AccountClient) WorkerDeployments(scriptName string) ([]WorkerDeployment, error) {
+	if err := a.check(); err != nil {
+		return nil, err
+	}
+	return a.api.WorkerDeployments(a.ID, scriptName)
+}
@@ -0,0 +1,44 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccountClient(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/accounts/acc/devices", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": [{"id": "d1"}]}`)
+	})
+	mux.HandleFunc("/accounts/acc/rulesets", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": []}`)
+	})
+
+	_, err := client.Account("").Devices()
+	assert.EqualError(t, err, "no account ID: pass one to Account or set it with UsingAccount")
+
+	devices, err := client.Account("acc").Devices()
+	if assert.NoError(t, err) && assert.Len(t, devices, 1) {
+		assert.Equal(t, "d1", devices[0].ID)
+	}
+
+	assert.Error(t, UsingAccount("")(client))
+	assert.NoError(t, UsingAccount("acc")(client))
+	account := client.Account("")
+	assert.Equal(t, "acc", account.ID)
+	body, err := account.Raw(context.Background(), "GET", "/rulesets", nil, nil)
+	if assert.NoError(t, err) {
+		assert.Contains(t, string(body), `"result": []`)
+	}
+	_, err = account.Raw(context.Background(), "GET", "rulesets", nil, nil)
+	assert.Error(t, err)
+}
@@ -0,0 +1,101 @@
+package cloudflare
+
+import (
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Account represents a Cloudflare account.
+type Account struct {
+	ID        string           `json:"id"`
+	Name      string           `json:"name"`
+	Settings  *AccountSettings `json:"settings,omitempty"`
+	CreatedOn time.Time        `json:"created_on,omitempty"`
+}
+
+// AccountSettings are the settings of an account.
+type AccountSettings struct {
+	EnforceTwoFactor bool `json:"enforce_twofactor"`
+}
+
+// AccountNotFoundError is returned by AccountIDByName when no account has the
+// given name.
+type AccountNotFoundError struct {
+	Name string
+}
+
+func (e *AccountNotFoundError) Error() string {
+	return "account " + strconv.Quote(e.Name) + " could not be found"
+}
+
+// AmbiguousAccountError is returned by AccountIDByName when more than one
+// account has the given name.
+type AmbiguousAccountError struct {
+	Name string
+	IDs  []string
+}
+
+func (e *AmbiguousAccountError) Error() string {
+	return "account name " + strconv.Quote(e.Name) + " is ambiguous, matching IDs " + strings.Join(e.IDs, ", ")
+}
+
+// Accounts lists the accounts the credentials have access to. If name is not
+// empty, only accounts with that name are returned.
+//
+// API reference: https://api.cloudflare.com/#accounts-list-accounts
+func (api *API) Accounts(name string) ([]Account, error) {
+	v := url.Values{}
+	if name != "" {
+		v.Set("name", name)
+	}
+	var accounts []Account
+	if err := api.paginateInto("/accounts", v, &accounts); err != nil {
+		return nil, err
+	}
+	return accounts, nil
+}
+
+// AccountIDByName retrieves an account's ID from its name. Successful lookups
+// are remembered for the lifetime of the API client.
+//
+// If no account has the name, an *AccountNotFoundError is returned. If more
+// than one account has the name, an *AmbiguousAccountError is returned.
+func (api *API) AccountIDByName(name string) (string, error) {
+	api.cache.mu.Lock()
+	id, ok := api.cache.accountIDs[name]
+	api.cache.mu.Unlock()
+	if ok {
+		return id, nil
+	}
+
+	accounts, err := api.Accounts(name)
+	if err != nil {
+		return "", errors.Wrap(err, "Accounts command failed")
+	}
+	var ids []string
+	for _, a := range accounts {
+		if a.Name == name {
+			ids = append(ids, a.ID)
+		}
+	}
+	switch len(ids) {
+	case 0:
+		return "", &AccountNotFoundError{Name: name}
+	case 1:
+	default:
+		return "", &AmbiguousAccountError{Name: name, IDs: ids}
+	}
+
+	api.cache.mu.Lock()
+	if api.cache.accountIDs == nil {
+		api.cache.accountIDs = make(map[string]string)
+	}
+	api.cache.accountIDs[name] = ids[0]
+	api.cache.mu.Unlock()
+
+	return ids[0], nil
+}
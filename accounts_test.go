@@ -0,0 +1,65 @@
+package cloudflare
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccountIDByName(t *testing.T) {
+	setup()
+	defer teardown()
+
+	calls := 0
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		calls++
+		w.Header().Set("content-type", "application/json")
+		switch r.URL.Query().Get("name") {
+		case "Demo Account":
+			fmt.Fprint(w, `{
+				"success": true,
+				"errors": [],
+				"messages": [],
+				"result": [
+					{"id": "01a7362d577a6c3019a474fd6f485823", "name": "Demo Account"},
+					{"id": "01a7362d577a6c3019a474fd6f485824", "name": "Demo Account 2"}
+				],
+				"result_info": {"page": 1, "per_page": 20, "count": 2, "total_count": 2}
+			}`)
+		case "Shared":
+			fmt.Fprint(w, `{
+				"success": true,
+				"errors": [],
+				"messages": [],
+				"result": [
+					{"id": "1", "name": "Shared"},
+					{"id": "2", "name": "Shared"}
+				],
+				"result_info": {"page": 1, "per_page": 20, "count": 2, "total_count": 2}
+			}`)
+		default:
+			fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": []}`)
+		}
+	}
+
+	mux.HandleFunc("/accounts", handler)
+
+	for i := 0; i < 2; i++ {
+		id, err := client.AccountIDByName("Demo Account")
+		if assert.NoError(t, err) {
+			assert.Equal(t, "01a7362d577a6c3019a474fd6f485823", id)
+		}
+	}
+	assert.Equal(t, 1, calls, "lookup should be cached")
+
+	_, err := client.AccountIDByName("Shared")
+	if assert.IsType(t, &AmbiguousAccountError{}, err) {
+		assert.Equal(t, []string{"1", "2"}, err.(*AmbiguousAccountError).IDs)
+	}
+
+	_, err = client.AccountIDByName("Missing")
+	assert.IsType(t, &AccountNotFoundError{}, err)
+}
@@ -0,0 +1,181 @@
+package cloudflare
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// AddressMap maps BYOIP addresses to the zones and accounts they serve.
+type AddressMap struct {
+	ID           string                 `json:"id,omitempty"`
+	Description  *string                `json:"description,omitempty"`
+	DefaultSNI   *string                `json:"default_sni,omitempty"`
+	Enabled      *bool                  `json:"enabled,omitempty"`
+	Deletable    *bool                  `json:"can_delete,omitempty"`
+	CanModifyIPs *bool                  `json:"can_modify_ips,omitempty"`
+	IPs          []AddressMapIP         `json:"ips,omitempty"`
+	Memberships  []AddressMapMembership `json:"memberships,omitempty"`
+	CreatedAt    *time.Time             `json:"created_at,omitempty"`
+	ModifiedAt   *time.Time             `json:"modified_at,omitempty"`
+}
+
+// AddressMapIP is an IP address of an address map.
+type AddressMapIP struct {
+	IP        string     `json:"ip"`
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+}
+
+// Address map membership kinds.
+const (
+	AddressMapMembershipZone    = "zone"
+	AddressMapMembershipAccount = "account"
+)
+
+// AddressMapMembership is a zone or account which an address map applies to.
+type AddressMapMembership struct {
+	Identifier string     `json:"identifier"`
+	Kind       string     `json:"kind"`
+	Deletable  *bool      `json:"can_delete,omitempty"`
+	CreatedAt  *time.Time `json:"created_at,omitempty"`
+}
+
+// AddressMaps lists the address maps of an account.
+//
+// API reference: https://developers.cloudflare.com/api/operations/ip-address-management-address-maps-list-address-maps
+func (api *API) AddressMaps(accountID string) ([]AddressMap, error) {
+	var maps []AddressMap
+	if _, err := api.makeRequestResult("GET", "/accounts/"+accountID+"/addressing/address_maps", nil, &maps); err != nil {
+		return nil, err
+	}
+	return maps, nil
+}
+
+// AddressMap returns an address map with its IPs and memberships.
+//
+// API reference: https://developers.cloudflare.com/api/operations/ip-address-management-address-maps-address-map-details
+func (api *API) AddressMap(accountID, addressMapID string) (AddressMap, error) {
+	var m AddressMap
+	if _, err := api.makeRequestResult("GET", "/accounts/"+accountID+"/addressing/address_maps/"+addressMapID, nil, &m); err != nil {
+		return AddressMap{}, err
+	}
+	return m, nil
+}
+
+// CreateAddressMap creates an address map, optionally with its initial IPs
+// and memberships.
+//
+// API reference: https://developers.cloudflare.com/api/operations/ip-address-management-address-maps-create-address-map
+func (api *API) CreateAddressMap(accountID string, addressMap AddressMap) (AddressMap, error) {
+	type membership struct {
+		Identifier string `json:"identifier"`
+		Kind       string `json:"kind"`
+	}
+	params := struct {
+		Description *string      `json:"description,omitempty"`
+		Enabled     *bool        `json:"enabled,omitempty"`
+		IPs         []string     `json:"ips,omitempty"`
+		Memberships []membership `json:"memberships,omitempty"`
+	}{
+		Description: addressMap.Description,
+		Enabled:     addressMap.Enabled,
+	}
+	for _, ip := range addressMap.IPs {
+		params.IPs = append(params.IPs, ip.IP)
+	}
+	for _, m := range addressMap.Memberships {
+		params.Memberships = append(params.Memberships, membership{Identifier: m.Identifier, Kind: m.Kind})
+	}
+
+	var result AddressMap
+	if _, err := api.makeRequestResult("POST", "/accounts/"+accountID+"/addressing/address_maps", params, &result); err != nil {
+		return AddressMap{}, err
+	}
+	return result, nil
+}
+
+// UpdateAddressMap changes the description, default SNI or enabled state of
+// an address map; fields left nil are unchanged.
+//
+// API reference: https://developers.cloudflare.com/api/operations/ip-address-management-address-maps-update-address-map
+func (api *API) UpdateAddressMap(accountID, addressMapID string, addressMap AddressMap) (AddressMap, error) {
+	params := struct {
+		Description *string `json:"description,omitempty"`
+		DefaultSNI  *string `json:"default_sni,omitempty"`
+		Enabled     *bool   `json:"enabled,omitempty"`
+	}{
+		Description: addressMap.Description,
+		DefaultSNI:  addressMap.DefaultSNI,
+		Enabled:     addressMap.Enabled,
+	}
+	var result AddressMap
+	if _, err := api.makeRequestResult("PATCH", "/accounts/"+accountID+"/addressing/address_maps/"+addressMapID, params, &result); err != nil {
+		return AddressMap{}, err
+	}
+	return result, nil
+}
+
+// DeleteAddressMap deletes an address map.
+//
+// API reference: https://developers.cloudflare.com/api/operations/ip-address-management-address-maps-delete-address-map
+func (api *API) DeleteAddressMap(accountID, addressMapID string) error {
+	if _, err := api.makeRequestResult("DELETE", "/accounts/"+accountID+"/addressing/address_maps/"+addressMapID, nil, nil); err != nil {
+		return err
+	}
+	return nil
+}
+
+// AddIPToAddressMap adds an IP address to an address map.
+//
+// API reference: https://developers.cloudflare.com/api/operations/ip-address-management-address-maps-add-an-ip-to-an-address-map
+func (api *API) AddIPToAddressMap(accountID, addressMapID, ip string) error {
+	return api.addressMapMember("PUT", accountID, addressMapID, "ips", ip)
+}
+
+// RemoveIPFromAddressMap removes an IP address from an address map.
+//
+// API reference: https://developers.cloudflare.com/api/operations/ip-address-management-address-maps-remove-an-ip-from-an-address-map
+func (api *API) RemoveIPFromAddressMap(accountID, addressMapID, ip string) error {
+	return api.addressMapMember("DELETE", accountID, addressMapID, "ips", ip)
+}
+
+// AddAddressMapMembership adds a zone or account membership to an address map.
+//
+// API reference: https://developers.cloudflare.com/api/operations/ip-address-management-address-maps-add-a-zone-membership-to-an-address-map
+func (api *API) AddAddressMapMembership(accountID, addressMapID string, membership AddressMapMembership) error {
+	collection, err := addressMapMembershipCollection(membership)
+	if err != nil {
+		return err
+	}
+	return api.addressMapMember("PUT", accountID, addressMapID, collection, membership.Identifier)
+}
+
+// RemoveAddressMapMembership removes a zone or account membership from an
+// address map.
+//
+// API reference: https://developers.cloudflare.com/api/operations/ip-address-management-address-maps-remove-a-zone-membership-from-an-address-map
+func (api *API) RemoveAddressMapMembership(accountID, addressMapID string, membership AddressMapMembership) error {
+	collection, err := addressMapMembershipCollection(membership)
+	if err != nil {
+		return err
+	}
+	return api.addressMapMember("DELETE", accountID, addressMapID, collection, membership.Identifier)
+}
+
+func addressMapMembershipCollection(membership AddressMapMembership) (string, error) {
+	switch membership.Kind {
+	case AddressMapMembershipZone:
+		return "zones", nil
+	case AddressMapMembershipAccount:
+		return "accounts", nil
+	}
+	return "", errors.Errorf("unknown address map membership kind %q", membership.Kind)
+}
+
+func (api *API) addressMapMember(method, accountID, addressMapID, collection, id string) error {
+	uri := "/accounts/" + accountID + "/addressing/address_maps/" + addressMapID + "/" + collection + "/" + id
+	if _, err := api.makeRequestResult(method, uri, nil, nil); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,110 @@
+package cloudflare
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAddressMaps(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/accounts/acc/addressing/address_maps", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		switch r.Method {
+		case "GET":
+			fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": [{"id": "m1", "description": "web", "enabled": true, "can_delete": true}]}`)
+		case "POST":
+			var params map[string]interface{}
+			if assert.NoError(t, json.NewDecoder(r.Body).Decode(&params)) {
+				assert.Equal(t, []interface{}{"192.0.2.1"}, params["ips"])
+				assert.Equal(t, []interface{}{map[string]interface{}{"identifier": "z1", "kind": "zone"}}, params["memberships"])
+			}
+			fmt.Fprint(w, `{
+				"success": true,
+				"errors": [],
+				"messages": [],
+				"result": {
+					"id": "m2",
+					"description": "api",
+					"ips": [{"ip": "192.0.2.1"}],
+					"memberships": [{"identifier": "z1", "kind": "zone", "can_delete": true}]
+				}
+			}`)
+		default:
+			t.Errorf("unexpected method %s", r.Method)
+		}
+	})
+	mux.HandleFunc("/accounts/acc/addressing/address_maps/m2", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		switch r.Method {
+		case "PATCH":
+			var params map[string]interface{}
+			if assert.NoError(t, json.NewDecoder(r.Body).Decode(&params)) {
+				assert.Equal(t, map[string]interface{}{"enabled": false}, params)
+			}
+			fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"id": "m2", "enabled": false}}`)
+		case "DELETE":
+			fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": null}`)
+		default:
+			t.Errorf("unexpected method %s", r.Method)
+		}
+	})
+
+	maps, err := client.AddressMaps("acc")
+	if assert.NoError(t, err) && assert.Len(t, maps, 1) {
+		assert.Equal(t, "web", *maps[0].Description)
+		assert.True(t, *maps[0].Deletable)
+	}
+
+	description := "api"
+	m, err := client.CreateAddressMap("acc", AddressMap{
+		Description: &description,
+		IPs:         []AddressMapIP{{IP: "192.0.2.1"}},
+		Memberships: []AddressMapMembership{{Identifier: "z1", Kind: AddressMapMembershipZone}},
+	})
+	if assert.NoError(t, err) {
+		assert.Equal(t, "m2", m.ID)
+		assert.Equal(t, "192.0.2.1", m.IPs[0].IP)
+	}
+
+	enabled := false
+	m, err = client.UpdateAddressMap("acc", "m2", AddressMap{Enabled: &enabled})
+	if assert.NoError(t, err) {
+		assert.False(t, *m.Enabled)
+	}
+
+	assert.NoError(t, client.DeleteAddressMap("acc", "m2"))
+}
+
+func TestAddressMapMembers(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var calls []string
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		calls = append(calls, r.Method+" "+r.URL.Path)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": null}`)
+	}
+	mux.HandleFunc("/accounts/acc/addressing/address_maps/m1/ips/192.0.2.1", handler)
+	mux.HandleFunc("/accounts/acc/addressing/address_maps/m1/zones/z1", handler)
+	mux.HandleFunc("/accounts/acc/addressing/address_maps/m1/accounts/acc", handler)
+
+	assert.NoError(t, client.AddIPToAddressMap("acc", "m1", "192.0.2.1"))
+	assert.NoError(t, client.RemoveIPFromAddressMap("acc", "m1", "192.0.2.1"))
+	assert.NoError(t, client.AddAddressMapMembership("acc", "m1", AddressMapMembership{Kind: AddressMapMembershipZone, Identifier: "z1"}))
+	assert.NoError(t, client.RemoveAddressMapMembership("acc", "m1", AddressMapMembership{Kind: AddressMapMembershipAccount, Identifier: "acc"}))
+	assert.Error(t, client.AddAddressMapMembership("acc", "m1", AddressMapMembership{Kind: "user", Identifier: "u1"}))
+
+	assert.Equal(t, []string{
+		"PUT /accounts/acc/addressing/address_maps/m1/ips/192.0.2.1",
+		"DELETE /accounts/acc/addressing/address_maps/m1/ips/192.0.2.1",
+		"PUT /accounts/acc/addressing/address_maps/m1/zones/z1",
+		"DELETE /accounts/acc/addressing/address_maps/m1/accounts/acc",
+	}, calls)
+}
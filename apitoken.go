@@ -0,0 +1,23 @@
+package cloudflare
+
+import "time"
+
+// APITokenVerification is the result of verifying an API token.
+type APITokenVerification struct {
+	ID        string     `json:"id"`
+	Status    string     `json:"status"`
+	NotBefore *time.Time `json:"not_before,omitempty"`
+	ExpiresOn *time.Time `json:"expires_on,omitempty"`
+}
+
+// VerifyAPIToken checks the API token the client was created with, returning
+// its ID and whether it is active.
+//
+// API reference: https://api.cloudflare.com/#user-api-tokens-verify-token
+func (api *API) VerifyAPIToken() (APITokenVerification, error) {
+	var result APITokenVerification
+	if _, err := api.makeRequestResult("GET", "/user/tokens/verify", nil, &result); err != nil {
+		return APITokenVerification{}, err
+	}
+	return result, nil
+}
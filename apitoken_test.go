@@ -0,0 +1,53 @@
+package cloudflare
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewWithAPIToken(t *testing.T) {
+	_, err := NewWithAPIToken("")
+	assert.Error(t, err)
+
+	api, err := NewWithAPIToken("token", MaxIdleConnsPerHost(8))
+	if assert.NoError(t, err) {
+		assert.Equal(t, "token", api.APIToken)
+		assert.Equal(t, apiURL, api.BaseURL)
+		assert.NotNil(t, api.httpClient)
+	}
+}
+
+func TestVerifyAPIToken(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/user/tokens/verify", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+		assert.Empty(t, r.Header.Get("X-Auth-Key"))
+		assert.Empty(t, r.Header.Get("X-Auth-Email"))
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [{"code": 10000, "message": "This API Token is valid and active"}],
+			"result": {"id": "ed17574386854bf78a67040be0a770b0", "status": "active", "expires_on": "2020-01-01T00:00:00Z"}
+		}`)
+	})
+
+	api, err := NewWithAPIToken("token")
+	if !assert.NoError(t, err) {
+		return
+	}
+	api.BaseURL = server.URL
+
+	v, err := api.VerifyAPIToken()
+	if assert.NoError(t, err) {
+		assert.Equal(t, "active", v.Status)
+		assert.Nil(t, v.NotBefore)
+		assert.NotNil(t, v.ExpiresOn)
+	}
+}
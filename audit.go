@@ -0,0 +1,101 @@
+package cloudflare
+
+import (
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"time"
+)
+
+// AuditRecord describes an attempt to change something through the client.
+type AuditRecord struct {
+	Time time.Time
+	// Actor is the identity given to Audit.
+	Actor  string
+	Method string
+	// Endpoint is the templated path of the request (see Endpoint), and URI
+	// the requested path and query.
+	Endpoint string
+	URI      string
+	// PayloadSHA256 is the hex-encoded SHA-256 hash of the request body, or
+	// empty if there was none or it was streamed.
+	PayloadSHA256 string
+	// Attempt counts the attempts made at the request, starting from 1.
+	Attempt int
+	// StatusCode is the status of the response, or zero if the request failed
+	// with Err.
+	StatusCode int
+	Err        error
+}
+
+// AuditSink receives an AuditRecord for every attempt at a request which
+// changes something (those with methods other than GET and HEAD), e.g. to
+// keep a compliance audit trail of automated changes. Implementations must
+// be safe for concurrent use.
+type AuditSink interface {
+	RecordChange(AuditRecord)
+}
+
+// Audit records every attempt the client makes to change something to sink,
+// attributed to actor, e.g. the name of the automation or person making the
+// changes.
+func Audit(sink AuditSink, actor string) Option {
+	return Intercept(auditInterceptor{sink: sink, actor: actor})
+}
+
+type auditInterceptor struct {
+	sink  AuditSink
+	actor string
+}
+
+func (auditInterceptor) BeforeRequest(*http.Request) error {
+	return nil
+}
+
+func (a auditInterceptor) AfterResponse(res *InterceptedResponse) {
+	req := res.Request
+	if req.Method == "GET" || req.Method == "HEAD" {
+		return
+	}
+	endpoint, _ := RequestEndpoint(req)
+	a.sink.RecordChange(AuditRecord{
+		Time:          time.Now().Add(-res.Latency),
+		Actor:         a.actor,
+		Method:        req.Method,
+		Endpoint:      endpoint,
+		URI:           requestInfoFrom(req).uri,
+		PayloadSHA256: payloadHash(req),
+		Attempt:       res.Attempt,
+		StatusCode:    res.StatusCode,
+		Err:           res.Err,
+	})
+}
+
+// payloadHash returns the hex-encoded SHA-256 hash of the body of req, which
+// is hashed before any compression, or empty if it cannot be read again.
+func payloadHash(req *http.Request) string {
+	if req.GetBody == nil {
+		return ""
+	}
+	body, err := req.GetBody()
+	if err != nil {
+		return ""
+	}
+	defer body.Close()
+
+	var r io.Reader = body
+	if req.Header.Get("Content-Encoding") == "gzip" {
+		gz, err := gzip.NewReader(body)
+		if err != nil {
+			return ""
+		}
+		r = gz
+	}
+	h := sha256.New()
+	if _, err := io.Copy(h, r); err != nil {
+		return ""
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
@@ -0,0 +1,64 @@
+package cloudflare
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type recordingAuditSink struct {
+	mu      sync.Mutex
+	records []AuditRecord
+}
+
+func (s *recordingAuditSink) RecordChange(r AuditRecord) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.records = append(s.records, r)
+}
+
+func TestAudit(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var payload []byte
+	mux.HandleFunc("/zones/023e105f4ecef8ad9ca31a8372d0c353/pagerules/372e67954025e0ba6aaa6d586b9e0b59", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "PATCH" {
+			payload, _ = ioutil.ReadAll(r.Body)
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"id": "r1"}}`)
+	})
+
+	sink := &recordingAuditSink{}
+	assert.NoError(t, Audit(sink, "deploy-bot")(client))
+
+	_, err := client.PageRule("023e105f4ecef8ad9ca31a8372d0c353", "372e67954025e0ba6aaa6d586b9e0b59")
+	assert.NoError(t, err)
+	_, err = client.ChangePageRule("023e105f4ecef8ad9ca31a8372d0c353", "372e67954025e0ba6aaa6d586b9e0b59", PageRule{Status: "disabled"})
+	assert.NoError(t, err)
+	assert.NoError(t, client.DeletePageRule("023e105f4ecef8ad9ca31a8372d0c353", "372e67954025e0ba6aaa6d586b9e0b59"))
+
+	// Reads are not recorded.
+	if assert.Len(t, sink.records, 2) {
+		r := sink.records[0]
+		assert.Equal(t, "deploy-bot", r.Actor)
+		assert.Equal(t, "PATCH", r.Method)
+		assert.Equal(t, "/zones/:zone_id/pagerules/:id", r.Endpoint)
+		assert.Equal(t, "/zones/023e105f4ecef8ad9ca31a8372d0c353/pagerules/372e67954025e0ba6aaa6d586b9e0b59", r.URI)
+		sum := sha256.Sum256(payload)
+		assert.Equal(t, hex.EncodeToString(sum[:]), r.PayloadSHA256)
+		assert.Equal(t, http.StatusOK, r.StatusCode)
+		assert.Equal(t, 1, r.Attempt)
+		assert.False(t, r.Time.IsZero())
+
+		assert.Equal(t, "DELETE", sink.records[1].Method)
+		assert.Empty(t, sink.records[1].PayloadSHA256)
+	}
+}
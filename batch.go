@@ -0,0 +1,148 @@
+package cloudflare
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Defaults for BatchOptions.
+const (
+	defaultBatchConcurrency = 4
+	defaultBatchRetries     = 3
+	defaultBatchBackoff     = time.Second
+	maxBatchBackoff         = time.Minute
+)
+
+// BatchOptions controls the behaviour of Batch.
+type BatchOptions struct {
+	// Concurrency is the maximum number of operations run at once. It
+	// defaults to 4.
+	Concurrency int
+	// RateLimitRetries is how many times an operation which fails because
+	// the API's rate limit was exceeded is retried. It defaults to 3; a
+	// negative value disables retries.
+	RateLimitRetries int
+	// Backoff is how long all operations are paused for after one is rate
+	// limited. It doubles for each retry of the operation, up to a minute,
+	// and defaults to one second.
+	Backoff time.Duration
+}
+
+// OperationError records the failure of an operation run by Batch.
+type OperationError struct {
+	// Index is the position of the operation in the batch.
+	Index int
+	Err   error
+}
+
+// BatchError is returned by Batch when one or more operations failed. The
+// failures are ordered by index.
+type BatchError struct {
+	Errors []OperationError
+	// Total is the number of operations in the batch.
+	Total int
+}
+
+func (e *BatchError) Error() string {
+	msgs := make([]string, len(e.Errors))
+	for i, oe := range e.Errors {
+		msgs[i] = fmt.Sprintf("operation %d: %s", oe.Index, oe.Err)
+	}
+	return fmt.Sprintf("%d of %d operations failed: %s", len(e.Errors), e.Total, strings.Join(msgs, "; "))
+}
+
+// Batch runs many operations, such as API calls creating page rules, with
+// bounded concurrency:
+//
+//	ops := make([]func() error, len(rules))
+//	for i, rule := range rules {
+//		rule := rule
+//		ops[i] = func() error {
+//			_, err := api.CreatePageRule(zoneID, rule)
+//			return err
+//		}
+//	}
+//	err := cloudflare.Batch(cloudflare.BatchOptions{Concurrency: 8}, ops...)
+//
+// When an operation fails because the API's rate limit was exceeded, no
+// further operations are started until a backoff has passed, and then the
+// operation is retried. Every operation is run even if some fail; if any do,
+// a *BatchError describing all of the failures is returned.
+func Batch(opts BatchOptions, ops ...func() error) error {
+	concurrency := opts.Concurrency
+	if concurrency < 1 {
+		concurrency = defaultBatchConcurrency
+	}
+	retries := opts.RateLimitRetries
+	if retries == 0 {
+		retries = defaultBatchRetries
+	}
+	backoff := opts.Backoff
+	if backoff <= 0 {
+		backoff = defaultBatchBackoff
+	}
+
+	// resume is when operations may be started again after rate limiting.
+	var mu sync.Mutex
+	var resume time.Time
+	var failures []OperationError
+	pause := func() {
+		mu.Lock()
+		d := time.Until(resume)
+		mu.Unlock()
+		if d > 0 {
+			time.Sleep(d)
+		}
+	}
+	run := func(op func() error) error {
+		delay := backoff
+		for attempt := 0; ; attempt++ {
+			pause()
+			err := op()
+			apiErr, ok := AsAPIError(err)
+			if err == nil || !ok || !apiErr.IsRateLimited() || attempt >= retries {
+				return err
+			}
+			mu.Lock()
+			if t := time.Now().Add(delay); t.After(resume) {
+				resume = t
+			}
+			mu.Unlock()
+			if delay *= 2; delay > maxBatchBackoff {
+				delay = maxBatchBackoff
+			}
+		}
+	}
+
+	work := make(chan int)
+	var wg sync.WaitGroup
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for n := range work {
+				if err := run(ops[n]); err != nil {
+					mu.Lock()
+					failures = append(failures, OperationError{Index: n, Err: err})
+					mu.Unlock()
+				}
+			}
+		}()
+	}
+	for i := range ops {
+		work <- i
+	}
+	close(work)
+	wg.Wait()
+
+	if len(failures) == 0 {
+		return nil
+	}
+	sort.Slice(failures, func(i, j int) bool {
+		return failures[i].Index < failures[j].Index
+	})
+	return &BatchError{Errors: failures, Total: len(ops)}
+}
@@ -0,0 +1,78 @@
+package cloudflare
+
+import (
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBatch(t *testing.T) {
+	var running, maxRunning int32
+	var mu sync.Mutex
+	calls := make(map[int]int)
+
+	ops := make([]func() error, 20)
+	for i := range ops {
+		i := i
+		ops[i] = func() error {
+			n := atomic.AddInt32(&running, 1)
+			defer atomic.AddInt32(&running, -1)
+			for {
+				m := atomic.LoadInt32(&maxRunning)
+				if n <= m || atomic.CompareAndSwapInt32(&maxRunning, m, n) {
+					break
+				}
+			}
+			time.Sleep(time.Millisecond)
+
+			mu.Lock()
+			calls[i]++
+			call := calls[i]
+			mu.Unlock()
+			switch {
+			case i == 3 && call == 1:
+				// Rate limited once, then succeeds.
+				return errors.Wrap(&APIError{StatusCode: http.StatusTooManyRequests}, errMakeRequestError)
+			case i == 7 || i == 5:
+				return &APIError{StatusCode: http.StatusBadRequest}
+			}
+			return nil
+		}
+	}
+
+	err := Batch(BatchOptions{Concurrency: 3, Backoff: time.Millisecond}, ops...)
+	if batchErr, ok := err.(*BatchError); assert.True(t, ok) {
+		assert.Equal(t, 20, batchErr.Total)
+		if assert.Len(t, batchErr.Errors, 2) {
+			assert.Equal(t, 5, batchErr.Errors[0].Index)
+			assert.Equal(t, 7, batchErr.Errors[1].Index)
+		}
+	}
+	assert.Equal(t, 2, calls[3])
+	assert.Equal(t, 1, calls[5])
+	assert.True(t, maxRunning <= 3)
+}
+
+func TestBatchRateLimitRetries(t *testing.T) {
+	var calls int32
+	op := func() error {
+		atomic.AddInt32(&calls, 1)
+		return &APIError{StatusCode: http.StatusTooManyRequests}
+	}
+
+	err := Batch(BatchOptions{RateLimitRetries: 2, Backoff: time.Millisecond}, op)
+	assert.Error(t, err)
+	assert.Equal(t, int32(3), calls)
+
+	calls = 0
+	err = Batch(BatchOptions{RateLimitRetries: -1}, op)
+	assert.Error(t, err)
+	assert.Equal(t, int32(1), calls)
+
+	assert.NoError(t, Batch(BatchOptions{}))
+}
@@ -0,0 +1,39 @@
+package cloudflare
+
+import "time"
+
+// BillingProfile is the billing contact and payment details of an account.
+// Only the last digits of the card number are returned.
+type BillingProfile struct {
+	ID                     string    `json:"id"`
+	FirstName              string    `json:"first_name"`
+	LastName               string    `json:"last_name"`
+	Address                string    `json:"address"`
+	Address2               string    `json:"address2"`
+	Company                string    `json:"company"`
+	City                   string    `json:"city"`
+	State                  string    `json:"state"`
+	ZipCode                string    `json:"zipcode"`
+	Country                string    `json:"country"`
+	Telephone              string    `json:"telephone"`
+	CardNumber             string    `json:"card_number"`
+	CardExpiryYear         int       `json:"card_expiry_year"`
+	CardExpiryMonth        int       `json:"card_expiry_month"`
+	VAT                    string    `json:"vat"`
+	PaymentEmail           string    `json:"payment_email"`
+	EnterpriseBillingEmail string    `json:"enterprise_billing_email"`
+	EnterprisePrimaryEmail string    `json:"enterprise_primary_email"`
+	CreatedOn              time.Time `json:"created_on"`
+	EditedOn               time.Time `json:"edited_on"`
+}
+
+// AccountBillingProfile returns the billing profile of an account.
+//
+// API reference: https://api.cloudflare.com/#account-billing-profile-billing-profile-details
+func (api *API) AccountBillingProfile(accountID string) (BillingProfile, error) {
+	var result BillingProfile
+	if _, err := api.makeRequestResult("GET", "/accounts/"+accountID+"/billing/profile", nil, &result); err != nil {
+		return BillingProfile{}, err
+	}
+	return result, nil
+}
@@ -0,0 +1,51 @@
+package cloudflare
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccountBillingProfile(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/accounts/acc/billing/profile", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {
+				"id": "0020c268dbf54e975fa7b2d5d5b3f6b8",
+				"first_name": "Jane",
+				"last_name": "Doe",
+				"company": "Example Inc",
+				"country": "US",
+				"card_number": "xxxx-xxxx-xxxx-1234",
+				"card_expiry_year": 2030,
+				"card_expiry_month": 12,
+				"payment_email": "billing@example.com",
+				"created_on": "2014-01-01T05:20:00Z",
+				"edited_on": "2014-01-01T05:20:00Z"
+			}
+		}`)
+	})
+
+	profile, err := client.AccountBillingProfile("acc")
+	if assert.NoError(t, err) {
+		assert.Equal(t, "Example Inc", profile.Company)
+		assert.Equal(t, "xxxx-xxxx-xxxx-1234", profile.CardNumber)
+		assert.Equal(t, 2030, profile.CardExpiryYear)
+		assert.Equal(t, time.Date(2014, 1, 1, 5, 20, 0, 0, time.UTC), profile.CreatedOn)
+	}
+
+	profile, err = client.Account("acc").BillingProfile()
+	if assert.NoError(t, err) {
+		assert.Equal(t, "billing@example.com", profile.PaymentEmail)
+	}
+}
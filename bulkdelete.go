@@ -0,0 +1,42 @@
+package cloudflare
+
+// forEachID runs op for each of the given IDs using Batch, returning the
+// error for each ID for which it failed, or nil if it succeeded for all.
+func forEachID(ids []string, op func(id string) error) map[string]error {
+	ops := make([]func() error, len(ids))
+	for i, id := range ids {
+		id := id
+		ops[i] = func() error {
+			return op(id)
+		}
+	}
+	err := Batch(BatchOptions{}, ops...)
+	if err == nil {
+		return nil
+	}
+	errs := make(map[string]error)
+	for _, oe := range err.(*BatchError).Errors {
+		errs[ids[oe.Index]] = oe.Err
+	}
+	return errs
+}
+
+// DeletePageRules deletes many page rules from a zone concurrently, backing
+// off when the API's rate limit is exceeded (see Batch). Every deletion is
+// attempted; the returned map holds the error for each rule ID which could
+// not be deleted, and is nil if all were.
+func (api *API) DeletePageRules(zoneID string, ids []string) map[string]error {
+	return forEachID(ids, func(id string) error {
+		return api.DeletePageRule(zoneID, id)
+	})
+}
+
+// DeleteDNSRecords deletes many DNS records from a zone concurrently, backing
+// off when the API's rate limit is exceeded (see Batch). Every deletion is
+// attempted; the returned map holds the error for each record ID which could
+// not be deleted, and is nil if all were.
+func (api *API) DeleteDNSRecords(zoneID string, ids []string) map[string]error {
+	return forEachID(ids, func(id string) error {
+		return api.DeleteDNSRecord(zoneID, id)
+	})
+}
@@ -0,0 +1,52 @@
+package cloudflare
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeletePageRules(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var mu sync.Mutex
+	deleted := make(map[string]bool)
+	limited := false
+	mux.HandleFunc("/zones/z1/pagerules/", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "DELETE", r.Method, "Expected method 'DELETE', got %s", r.Method)
+		id := strings.TrimPrefix(r.URL.Path, "/zones/z1/pagerules/")
+		w.Header().Set("content-type", "application/json")
+
+		mu.Lock()
+		defer mu.Unlock()
+		switch {
+		case id == "missing":
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"success": false, "errors": [{"code": 1002, "message": "Page rule not found"}], "messages": [], "result": null}`)
+			return
+		case id == "r2" && !limited:
+			limited = true
+			w.WriteHeader(http.StatusTooManyRequests)
+			fmt.Fprint(w, `{"success": false, "errors": [{"code": 971, "message": "Please wait"}], "messages": [], "result": null}`)
+			return
+		}
+		deleted[id] = true
+		fmt.Fprintf(w, `{"success": true, "errors": [], "messages": [], "result": {"id": %q}}`, id)
+	})
+
+	errs := client.DeletePageRules("z1", []string{"r1", "r2", "r3"})
+	assert.Nil(t, errs)
+	assert.Equal(t, map[string]bool{"r1": true, "r2": true, "r3": true}, deleted)
+
+	errs = client.DeletePageRules("z1", []string{"r4", "missing"})
+	if assert.Len(t, errs, 1) {
+		e, ok := AsAPIError(errs["missing"])
+		assert.True(t, ok && e.IsNotFound())
+	}
+	assert.True(t, deleted["r4"])
+}
@@ -1,13 +1,19 @@
 // Package cloudflare implements the CloudFlare v4 API.
 package cloudflare
 
+//go:generate go run gen.go
+
 import (
 	"bytes"
+	"context"
 	"encoding/json"
+	"fmt"
 	"io"
 	"io/ioutil"
-	"log"
 	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
 	"time"
 
 	"github.com/pkg/errors"
@@ -15,27 +21,119 @@ import (
 
 const apiURL = "https://api.cloudflare.com/client/v4"
 
-// API holds the configuration for the current API client. A client should not
-// be modified concurrently.
+// API holds the configuration for the current API client.
+//
+// An API is safe for concurrent use by multiple goroutines: requests share a
+// single http.Client, so connections are pooled, and the state a client keeps
+// between requests (cached lookups and responses, and the rate limiter) is
+// guarded by locks. Its exported fields must not be changed while it is in
+// use; derive a client with With instead.
 type API struct {
-	APIKey     string
-	APIEmail   string
-	BaseURL    string
-	headers    http.Header
-	httpClient *http.Client
+	APIKey            string
+	APIEmail          string
+	APIToken          string
+	APIUserServiceKey string
+	BaseURL           string
+	AccountID         string
+	headers           http.Header
+	httpClient        *http.Client
+	transport         transportConfig
+
+	environment         Environment
+	retryPolicy         RetryPolicy
+	rateLimiter         *rateLimiter
+	interceptors        []Interceptor
+	conditionalRequests bool
+	compressRequests    bool
+	compressMinSize     int64
+	verifyCreates       bool
+	zoneIDCacheTTL      time.Duration
+	dryRun              Logger
+	responseCache       ResponseCacheStore
+	responseCacheTTL    time.Duration
+	onMessages          MessageHandler
+	userAgent           string
+	maxResponseSize     int64
+	codec               Codec
+	requestOptions      requestOptions
+
+	// cache is shared with the clients derived by With.
+	cache *clientCache
+}
+
+// clientCache holds the lookups and responses remembered by a client.
+type clientCache struct {
+	// mu guards the fields below.
+	mu         sync.Mutex
+	accountIDs map[string]string
+	zoneIDs    map[string]zoneIDEntry
+	etags      map[string]*etagEntry
+}
+
+// zoneIDEntry is a zone ID remembered by ZoneIDByName.
+type zoneIDEntry struct {
+	id      string
+	expires time.Time
 }
 
-// New creates a new CloudFlare v4 API client.
+// New creates a new CloudFlare v4 API client authenticating with an email
+// address and global API key.
 func New(key, email string, opts ...Option) (*API, error) {
 	if key == "" || email == "" {
 		return nil, errors.New(errEmptyCredentials)
 	}
 
+	api, err := newClient(opts...)
+	if err != nil {
+		return nil, err
+	}
+	api.APIKey = key
+	api.APIEmail = email
+
+	return api, nil
+}
+
+// NewWithAPIToken creates a new CloudFlare v4 API client authenticating with a
+// scoped API token.
+func NewWithAPIToken(token string, opts ...Option) (*API, error) {
+	if token == "" {
+		return nil, errors.New(errEmptyAPIToken)
+	}
+
+	api, err := newClient(opts...)
+	if err != nil {
+		return nil, err
+	}
+	api.APIToken = token
+
+	return api, nil
+}
+
+// NewWithUserServiceKey creates a new CloudFlare v4 API client authenticating
+// with an Origin CA key (a user service key), which is only accepted by the
+// Origin CA certificate endpoints.
+func NewWithUserServiceKey(key string, opts ...Option) (*API, error) {
+	if key == "" {
+		return nil, errors.New(errEmptyUserServiceKey)
+	}
+
+	api, err := newClient(opts...)
+	if err != nil {
+		return nil, err
+	}
+	api.APIUserServiceKey = key
+
+	return api, nil
+}
+
+func newClient(opts ...Option) (*API, error) {
 	api := &API{
-		APIKey:   key,
-		APIEmail: email,
-		BaseURL:  apiURL,
-		headers:  make(http.Header),
+		BaseURL:        apiURL,
+		headers:        make(http.Header),
+		transport:      defaultTransportConfig(),
+		environment:    EnvironmentDefault,
+		zoneIDCacheTTL: defaultZoneIDCacheTTL,
+		cache:          &clientCache{},
 	}
 
 	err := api.parseOptions(opts...)
@@ -43,95 +141,377 @@ func New(key, email string, opts ...Option) (*API, error) {
 		return nil, errors.Wrap(err, "options parsing failed")
 	}
 
-	// Fall back to http.DefaultClient if the package user does not provide
-	// their own.
-	if api.httpClient == nil {
-		api.httpClient = http.DefaultClient
+	// Create a client with a transport tuned for the API if the package user
+	// does not provide their own.
+	switch {
+	case api.httpClient != nil && api.transport.set:
+		return nil, errors.New("transport options cannot be combined with a custom HTTP client")
+	case api.transport.replaced && api.transport.tuned:
+		return nil, errors.New("transport options cannot be combined with a custom round tripper")
+	case api.httpClient != nil:
+		if len(api.transport.wrappers) > 0 {
+			// Copy the client rather than modifying the caller's.
+			c := *api.httpClient
+			rt := c.Transport
+			if rt == nil {
+				rt = http.DefaultTransport
+			}
+			c.Transport = api.transport.wrap(rt)
+			api.httpClient = &c
+		}
+	case api.transport.roundTripper != nil:
+		api.httpClient = &http.Client{Transport: api.transport.wrap(api.transport.roundTripper)}
+	default:
+		api.httpClient = &http.Client{Transport: api.transport.wrap(api.transport.newTransport())}
 	}
 
 	return api, nil
 }
 
-// ZoneIDByName retrieves a zone's ID from the name.
+// ZoneIDByName retrieves a zone's ID from the name. Successful lookups are
+// remembered for the client's zone ID cache TTL (see ZoneIDCacheTTL), so
+// tools working with zone names need not list zones before every call.
 func (api *API) ZoneIDByName(zoneName string) (string, error) {
+	api.cache.mu.Lock()
+	entry, ok := api.cache.zoneIDs[zoneName]
+	api.cache.mu.Unlock()
+	if ok && time.Now().Before(entry.expires) {
+		return entry.id, nil
+	}
+
 	res, err := api.ListZones(zoneName)
 	if err != nil {
 		return "", errors.Wrap(err, "ListZones command failed")
 	}
 	for _, zone := range res {
 		if zone.Name == zoneName {
+			api.cacheZoneID(zone.Name, zone.ID)
 			return zone.ID, nil
 		}
 	}
 	return "", errors.New("Zone could not be found")
 }
 
+// cacheZoneID remembers the ID of a zone, if the client caches zone IDs.
+func (api *API) cacheZoneID(zoneName, zoneID string) {
+	if api.zoneIDCacheTTL <= 0 {
+		return
+	}
+	api.cache.mu.Lock()
+	defer api.cache.mu.Unlock()
+	if api.cache.zoneIDs == nil {
+		api.cache.zoneIDs = make(map[string]zoneIDEntry)
+	}
+	api.cache.zoneIDs[zoneName] = zoneIDEntry{id: zoneID, expires: time.Now().Add(api.zoneIDCacheTTL)}
+}
+
+// ForgetZoneIDs removes the given zones from the cache used by ZoneIDByName,
+// or every zone if none are given, e.g. after a zone has been deleted and
+// added again outside of the client.
+func (api *API) ForgetZoneIDs(zoneNames ...string) {
+	api.cache.mu.Lock()
+	defer api.cache.mu.Unlock()
+	if len(zoneNames) == 0 {
+		api.cache.zoneIDs = nil
+		return
+	}
+	for _, name := range zoneNames {
+		delete(api.cache.zoneIDs, name)
+	}
+}
+
+// forgetZoneID removes a zone from the cache used by ZoneIDByName by its ID.
+func (api *API) forgetZoneID(zoneID string) {
+	api.cache.mu.Lock()
+	defer api.cache.mu.Unlock()
+	for name, entry := range api.cache.zoneIDs {
+		if entry.id == zoneID {
+			delete(api.cache.zoneIDs, name)
+		}
+	}
+}
+
 // makeRequest makes a HTTP request and returns the body as a byte slice,
 // closing it before returnng. params will be serialized to JSON.
 func (api *API) makeRequest(method, uri string, params interface{}) ([]byte, error) {
-	// Replace nil with a JSON object if needed
-	var reqBody io.Reader
+	return api.makeRequestContext(context.Background(), method, uri, params, nil)
+}
+
+// makeRequestContext is makeRequest with a context and any headers specific to
+// the request, which may be nil.
+func (api *API) makeRequestContext(ctx context.Context, method, uri string, params interface{}, extraHeader http.Header) ([]byte, error) {
+	uri = api.requestOptions.withQuery(uri)
+	ctx, cancel := api.requestOptions.withTimeout(ctx)
+	defer cancel()
+
+	if body, ok := api.cachedResponseBody(method, uri); ok {
+		return body, nil
+	}
+
+	// Replace nil with a JSON object if needed. The body is encoded into a
+	// pooled buffer, which is released once the response has been read.
+	var reqBuf *bytes.Buffer
 	if params != nil {
-		json, err := json.Marshal(params)
-		log.Printf("[DEBUG] Request is %s", string(json))
+		reqBuf = getBuffer()
+		defer putBuffer(reqBuf)
+		b, err := api.json().Marshal(params)
 		if err != nil {
 			return nil, errors.Wrap(err, "error marshalling params to JSON")
 		}
-		reqBody = bytes.NewReader(json)
-	} else {
-		reqBody = nil
+		// Terminate the body with a newline, as json.Encoder does.
+		reqBuf.Write(b)
+		reqBuf.WriteByte('\n')
 	}
 
-	resp, err := api.request(method, uri, reqBody)
-	if err != nil {
-		return nil, err
+	header := extraHeader
+	cached := api.cachedResponse(method, uri)
+	if cached != nil {
+		header = make(http.Header, len(extraHeader)+1)
+		for k, v := range extraHeader {
+			header[k] = v
+		}
+		header.Set("If-None-Match", cached.etag)
 	}
-	defer resp.Body.Close()
 
-	body, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return nil, errors.Wrap(err, "could not read response body")
+	var resp *http.Response
+	var body []byte
+	for attempt := 1; ; attempt++ {
+		var reqBody io.Reader
+		if reqBuf != nil {
+			reqBody = bytes.NewReader(reqBuf.Bytes())
+		}
+
+		var err error
+		resp, err = api.request(ctx, method, uri, reqBody, header, attempt)
+		if err == nil {
+			body, err = api.readResponseBody(method, uri, resp)
+			resp.Body.Close()
+			if _, ok := err.(*ResponseTooLargeError); ok {
+				return nil, err
+			} else if err != nil {
+				// The request was sent, so the API may have acted on it.
+				err = errors.Wrap(unknownOutcome(method, uri, err), "could not read response body")
+			} else {
+				err = unavailableError(resp, body)
+			}
+		}
+
+		delay, retry := api.retryPolicy.retryDelay(attempt, resp, err)
+		if !retry || !retrySafe(method, resp, err) {
+			if err != nil {
+				return nil, err
+			}
+			break
+		}
+		select {
+		case <-time.After(delay):
+		case <-ctx.Done():
+			return nil, errors.Wrap(ctx.Err(), "request cancelled while waiting to retry")
+		}
 	}
 
 	switch resp.StatusCode {
 	case http.StatusOK:
-		break
-	case http.StatusUnauthorized:
-		return nil, errors.Errorf("HTTP status %d: invalid credentials", resp.StatusCode)
-	case http.StatusForbidden:
-		return nil, errors.Errorf("HTTP status %d: insufficient permissions", resp.StatusCode)
-	case http.StatusServiceUnavailable, http.StatusBadGateway, http.StatusGatewayTimeout,
-		522, 523, 524:
-		return nil, errors.Errorf("HTTP status %d: service failure", resp.StatusCode)
-	default:
-		var s string
-		if body != nil {
-			s = string(body)
+		api.cacheResponse(method, uri, resp, body)
+		api.updateResponseCache(method, uri, body)
+		api.reportMessages(method, uri, body)
+	case http.StatusNotModified:
+		if cached == nil {
+			return nil, errors.Errorf("HTTP status %d: unexpected for a request without If-None-Match", resp.StatusCode)
 		}
-		return nil, errors.Errorf("HTTP status %d: content %q", resp.StatusCode, s)
+		body = cached.body
+	default:
+		return nil, statusError(api.json(), resp.StatusCode, body)
 	}
-	log.Printf("[DEBUG] Response is: %s", string(body))
 
 	return body, nil
 }
 
+// sizedReader is a request body of known length, allowing it to be streamed
+// without chunked encoding.
+type sizedReader struct {
+	io.Reader
+	size int64
+}
+
+// makeRequestStream makes a HTTP request with a raw body, returning the
+// response body unread so that large payloads need not be held in memory.
+// header holds any headers specific to the request (such as the content type
+// of the body), and may be nil. The caller must close the returned body.
+func (api *API) makeRequestStream(method, uri string, reqBody io.Reader, header http.Header) (io.ReadCloser, error) {
+	uri = api.requestOptions.withQuery(uri)
+	ctx, cancel := api.requestOptions.withTimeout(context.Background())
+	resp, err := api.request(ctx, method, uri, reqBody, header, 1)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		defer cancel()
+		defer resp.Body.Close()
+		body, err := ioutil.ReadAll(resp.Body)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not read response body")
+		}
+		return nil, statusError(api.json(), resp.StatusCode, body)
+	}
+
+	// The timeout also covers reading the body, so ends when it is closed.
+	return cancelOnClose{resp.Body, cancel}, nil
+}
+
+// cancelOnClose is a response body which cancels the context of its request
+// when closed.
+type cancelOnClose struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b cancelOnClose) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+	return err
+}
+
+// makeRequestBody makes a HTTP request with a raw body of the given content
+// type, such as a multipart form, and decodes the result of the response into
+// result, which may be nil. A response with "success": false returns an
+// *APIError.
+func (api *API) makeRequestBody(method, uri, contentType string, reqBody io.Reader, result interface{}) error {
+	body, err := api.makeRequestStream(method, uri, reqBody, http.Header{"Content-Type": {contentType}})
+	if err != nil {
+		return errors.Wrap(err, errMakeRequestError)
+	}
+	defer body.Close()
+
+	res, err := ioutil.ReadAll(body)
+	if err != nil {
+		return errors.Wrap(err, "could not read response body")
+	}
+	var r rawResponse
+	if err := api.json().Unmarshal(res, &r); err != nil {
+		return errors.Wrap(err, errUnmarshalError)
+	}
+	if !r.Success {
+		return errors.Wrap(unsuccessfulError(r.Errors), errMakeRequestError)
+	}
+	if result != nil && len(r.Result) > 0 {
+		if err := api.json().Unmarshal(r.Result, result); err != nil {
+			return errors.Wrap(err, errUnmarshalError)
+		}
+	}
+	return nil
+}
+
+// rawResponse is the envelope of an API response, with the result left
+// undecoded so that it is only unmarshalled once, into its final type.
+type rawResponse struct {
+	Response
+	Result     json.RawMessage `json:"result"`
+	ResultInfo ResultInfo      `json:"result_info"`
+}
+
+// makeRequestResult makes a HTTP request and decodes the result of the
+// response into result, which may be nil if the result is not needed. A
+// response with "success": false returns an *APIError, even if its HTTP
+// status was 200. The response envelope is returned for callers which need
+// its metadata (e.g. for pagination).
+func (api *API) makeRequestResult(method, uri string, params, result interface{}) (rawResponse, error) {
+	res, err := api.makeRequest(method, uri, params)
+	if err != nil {
+		return rawResponse{}, errors.Wrap(err, errMakeRequestError)
+	}
+
+	var r rawResponse
+	if err := api.json().Unmarshal(res, &r); err != nil {
+		return rawResponse{}, errors.Wrap(err, errUnmarshalError)
+	}
+	if !r.Success {
+		return rawResponse{}, errors.Wrap(unsuccessfulError(r.Errors), errMakeRequestError)
+	}
+	if result != nil && len(r.Result) > 0 {
+		if err := api.json().Unmarshal(r.Result, result); err != nil {
+			return rawResponse{}, errors.Wrap(err, errUnmarshalError)
+		}
+	}
+
+	return r, nil
+}
+
 // request makes a HTTP request to the given API endpoint, returning the raw
-// *http.Response, or an error if one occurred. The caller is responsible for
-// closing the response body.
-func (api *API) request(method, uri string, reqBody io.Reader) (*http.Response, error) {
-	req, err := http.NewRequest(method, api.BaseURL+uri, reqBody)
+// *http.Response, or an error if one occurred. header holds any headers
+// specific to this request, and may be nil. attempt counts the attempts made at
+// the request, starting from 1. The request is cancelled if ctx is done. The
+// caller is responsible for closing the response body.
+func (api *API) request(ctx context.Context, method, uri string, reqBody io.Reader, header http.Header, attempt int) (*http.Response, error) {
+	if resp := api.dryRunRequest(method, uri, reqBody); resp != nil {
+		return resp, nil
+	}
+	ctx = context.WithValue(ctx, requestInfoKey{}, requestInfo{uri: uri, attempt: attempt})
+	reqBody, compressed, err := api.compressBody(reqBody)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, method, api.BaseURL+uri, reqBody)
 	if err != nil {
 		return nil, errors.Wrap(err, "HTTP request creation failed")
 	}
+	if r, ok := reqBody.(sizedReader); ok && r.size >= 0 {
+		req.ContentLength = r.size
+	}
+
+	req.Header.Set("User-Agent", api.userAgentHeader())
 
-	// Apply any user-defined headers first.
-	req.Header = api.headers
-	req.Header.Set("X-Auth-Key", api.APIKey)
-	req.Header.Set("X-Auth-Email", api.APIEmail)
+	// Apply the environment's and any user-defined headers first. They are
+	// copied so that headers for this request do not leak into later ones.
+	for k, v := range api.environment.Header {
+		req.Header[k] = v
+	}
+	for k, v := range api.headers {
+		req.Header[k] = v
+	}
+	for k, v := range api.requestOptions.header {
+		req.Header[k] = v
+	}
+	for k, v := range header {
+		req.Header[k] = v
+	}
+	if compressed {
+		req.Header.Set("Content-Encoding", "gzip")
+	}
+	switch {
+	case api.requestOptions.userServiceKey != "":
+		req.Header.Set("X-Auth-User-Service-Key", api.requestOptions.userServiceKey)
+	case api.APIToken != "":
+		req.Header.Set("Authorization", "Bearer "+api.APIToken)
+	case api.APIKey != "":
+		req.Header.Set("X-Auth-Key", api.APIKey)
+		req.Header.Set("X-Auth-Email", api.APIEmail)
+	case api.APIUserServiceKey != "":
+		req.Header.Set("X-Auth-User-Service-Key", api.APIUserServiceKey)
+	}
+
+	// Wait for the rate limiter before calling the interceptors, so that
+	// every request they see is also reported to them as sent or failed.
+	if api.rateLimiter != nil {
+		if err := api.rateLimiter.wait(ctx); err != nil {
+			return nil, errors.Wrap(err, "request cancelled while rate limited")
+		}
+	}
+	if err := api.interceptRequest(req); err != nil {
+		return nil, errors.Wrap(err, "request interceptor failed")
+	}
 
+	start := time.Now()
 	resp, err := api.httpClient.Do(req)
+	if err == nil {
+		if err = decompressResponse(resp); err != nil {
+			resp = nil
+		}
+	}
+	api.interceptResponse(req, resp, time.Since(start), err)
 	if err != nil {
-		return nil, errors.Wrap(err, "HTTP request failed")
+		return nil, errors.Wrap(unknownOutcome(method, uri, err), "HTTP request failed")
 	}
 
 	return resp, nil
@@ -140,12 +520,30 @@ func (api *API) request(method, uri string, reqBody io.Reader) (*http.Response,
 // ResponseInfo contains a code and message returned by the API as errors or
 // informational messages inside the response.
 type ResponseInfo struct {
-	Code    int    `json:"code"`
-	Message string `json:"message"`
+	Code       int            `json:"code"`
+	Message    string         `json:"message"`
+	ErrorChain []ResponseInfo `json:"error_chain,omitempty"`
+}
+
+// String formats the message with its code and any chained errors.
+func (i ResponseInfo) String() string {
+	s := fmt.Sprintf("%s (%d)", i.Message, i.Code)
+	for _, c := range i.ErrorChain {
+		s += ": " + c.String()
+	}
+	return s
 }
 
-// Response is a template.  There will also be a result struct.  There will be a
-// unique response type for each response, which will include this type.
+// Response holds the success flag, errors and messages of the envelope around
+// every API response.
+//
+// The client decodes that envelope for every endpoint in one place and returns
+// only its result, so the per-endpoint types which embed Response alongside a
+// result (UserResponse, DNSRecordResponse, ZoneResponse and so on) are
+// deprecated. A generic Response[T] is not offered in their place as it would
+// need Go 1.18, above the Go 1.13 this package supports. DNSRecordResponse and
+// PurgeCacheResponse are still returned by CreateDNSRecord, PurgeCache and
+// PurgeEverything, whose signatures are kept for compatibility.
 type Response struct {
 	Success  bool           `json:"success"`
 	Errors   []ResponseInfo `json:"errors"`
@@ -154,10 +552,35 @@ type Response struct {
 
 // ResultInfo contains metadata about the Response.
 type ResultInfo struct {
-	Page    int `json:"page"`
-	PerPage int `json:"per_page"`
-	Count   int `json:"count"`
-	Total   int `json:"total_count"`
+	Page       int `json:"page"`
+	PerPage    int `json:"per_page"`
+	Count      int `json:"count"`
+	Total      int `json:"total_count"`
+	TotalPages int `json:"total_pages"`
+}
+
+// HasMorePages reports whether there are pages of results after this one.
+func (r ResultInfo) HasMorePages() bool {
+	if r.TotalPages > 0 {
+		return r.Page < r.TotalPages
+	}
+	return r.PerPage > 0 && r.Page*r.PerPage < r.Total
+}
+
+// PaginationOptions selects a page of results from a list endpoint. Zero
+// values use the API's defaults.
+type PaginationOptions struct {
+	Page    int
+	PerPage int
+}
+
+func (o PaginationOptions) encode(v url.Values) {
+	if o.Page > 0 {
+		v.Set("page", strconv.Itoa(o.Page))
+	}
+	if o.PerPage > 0 {
+		v.Set("per_page", strconv.Itoa(o.PerPage))
+	}
 }
 
 // User describes a user account.
@@ -179,6 +602,8 @@ type User struct {
 }
 
 // UserResponse wraps a response containing User accounts.
+//
+// Deprecated: see Response.
 type UserResponse struct {
 	Response
 	Result User `json:"result"`
@@ -211,15 +636,20 @@ type DNSRecord struct {
 }
 
 // DNSRecordResponse represents the response from the DNS endpoint.
+//
+// Deprecated: see Response.
 type DNSRecordResponse struct {
 	Response
 	Result DNSRecord `json:"result"`
 }
 
 // DNSListResponse represents the response from the list DNS records endpoint.
+//
+// Deprecated: see Response.
 type DNSListResponse struct {
 	Response
-	Result []DNSRecord `json:"result"`
+	Result     []DNSRecord `json:"result"`
+	ResultInfo ResultInfo  `json:"result_info"`
 }
 
 // KeylessSSL represents Keyless SSL configuration.
@@ -236,13 +666,17 @@ type KeylessSSL struct {
 }
 
 // KeylessSSLResponse represents the response from the Keyless SSL endpoint.
+//
+// Deprecated: see Response.
 type KeylessSSLResponse struct {
 	Response
-	Result []KeylessSSL `json:"result"`
+	Result     []KeylessSSL `json:"result"`
+	ResultInfo ResultInfo   `json:"result_info"`
 }
 
 // CustomPage represents a custom page configuration.
 type CustomPage struct {
+	ID             string    `json:"id"`
 	CreatedOn      string    `json:"created_on"`
 	ModifiedOn     time.Time `json:"modified_on"`
 	URL            string    `json:"url"`
@@ -253,9 +687,12 @@ type CustomPage struct {
 }
 
 // CustomPageResponse represents the response from the custom pages endpoint.
+//
+// Deprecated: see Response.
 type CustomPageResponse struct {
 	Response
-	Result []CustomPage `json:"result"`
+	Result     []CustomPage `json:"result"`
+	ResultInfo ResultInfo   `json:"result_info"`
 }
 
 // WAFPackage represents a WAF package configuration.
@@ -270,6 +707,8 @@ type WAFPackage struct {
 }
 
 // WAFPackagesResponse represents the response from the WAF packages endpoint.
+//
+// Deprecated: see Response.
 type WAFPackagesResponse struct {
 	Response
 	Result     []WAFPackage `json:"result"`
@@ -292,6 +731,8 @@ type WAFRule struct {
 }
 
 // WAFRulesResponse represents the response from the WAF rule endpoint.
+//
+// Deprecated: see Response.
 type WAFRulesResponse struct {
 	Response
 	Result     []WAFRule  `json:"result"`
@@ -306,6 +747,8 @@ type PurgeCacheRequest struct {
 }
 
 // PurgeCacheResponse represents the response from the purge endpoint.
+//
+// Deprecated: see Response.
 type PurgeCacheResponse struct {
 	Response
 }
@@ -317,6 +760,8 @@ type IPRanges struct {
 }
 
 // IPsResponse is the API response containing a list of IPs
+//
+// Deprecated: see Response.
 type IPsResponse struct {
 	Response
 	Result IPRanges `json:"result"`
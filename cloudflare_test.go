@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"sync"
+	"sync/atomic"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -58,3 +60,85 @@ func TestClient_Auth(t *testing.T) {
 
 	assert.NoError(t, err)
 }
+
+func TestMakeRequestResult(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/things", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [{"code": 1000, "message": "ok"}],
+			"result": [{"id": "a"}, {"id": "b"}],
+			"result_info": {"page": 1, "per_page": 2, "count": 2, "total_count": 4}
+		}`)
+	})
+
+	var result []struct {
+		ID string `json:"id"`
+	}
+	r, err := client.makeRequestResult("GET", "/things", nil, &result)
+	if assert.NoError(t, err) {
+		assert.Len(t, result, 2)
+		assert.Equal(t, "b", result[1].ID)
+		assert.True(t, r.Success)
+		assert.Equal(t, []ResponseInfo{{Code: 1000, Message: "ok"}}, r.Messages)
+		assert.Equal(t, 4, r.ResultInfo.Total)
+	}
+
+	_, err = client.makeRequestResult("GET", "/things", nil, nil)
+	assert.NoError(t, err)
+
+	var wrong string
+	_, err = client.makeRequestResult("GET", "/things", nil, &wrong)
+	assert.Error(t, err)
+}
+
+// TestConcurrentUse documents that a single client may be shared between
+// goroutines, including the state kept between requests. Run with -race.
+func TestConcurrentUse(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var requests int32
+	mux.HandleFunc("/zones/023e105f4ecef8ad9ca31a8372d0c353/pagerules", func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("content-type", "application/json")
+		w.Header().Set("ETag", `"v1"`)
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": [{"id": "abc", "status": "active"}]}`)
+	})
+
+	api, err := New("deadbeef", "cloudflare@example.org",
+		BaseURL(server.URL), ConditionalRequests(), RateLimit(1e6, 100))
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	const goroutines = 100
+	var wg sync.WaitGroup
+	errs := make(chan error, goroutines)
+	for i := 0; i < goroutines; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			rules, err := api.ListPageRules("023e105f4ecef8ad9ca31a8372d0c353")
+			if err == nil && (len(rules) != 1 || rules[0].ID != "abc") {
+				err = fmt.Errorf("unexpected page rules %+v", rules)
+			}
+			errs <- err
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		assert.NoError(t, err)
+	}
+	assert.Equal(t, int32(goroutines), atomic.LoadInt32(&requests))
+}
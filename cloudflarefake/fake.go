@@ -0,0 +1,1942 @@
+// Code generated by gen.go; DO NOT EDIT.
+
+// Package cloudflarefake provides a fake implementation of cloudflare.Client
+// for use in unit tests.
+package cloudflarefake
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/cloudflare/cloudflare-go"
+)
+
+// Fake implements cloudflare.Client. Each method calls the function in the
+// correspondingly named Func field if it is set, and otherwise returns zero
+// values along with a not-implemented error (if the method returns an error).
+type Fake struct {
+	AccessAuditLogsFunc                  func(accountID string, opts cloudflare.AccessAuditLogFilterOptions) ([]cloudflare.AccessAuditLogRecord, error)
+	AccountBillingProfileFunc            func(accountID string) (cloudflare.BillingProfile, error)
+	AccountIDByNameFunc                  func(name string) (string, error)
+	AccountSubscriptionsFunc             func(accountID string) ([]cloudflare.Subscription, error)
+	AccountsFunc                         func(name string) ([]cloudflare.Account, error)
+	AckQueueMessagesFunc                 func(accountID, queueID string, acks []string, retries []cloudflare.QueueRetry) (cloudflare.QueueAckResult, error)
+	AddAddressMapMembershipFunc          func(accountID, addressMapID string, membership cloudflare.AddressMapMembership) error
+	AddIPToAddressMapFunc                func(accountID, addressMapID, ip string) error
+	AddressMapFunc                       func(accountID, addressMapID string) (cloudflare.AddressMap, error)
+	AddressMapsFunc                      func(accountID string) ([]cloudflare.AddressMap, error)
+	AdvertisePrefixFunc                  func(accountID, prefixID string) (cloudflare.PrefixAdvertisementStatus, error)
+	ApplyZoneConfigFunc                  func(zoneID string, config cloudflare.ZoneConfig) (cloudflare.ZoneConfigPlan, error)
+	ApplyZoneConfigPlanFunc              func(plan cloudflare.ZoneConfigPlan) error
+	AvailableZonePlansFunc               func(zoneID string) ([]cloudflare.ZonePlan, error)
+	AvailableZoneRatePlansFunc           func(zoneID string) ([]cloudflare.AvailableRatePlan, error)
+	CancelRegistrarDomainTransferFunc    func(accountID, domainName string) ([]cloudflare.RegistrarDomain, error)
+	ChangePageRuleFunc                   func(zoneID, ruleID string, rule cloudflare.PageRule) (cloudflare.PageRule, error)
+	ConnectZoneRailgunFunc               func(zoneID, railgunID string) (cloudflare.ZoneRailgun, error)
+	CopyPageRulesFunc                    func(srcZoneID, dstZoneID string, opts cloudflare.PageRuleCopyOptions) ([]cloudflare.ZoneConfigChange, error)
+	CreateAccountSubscriptionFunc        func(accountID string, sub cloudflare.Subscription) (cloudflare.Subscription, error)
+	CreateAddressMapFunc                 func(accountID string, addressMap cloudflare.AddressMap) (cloudflare.AddressMap, error)
+	CreateDLPProfilesFunc                func(accountID string, profiles []cloudflare.DLPProfile) ([]cloudflare.DLPProfile, error)
+	CreateDNSRecordFunc                  func(zoneID string, rr cloudflare.DNSRecord) (*cloudflare.DNSRecordResponse, error)
+	CreateFirewallRulesFunc              func(zoneID string, rules []cloudflare.FirewallRule) ([]cloudflare.FirewallRule, error)
+	CreateImageDirectUploadFunc          func(accountID string, opts cloudflare.ImageDirectUploadOptions) (cloudflare.ImageDirectUpload, error)
+	CreateKeylessFunc                    func()
+	CreateOriginCertificateFunc          func(request cloudflare.OriginCACertificateRequest) (cloudflare.OriginCACertificate, error)
+	CreatePageRuleFunc                   func(zoneID string, rule cloudflare.PageRule) (cloudflare.PageRule, error)
+	CreateRailgunFunc                    func(name string) (cloudflare.Railgun, error)
+	CreateRateLimitFunc                  func(zoneID string, limit cloudflare.ZoneRateLimit) (cloudflare.ZoneRateLimit, error)
+	CreateSSLFunc                        func(zoneID string, options cloudflare.ZoneCustomSSLOptions) (cloudflare.ZoneCustomSSL, error)
+	CreateStreamLiveInputFunc            func(accountID string, input cloudflare.StreamLiveInput) (cloudflare.StreamLiveInput, error)
+	CreateStreamLiveInputOutputFunc      func(accountID, inputID string, output cloudflare.StreamLiveInputOutput) (cloudflare.StreamLiveInputOutput, error)
+	CreateUserAgentRuleFunc              func(zoneID string, rule cloudflare.UserAgentRule) (cloudflare.UserAgentRule, error)
+	CreateVirtualDNSFunc                 func(v *cloudflare.VirtualDNS) (*cloudflare.VirtualDNS, error)
+	CreateWorkerDeploymentFunc           func(accountID, scriptName string, deployment cloudflare.WorkerDeployment) (cloudflare.WorkerDeployment, error)
+	CreateZoneFunc                       func(name string, jumpstart bool, org cloudflare.Organization) (cloudflare.Zone, error)
+	CreateZoneAccessRuleFunc             func(zoneID string, rule cloudflare.AccessRule) (cloudflare.AccessRule, error)
+	CreateZoneHoldFunc                   func(zoneID string, includeSubdomains bool) (cloudflare.ZoneHold, error)
+	CreateZoneLockdownFunc               func(zoneID string, rule cloudflare.ZoneLockdown) (cloudflare.ZoneLockdown, error)
+	CreateZoneWithParamsFunc             func(params cloudflare.ZoneCreateParams) (cloudflare.Zone, error)
+	CustomErrorRulesFunc                 func(zoneID string) ([]cloudflare.CustomErrorRule, error)
+	CustomPageFunc                       func(options cloudflare.CustomPageOptions, pageID string) (cloudflare.CustomPage, error)
+	CustomPagesFunc                      func(options cloudflare.CustomPageOptions) ([]cloudflare.CustomPage, error)
+	DLPProfileFunc                       func(accountID, profileID string) (cloudflare.DLPProfile, error)
+	DLPProfilesFunc                      func(accountID string) ([]cloudflare.DLPProfile, error)
+	DNSRecordFunc                        func(zoneID, recordID string) (cloudflare.DNSRecord, error)
+	DNSRecordsFunc                       func(zoneID string, rr cloudflare.DNSRecord) ([]cloudflare.DNSRecord, error)
+	DNSRecordsPageFunc                   func(zoneID string, rr cloudflare.DNSRecord, opts cloudflare.PaginationOptions) ([]cloudflare.DNSRecord, cloudflare.ResultInfo, error)
+	DeleteAccountSubscriptionFunc        func(accountID, subscriptionID string) error
+	DeleteAddressMapFunc                 func(accountID, addressMapID string) error
+	DeleteDLPProfileFunc                 func(accountID, profileID string) error
+	DeleteDNSRecordFunc                  func(zoneID, recordID string) error
+	DeleteDNSRecordsFunc                 func(zoneID string, ids []string) map[string]error
+	DeleteFiltersFunc                    func(zoneID string, filterIDs []string) error
+	DeleteFirewallRulesFunc              func(zoneID string, ruleIDs []string) error
+	DeleteKeylessFunc                    func()
+	DeletePageRuleFunc                   func(zoneID, ruleID string) error
+	DeletePageRulesFunc                  func(zoneID string, ids []string) map[string]error
+	DeleteRailgunFunc                    func(railgunID string) error
+	DeleteRateLimitFunc                  func(zoneID, limitID string) error
+	DeleteSSLFunc                        func(zoneID, certificateID string) error
+	DeleteStreamLiveInputFunc            func(accountID, inputID string) error
+	DeleteStreamLiveInputOutputFunc      func(accountID, inputID, outputID string) error
+	DeleteStreamWebhookFunc              func(accountID string) error
+	DeleteUserAgentRuleFunc              func(zoneID, ruleID string) error
+	DeleteVirtualDNSFunc                 func(virtualDNSID string) error
+	DeleteZoneFunc                       func(zoneID string) (cloudflare.ZoneID, error)
+	DeleteZoneAccessRuleFunc             func(zoneID, ruleID string) error
+	DeleteZoneDNSSECFunc                 func(zoneID string) error
+	DeleteZoneHoldFunc                   func(zoneID string, holdAfter time.Time) (cloudflare.ZoneHold, error)
+	DeleteZoneLockdownFunc               func(zoneID, ruleID string) error
+	DeviceFunc                           func(accountID, deviceID string) (cloudflare.Device, error)
+	DeviceOverrideCodesFunc              func(accountID, deviceID string) (cloudflare.DeviceOverrideCodes, error)
+	DevicesFunc                          func(accountID string) ([]cloudflare.Device, error)
+	DisableRailgunFunc                   func(railgunID string) (cloudflare.Railgun, error)
+	DisconnectZoneRailgunFunc            func(zoneID, railgunID string) (cloudflare.ZoneRailgun, error)
+	EditZoneFunc                         func(zoneID string, zoneOpts cloudflare.ZoneOptions) (cloudflare.Zone, error)
+	EditZoneSettingsFunc                 func(zoneID string, settings []cloudflare.ZoneSetting) ([]cloudflare.ZoneSetting, error)
+	EnableRailgunFunc                    func(railgunID string) (cloudflare.Railgun, error)
+	EnableStreamLiveInputOutputFunc      func(accountID, inputID, outputID string, enabled bool) (cloudflare.StreamLiveInputOutput, error)
+	EnableZoneDNSSECFunc                 func(zoneID string) (cloudflare.ZoneDNSSEC, error)
+	ExportDNSRecordsFunc                 func(zoneID string, w io.Writer) (int64, error)
+	ExportPageRulesFunc                  func(zoneID string) (cloudflare.PageRuleExport, error)
+	ExportZoneFunc                       func(zoneID string) (cloudflare.ZoneExport, error)
+	FallbackDomainsFunc                  func(accountID, policyID string) ([]cloudflare.FallbackDomain, error)
+	FiltersFunc                          func(zoneID string) ([]cloudflare.Filter, error)
+	FindPageRuleByTargetFunc             func(zoneID, urlPattern string) (cloudflare.PageRule, error)
+	FirewallRulesFunc                    func(zoneID string) ([]cloudflare.FirewallRule, error)
+	ForEachZoneFunc                      func(opts cloudflare.ForEachZoneOptions, fn func(cloudflare.Zone) error) error
+	ForgetZoneIDsFunc                    func(zoneNames ...string)
+	GatewayAppTypesFunc                  func(accountID string) ([]cloudflare.GatewayAppType, error)
+	GatewayCategoriesFunc                func(accountID string) ([]cloudflare.GatewayCategory, error)
+	GetZoneHoldFunc                      func(zoneID string) (cloudflare.ZoneHold, error)
+	GetZoneSettingsFunc                  func(zoneID string) ([]cloudflare.ZoneSetting, error)
+	IPsFunc                              func() (cloudflare.IPRanges, error)
+	ImagesBatchTokenFunc                 func(accountID string) (cloudflare.ImagesBatchToken, error)
+	ImportDNSRecordsFunc                 func(zoneID string, r io.Reader, size int64) (cloudflare.DNSImportResult, error)
+	ImportPageRulesFunc                  func(zoneID string, doc cloudflare.PageRuleExport, replace bool) ([]cloudflare.ZoneConfigChange, error)
+	ImportZoneFunc                       func(zoneID string, export cloudflare.ZoneExport) ([]cloudflare.ZoneImportResult, error)
+	KeylessFunc                          func()
+	ListAllRateLimitsFunc                func(zoneID string) ([]cloudflare.ZoneRateLimit, error)
+	ListAvailablePageRuleSettingsFunc    func(zoneID string) ([]cloudflare.PageRuleSetting, error)
+	ListImagesFunc                       func(accountID string, opts cloudflare.ImagesListOptions) ([]cloudflare.Image, string, error)
+	ListKeylessFunc                      func()
+	ListPageRulesFunc                    func(zoneID string) ([]cloudflare.PageRule, error)
+	ListPageRulesWithParamsFunc          func(zoneID string, params cloudflare.PageRuleListParams) ([]cloudflare.PageRule, error)
+	ListRailgunsFunc                     func(options cloudflare.RailgunListOptions) ([]cloudflare.Railgun, error)
+	ListSSLFunc                          func(zoneID string) ([]cloudflare.ZoneCustomSSL, error)
+	ListUserAgentRulesFunc               func(zoneID string) ([]cloudflare.UserAgentRule, error)
+	ListVirtualDNSFunc                   func() ([]*cloudflare.VirtualDNS, error)
+	ListWAFPackagesFunc                  func(zoneID string) ([]cloudflare.WAFPackage, error)
+	ListWAFRulesFunc                     func(zoneID, packageID string) ([]cloudflare.WAFRule, error)
+	ListZonesFunc                        func(z ...string) ([]cloudflare.Zone, error)
+	ListZonesPageFunc                    func(opts cloudflare.PaginationOptions) ([]cloudflare.Zone, cloudflare.ResultInfo, error)
+	ListZonesWithParamsFunc              func(params cloudflare.ZoneListParams) ([]cloudflare.Zone, error)
+	OriginCertificateFunc                func(certificateID string) (cloudflare.OriginCACertificate, error)
+	OriginCertificatesFunc               func(zoneID string) ([]cloudflare.OriginCACertificate, error)
+	PageRuleFunc                         func(zoneID, ruleID string) (cloudflare.PageRule, error)
+	PaginateFunc                         func(path string, query url.Values, fn func(item json.RawMessage) error) error
+	PauseAllPageRulesFunc                func(zoneID string) ([]string, error)
+	PlanZoneConfigFunc                   func(zoneID string, config cloudflare.ZoneConfig) (cloudflare.ZoneConfigPlan, error)
+	PrefixAdvertisementStatusFunc        func(accountID, prefixID string) (cloudflare.PrefixAdvertisementStatus, error)
+	PullQueueMessagesFunc                func(accountID, queueID string, opts cloudflare.QueuePullOptions) ([]cloudflare.QueueMessage, error)
+	PurgeCacheFunc                       func(zoneID string, pcr cloudflare.PurgeCacheRequest) (cloudflare.PurgeCacheResponse, error)
+	PurgeEverythingFunc                  func(zoneID string) (cloudflare.PurgeCacheResponse, error)
+	RailgunDetailsFunc                   func(railgunID string) (cloudflare.Railgun, error)
+	RailgunZonesFunc                     func(railgunID string) ([]cloudflare.Zone, error)
+	RawFunc                              func(ctx context.Context, method, path string, body interface{}, header http.Header) ([]byte, error)
+	RegistrarDomainFunc                  func(accountID, domainName string) (cloudflare.RegistrarDomain, error)
+	RegistrarDomainsFunc                 func(accountID string) ([]cloudflare.RegistrarDomain, error)
+	RemoveAddressMapMembershipFunc       func(accountID, addressMapID string, membership cloudflare.AddressMapMembership) error
+	RemoveIPFromAddressMapFunc           func(accountID, addressMapID, ip string) error
+	ReorderPageRulesFunc                 func(zoneID string, orderedIDs []string) error
+	ReprioritizeSSLFunc                  func(zoneID string, p []cloudflare.ZoneCustomSSLPriority) ([]cloudflare.ZoneCustomSSL, error)
+	ResumeAllPageRulesFunc               func(zoneID string) ([]string, error)
+	RevokeDevicesFunc                    func(accountID string, deviceIDs []string) error
+	RevokeOriginCertificateFunc          func(certificateID string) (string, error)
+	SSLDetailsFunc                       func(zoneID, certificateID string) (cloudflare.ZoneCustomSSL, error)
+	SetCustomErrorRulesFunc              func(zoneID string, rules []cloudflare.CustomErrorRule) ([]cloudflare.CustomErrorRule, error)
+	SetPageRuleStatusFunc                func(zoneID, ruleID string, status cloudflare.PageRuleStatus) (cloudflare.PageRule, error)
+	SetPageRulesStatusFunc               func(zoneID string, ids []string, status cloudflare.PageRuleStatus) map[string]error
+	SetStreamWebhookFunc                 func(accountID, notificationURL string) (cloudflare.StreamWebhook, error)
+	SetZoneDevelopmentModeFunc           func(zoneID string, on bool) (cloudflare.ZoneDevelopmentMode, error)
+	SplitTunnelFunc                      func(accountID, policyID, mode string) ([]cloudflare.SplitTunnel, error)
+	StreamDNSRecordsFunc                 func(zoneID string, rr cloudflare.DNSRecord, fn func(cloudflare.DNSRecord) error) error
+	StreamLiveInputFunc                  func(accountID, inputID string) (cloudflare.StreamLiveInput, error)
+	StreamLiveInputOutputsFunc           func(accountID, inputID string) ([]cloudflare.StreamLiveInputOutput, error)
+	StreamLiveInputsFunc                 func(accountID string) ([]cloudflare.StreamLiveInput, error)
+	StreamPageRulesFunc                  func(zoneID string, fn func(cloudflare.PageRule) error) error
+	StreamWebhookFunc                    func(accountID string) (cloudflare.StreamWebhook, error)
+	StreamZoneAnalyticsByColocationFunc  func(zoneID string, options cloudflare.ZoneAnalyticsOptions, fn func(cloudflare.ZoneAnalyticsColocation) error) error
+	SyncDNSRecordsFunc                   func(zoneID string, desired []cloudflare.DNSRecord, opts cloudflare.DNSSyncOptions) ([]cloudflare.ZoneConfigChange, error)
+	SyncFirewallRulesFunc                func(zoneID string, desired []cloudflare.FirewallRule, opts cloudflare.FirewallSyncOptions) ([]cloudflare.ZoneConfigChange, error)
+	SyncPageRulesFunc                    func(zoneID string, desired []cloudflare.PageRule, opts cloudflare.PageRuleSyncOptions) ([]cloudflare.ZoneConfigChange, error)
+	SyncZoneAccessRulesFunc              func(zoneID string, desired []cloudflare.AccessRule, opts cloudflare.FirewallSyncOptions) ([]cloudflare.ZoneConfigChange, error)
+	TestRailgunConnectionFunc            func(zoneID, railgunID string) (cloudflare.RailgunDiagnosis, error)
+	TransferRegistrarDomainFunc          func(accountID, domainName, authCode string) ([]cloudflare.RegistrarDomain, error)
+	UnrevokeDevicesFunc                  func(accountID string, deviceIDs []string) error
+	UpdateAccountSubscriptionFunc        func(accountID, subscriptionID string, sub cloudflare.Subscription) (cloudflare.Subscription, error)
+	UpdateAddressMapFunc                 func(accountID, addressMapID string, addressMap cloudflare.AddressMap) (cloudflare.AddressMap, error)
+	UpdateCustomPageFunc                 func(options cloudflare.CustomPageOptions, pageID string, params cloudflare.CustomPageParameters) (cloudflare.CustomPage, error)
+	UpdateDLPProfileFunc                 func(accountID string, profile cloudflare.DLPProfile) (cloudflare.DLPProfile, error)
+	UpdateDNSRecordFunc                  func(zoneID, recordID string, rr cloudflare.DNSRecord) error
+	UpdateFallbackDomainsFunc            func(accountID, policyID string, domains []cloudflare.FallbackDomain) ([]cloudflare.FallbackDomain, error)
+	UpdateFiltersFunc                    func(zoneID string, filters []cloudflare.Filter) ([]cloudflare.Filter, error)
+	UpdateFirewallRulesFunc              func(zoneID string, rules []cloudflare.FirewallRule) ([]cloudflare.FirewallRule, error)
+	UpdateKeylessFunc                    func()
+	UpdatePageRuleFunc                   func(zoneID, ruleID string, rule cloudflare.PageRule) (cloudflare.PageRule, error)
+	UpdatePrefixAdvertisementStatusFunc  func(accountID, prefixID string, advertised bool) (cloudflare.PrefixAdvertisementStatus, error)
+	UpdateRateLimitFunc                  func(zoneID, limitID string, limit cloudflare.ZoneRateLimit) (cloudflare.ZoneRateLimit, error)
+	UpdateSSLFunc                        func(zoneID, certificateID string, options cloudflare.ZoneCustomSSLOptions) (cloudflare.ZoneCustomSSL, error)
+	UpdateSplitTunnelFunc                func(accountID, policyID, mode string, tunnels []cloudflare.SplitTunnel) ([]cloudflare.SplitTunnel, error)
+	UpdateStreamLiveInputFunc            func(accountID, inputID string, input cloudflare.StreamLiveInput) (cloudflare.StreamLiveInput, error)
+	UpdateUserFunc                       func() (cloudflare.User, error)
+	UpdateUserAgentRuleFunc              func(zoneID, ruleID string, rule cloudflare.UserAgentRule) (cloudflare.UserAgentRule, error)
+	UpdateVirtualDNSFunc                 func(virtualDNSID string, vv cloudflare.VirtualDNS) error
+	UpdateWorkerScriptSettingsFunc       func(accountID, scriptName string, settings cloudflare.WorkerScriptSettings) (cloudflare.WorkerScriptSettings, error)
+	UpdateZoneAccessRuleFunc             func(zoneID, ruleID string, rule cloudflare.AccessRule) (cloudflare.AccessRule, error)
+	UpdateZoneLockdownFunc               func(zoneID, ruleID string, rule cloudflare.ZoneLockdown) (cloudflare.ZoneLockdown, error)
+	UpdateZoneRulesetPhaseEntrypointFunc func(zoneID, phase string, rs cloudflare.Ruleset) (cloudflare.Ruleset, error)
+	UpdateZoneSettingsFunc               func(zoneID string, settings cloudflare.ZoneSettings) (cloudflare.ZoneSettings, error)
+	UpdateZoneSubscriptionFunc           func(zoneID string, sub cloudflare.Subscription) (cloudflare.Subscription, error)
+	UserDetailsFunc                      func() (cloudflare.User, error)
+	VerifyAPITokenFunc                   func() (cloudflare.APITokenVerification, error)
+	VirtualDNSFunc                       func(virtualDNSID string) (*cloudflare.VirtualDNS, error)
+	WaitForPageRuleStatusFunc            func(ctx context.Context, zoneID, ruleID string, status cloudflare.PageRuleStatus) (cloudflare.PageRule, error)
+	WaitForZoneActiveFunc                func(ctx context.Context, zoneID string) (cloudflare.Zone, error)
+	WithdrawPrefixFunc                   func(accountID, prefixID string) (cloudflare.PrefixAdvertisementStatus, error)
+	WorkerDeploymentFunc                 func(accountID, scriptName, deploymentID string) (cloudflare.WorkerDeployment, error)
+	WorkerDeploymentsFunc                func(accountID, scriptName string) ([]cloudflare.WorkerDeployment, error)
+	WorkerScriptSettingsFunc             func(accountID, scriptName string) (cloudflare.WorkerScriptSettings, error)
+	WorkerVersionsFunc                   func(accountID, scriptName string) ([]cloudflare.WorkerVersion, error)
+	ZoneAccessRulesFunc                  func(zoneID string) ([]cloudflare.AccessRule, error)
+	ZoneActivationCheckFunc              func(zoneID string) (cloudflare.Response, error)
+	ZoneAnalyticsByColocationFunc        func(zoneID string, options cloudflare.ZoneAnalyticsOptions) ([]cloudflare.ZoneAnalyticsColocation, error)
+	ZoneAnalyticsDashboardFunc           func(zoneID string, options cloudflare.ZoneAnalyticsOptions) (cloudflare.ZoneAnalyticsData, error)
+	ZoneAnalyticsDashboardRawFunc        func(zoneID string, options cloudflare.ZoneAnalyticsOptions) (json.RawMessage, error)
+	ZoneDNSSECStatusFunc                 func(zoneID string) (cloudflare.ZoneDNSSEC, error)
+	ZoneDetailsFunc                      func(zoneID string) (cloudflare.Zone, error)
+	ZoneDevelopmentModeFunc              func(zoneID string) (cloudflare.ZoneDevelopmentMode, error)
+	ZoneIDByNameFunc                     func(zoneName string) (string, error)
+	ZoneInventoryFunc                    func(opts cloudflare.ZoneInventoryOptions) ([]cloudflare.AccountZones, error)
+	ZoneLockdownsFunc                    func(zoneID string) ([]cloudflare.ZoneLockdown, error)
+	ZonePlanDetailsFunc                  func(zoneID, planID string) (cloudflare.ZonePlan, error)
+	ZoneRailgunDetailsFunc               func(zoneID, railgunID string) (cloudflare.ZoneRailgun, error)
+	ZoneRailgunsFunc                     func(zoneID string) ([]cloudflare.ZoneRailgun, error)
+	ZoneRulesetPhaseEntrypointFunc       func(zoneID, phase string) (cloudflare.Ruleset, error)
+	ZoneSetPausedFunc                    func(zoneID string, paused bool) (cloudflare.Zone, error)
+	ZoneSetPlanFunc                      func(zoneID string, plan cloudflare.ZonePlan) (cloudflare.Zone, error)
+	ZoneSetVanityNSFunc                  func(zoneID string, ns []string) (cloudflare.Zone, error)
+	ZoneSettingsFunc                     func(zoneID string) (cloudflare.ZoneSettings, error)
+	ZoneSubscriptionFunc                 func(zoneID string) (cloudflare.Subscription, error)
+}
+
+var _ cloudflare.Client = &Fake{}
+
+// AccessAuditLogs calls f.AccessAuditLogsFunc.
+func (f *Fake) AccessAuditLogs(accountID string, opts cloudflare.AccessAuditLogFilterOptions) ([]cloudflare.AccessAuditLogRecord, error) {
+	if f.AccessAuditLogsFunc != nil {
+		return f.AccessAuditLogsFunc(accountID, opts)
+	}
+	return nil, fmt.Errorf("cloudflarefake: AccessAuditLogs not implemented")
+}
+
+// AccountBillingProfile calls f.AccountBillingProfileFunc.
+func (f *Fake) AccountBillingProfile(accountID string) (cloudflare.BillingProfile, error) {
+	if f.AccountBillingProfileFunc != nil {
+		return f.AccountBillingProfileFunc(accountID)
+	}
+	return cloudflare.BillingProfile{}, fmt.Errorf("cloudflarefake: AccountBillingProfile not implemented")
+}
+
+// AccountIDByName calls f.AccountIDByNameFunc.
+func (f *Fake) AccountIDByName(name string) (string, error) {
+	if f.AccountIDByNameFunc != nil {
+		return f.AccountIDByNameFunc(name)
+	}
+	return "", fmt.Errorf("cloudflarefake: AccountIDByName not implemented")
+}
+
+// AccountSubscriptions calls f.AccountSubscriptionsFunc.
+func (f *Fake) AccountSubscriptions(accountID string) ([]cloudflare.Subscription, error) {
+	if f.AccountSubscriptionsFunc != nil {
+		return f.AccountSubscriptionsFunc(accountID)
+	}
+	return nil, fmt.Errorf("cloudflarefake: AccountSubscriptions not implemented")
+}
+
+// Accounts calls f.AccountsFunc.
+func (f *Fake) Accounts(name string) ([]cloudflare.Account, error) {
+	if f.AccountsFunc != nil {
+		return f.AccountsFunc(name)
+	}
+	return nil, fmt.Errorf("cloudflarefake: Accounts not implemented")
+}
+
+// AckQueueMessages calls f.AckQueueMessagesFunc.
+func (f *Fake) AckQueueMessages(accountID, queueID string, acks []string, retries []cloudflare.QueueRetry) (cloudflare.QueueAckResult, error) {
+	if f.AckQueueMessagesFunc != nil {
+		return f.AckQueueMessagesFunc(accountID, queueID, acks, retries)
+	}
+	return cloudflare.QueueAckResult{}, fmt.Errorf("cloudflarefake: AckQueueMessages not implemented")
+}
+
+// AddAddressMapMembership calls f.AddAddressMapMembershipFunc.
+func (f *Fake) AddAddressMapMembership(accountID, addressMapID string, membership cloudflare.AddressMapMembership) error {
+	if f.AddAddressMapMembershipFunc != nil {
+		return f.AddAddressMapMembershipFunc(accountID, addressMapID, membership)
+	}
+	return fmt.Errorf("cloudflarefake: AddAddressMapMembership not implemented")
+}
+
+// AddIPToAddressMap calls f.AddIPToAddressMapFunc.
+func (f *Fake) AddIPToAddressMap(accountID, addressMapID, ip string) error {
+	if f.AddIPToAddressMapFunc != nil {
+		return f.AddIPToAddressMapFunc(accountID, addressMapID, ip)
+	}
+	return fmt.Errorf("cloudflarefake: AddIPToAddressMap not implemented")
+}
+
+// AddressMap calls f.AddressMapFunc.
+func (f *Fake) AddressMap(accountID, addressMapID string) (cloudflare.AddressMap, error) {
+	if f.AddressMapFunc != nil {
+		return f.AddressMapFunc(accountID, addressMapID)
+	}
+	return cloudflare.AddressMap{}, fmt.Errorf("cloudflarefake: AddressMap not implemented")
+}
+
+// AddressMaps calls f.AddressMapsFunc.
+func (f *Fake) AddressMaps(accountID string) ([]cloudflare.AddressMap, error) {
+	if f.AddressMapsFunc != nil {
+		return f.AddressMapsFunc(accountID)
+	}
+	return nil, fmt.Errorf("cloudflarefake: AddressMaps not implemented")
+}
+
+// AdvertisePrefix calls f.AdvertisePrefixFunc.
+func (f *Fake) AdvertisePrefix(accountID, prefixID string) (cloudflare.PrefixAdvertisementStatus, error) {
+	if f.AdvertisePrefixFunc != nil {
+		return f.AdvertisePrefixFunc(accountID, prefixID)
+	}
+	return cloudflare.PrefixAdvertisementStatus{}, fmt.Errorf("cloudflarefake: AdvertisePrefix not implemented")
+}
+
+// ApplyZoneConfig calls f.ApplyZoneConfigFunc.
+func (f *Fake) ApplyZoneConfig(zoneID string, config cloudflare.ZoneConfig) (cloudflare.ZoneConfigPlan, error) {
+	if f.ApplyZoneConfigFunc != nil {
+		return f.ApplyZoneConfigFunc(zoneID, config)
+	}
+	return cloudflare.ZoneConfigPlan{}, fmt.Errorf("cloudflarefake: ApplyZoneConfig not implemented")
+}
+
+// ApplyZoneConfigPlan calls f.ApplyZoneConfigPlanFunc.
+func (f *Fake) ApplyZoneConfigPlan(plan cloudflare.ZoneConfigPlan) error {
+	if f.ApplyZoneConfigPlanFunc != nil {
+		return f.ApplyZoneConfigPlanFunc(plan)
+	}
+	return fmt.Errorf("cloudflarefake: ApplyZoneConfigPlan not implemented")
+}
+
+// AvailableZonePlans calls f.AvailableZonePlansFunc.
+func (f *Fake) AvailableZonePlans(zoneID string) ([]cloudflare.ZonePlan, error) {
+	if f.AvailableZonePlansFunc != nil {
+		return f.AvailableZonePlansFunc(zoneID)
+	}
+	return nil, fmt.Errorf("cloudflarefake: AvailableZonePlans not implemented")
+}
+
+// AvailableZoneRatePlans calls f.AvailableZoneRatePlansFunc.
+func (f *Fake) AvailableZoneRatePlans(zoneID string) ([]cloudflare.AvailableRatePlan, error) {
+	if f.AvailableZoneRatePlansFunc != nil {
+		return f.AvailableZoneRatePlansFunc(zoneID)
+	}
+	return nil, fmt.Errorf("cloudflarefake: AvailableZoneRatePlans not implemented")
+}
+
+// CancelRegistrarDomainTransfer calls f.CancelRegistrarDomainTransferFunc.
+func (f *Fake) CancelRegistrarDomainTransfer(accountID, domainName string) ([]cloudflare.RegistrarDomain, error) {
+	if f.CancelRegistrarDomainTransferFunc != nil {
+		return f.CancelRegistrarDomainTransferFunc(accountID, domainName)
+	}
+	return nil, fmt.Errorf("cloudflarefake: CancelRegistrarDomainTransfer not implemented")
+}
+
+// ChangePageRule calls f.ChangePageRuleFunc.
+func (f *Fake) ChangePageRule(zoneID, ruleID string, rule cloudflare.PageRule) (cloudflare.PageRule, error) {
+	if f.ChangePageRuleFunc != nil {
+		return f.ChangePageRuleFunc(zoneID, ruleID, rule)
+	}
+	return cloudflare.PageRule{}, fmt.Errorf("cloudflarefake: ChangePageRule not implemented")
+}
+
+// ConnectZoneRailgun calls f.ConnectZoneRailgunFunc.
+func (f *Fake) ConnectZoneRailgun(zoneID, railgunID string) (cloudflare.ZoneRailgun, error) {
+	if f.ConnectZoneRailgunFunc != nil {
+		return f.ConnectZoneRailgunFunc(zoneID, railgunID)
+	}
+	return cloudflare.ZoneRailgun{}, fmt.Errorf("cloudflarefake: ConnectZoneRailgun not implemented")
+}
+
+// CopyPageRules calls f.CopyPageRulesFunc.
+func (f *Fake) CopyPageRules(srcZoneID, dstZoneID string, opts cloudflare.PageRuleCopyOptions) ([]cloudflare.ZoneConfigChange, error) {
+	if f.CopyPageRulesFunc != nil {
+		return f.CopyPageRulesFunc(srcZoneID, dstZoneID, opts)
+	}
+	return nil, fmt.Errorf("cloudflarefake: CopyPageRules not implemented")
+}
+
+// CreateAccountSubscription calls f.CreateAccountSubscriptionFunc.
+func (f *Fake) CreateAccountSubscription(accountID string, sub cloudflare.Subscription) (cloudflare.Subscription, error) {
+	if f.CreateAccountSubscriptionFunc != nil {
+		return f.CreateAccountSubscriptionFunc(accountID, sub)
+	}
+	return cloudflare.Subscription{}, fmt.Errorf("cloudflarefake: CreateAccountSubscription not implemented")
+}
+
+// CreateAddressMap calls f.CreateAddressMapFunc.
+func (f *Fake) CreateAddressMap(accountID string, addressMap cloudflare.AddressMap) (cloudflare.AddressMap, error) {
+	if f.CreateAddressMapFunc != nil {
+		return f.CreateAddressMapFunc(accountID, addressMap)
+	}
+	return cloudflare.AddressMap{}, fmt.Errorf("cloudflarefake: CreateAddressMap not implemented")
+}
+
+// CreateDLPProfiles calls f.CreateDLPProfilesFunc.
+func (f *Fake) CreateDLPProfiles(accountID string, profiles []cloudflare.DLPProfile) ([]cloudflare.DLPProfile, error) {
+	if f.CreateDLPProfilesFunc != nil {
+		return f.CreateDLPProfilesFunc(accountID, profiles)
+	}
+	return nil, fmt.Errorf("cloudflarefake: CreateDLPProfiles not implemented")
+}
+
+// CreateDNSRecord calls f.CreateDNSRecordFunc.
+func (f *Fake) CreateDNSRecord(zoneID string, rr cloudflare.DNSRecord) (*cloudflare.DNSRecordResponse, error) {
+	if f.CreateDNSRecordFunc != nil {
+		return f.CreateDNSRecordFunc(zoneID, rr)
+	}
+	return nil, fmt.Errorf("cloudflarefake: CreateDNSRecord not implemented")
+}
+
+// CreateFirewallRules calls f.CreateFirewallRulesFunc.
+func (f *Fake) CreateFirewallRules(zoneID string, rules []cloudflare.FirewallRule) ([]cloudflare.FirewallRule, error) {
+	if f.CreateFirewallRulesFunc != nil {
+		return f.CreateFirewallRulesFunc(zoneID, rules)
+	}
+	return nil, fmt.Errorf("cloudflarefake: CreateFirewallRules not implemented")
+}
+
+// CreateImageDirectUpload calls f.CreateImageDirectUploadFunc.
+func (f *Fake) CreateImageDirectUpload(accountID string, opts cloudflare.ImageDirectUploadOptions) (cloudflare.ImageDirectUpload, error) {
+	if f.CreateImageDirectUploadFunc != nil {
+		return f.CreateImageDirectUploadFunc(accountID, opts)
+	}
+	return cloudflare.ImageDirectUpload{}, fmt.Errorf("cloudflarefake: CreateImageDirectUpload not implemented")
+}
+
+// CreateKeyless calls f.CreateKeylessFunc.
+func (f *Fake) CreateKeyless() {
+	if f.CreateKeylessFunc != nil {
+		f.CreateKeylessFunc()
+		return
+	}
+}
+
+// CreateOriginCertificate calls f.CreateOriginCertificateFunc.
+func (f *Fake) CreateOriginCertificate(request cloudflare.OriginCACertificateRequest) (cloudflare.OriginCACertificate, error) {
+	if f.CreateOriginCertificateFunc != nil {
+		return f.CreateOriginCertificateFunc(request)
+	}
+	return cloudflare.OriginCACertificate{}, fmt.Errorf("cloudflarefake: CreateOriginCertificate not implemented")
+}
+
+// CreatePageRule calls f.CreatePageRuleFunc.
+func (f *Fake) CreatePageRule(zoneID string, rule cloudflare.PageRule) (cloudflare.PageRule, error) {
+	if f.CreatePageRuleFunc != nil {
+		return f.CreatePageRuleFunc(zoneID, rule)
+	}
+	return cloudflare.PageRule{}, fmt.Errorf("cloudflarefake: CreatePageRule not implemented")
+}
+
+// CreateRailgun calls f.CreateRailgunFunc.
+func (f *Fake) CreateRailgun(name string) (cloudflare.Railgun, error) {
+	if f.CreateRailgunFunc != nil {
+		return f.CreateRailgunFunc(name)
+	}
+	return cloudflare.Railgun{}, fmt.Errorf("cloudflarefake: CreateRailgun not implemented")
+}
+
+// CreateRateLimit calls f.CreateRateLimitFunc.
+func (f *Fake) CreateRateLimit(zoneID string, limit cloudflare.ZoneRateLimit) (cloudflare.ZoneRateLimit, error) {
+	if f.CreateRateLimitFunc != nil {
+		return f.CreateRateLimitFunc(zoneID, limit)
+	}
+	return cloudflare.ZoneRateLimit{}, fmt.Errorf("cloudflarefake: CreateRateLimit not implemented")
+}
+
+// CreateSSL calls f.CreateSSLFunc.
+func (f *Fake) CreateSSL(zoneID string, options cloudflare.ZoneCustomSSLOptions) (cloudflare.ZoneCustomSSL, error) {
+	if f.CreateSSLFunc != nil {
+		return f.CreateSSLFunc(zoneID, options)
+	}
+	return cloudflare.ZoneCustomSSL{}, fmt.Errorf("cloudflarefake: CreateSSL not implemented")
+}
+
+// CreateStreamLiveInput calls f.CreateStreamLiveInputFunc.
+func (f *Fake) CreateStreamLiveInput(accountID string, input cloudflare.StreamLiveInput) (cloudflare.StreamLiveInput, error) {
+	if f.CreateStreamLiveInputFunc != nil {
+		return f.CreateStreamLiveInputFunc(accountID, input)
+	}
+	return cloudflare.StreamLiveInput{}, fmt.Errorf("cloudflarefake: CreateStreamLiveInput not implemented")
+}
+
+// CreateStreamLiveInputOutput calls f.CreateStreamLiveInputOutputFunc.
+func (f *Fake) CreateStreamLiveInputOutput(accountID, inputID string, output cloudflare.StreamLiveInputOutput) (cloudflare.StreamLiveInputOutput, error) {
+	if f.CreateStreamLiveInputOutputFunc != nil {
+		return f.CreateStreamLiveInputOutputFunc(accountID, inputID, output)
+	}
+	return cloudflare.StreamLiveInputOutput{}, fmt.Errorf("cloudflarefake: CreateStreamLiveInputOutput not implemented")
+}
+
+// CreateUserAgentRule calls f.CreateUserAgentRuleFunc.
+func (f *Fake) CreateUserAgentRule(zoneID string, rule cloudflare.UserAgentRule) (cloudflare.UserAgentRule, error) {
+	if f.CreateUserAgentRuleFunc != nil {
+		return f.CreateUserAgentRuleFunc(zoneID, rule)
+	}
+	return cloudflare.UserAgentRule{}, fmt.Errorf("cloudflarefake: CreateUserAgentRule not implemented")
+}
+
+// CreateVirtualDNS calls f.CreateVirtualDNSFunc.
+func (f *Fake) CreateVirtualDNS(v *cloudflare.VirtualDNS) (*cloudflare.VirtualDNS, error) {
+	if f.CreateVirtualDNSFunc != nil {
+		return f.CreateVirtualDNSFunc(v)
+	}
+	return nil, fmt.Errorf("cloudflarefake: CreateVirtualDNS not implemented")
+}
+
+// CreateWorkerDeployment calls f.CreateWorkerDeploymentFunc.
+func (f *Fake) CreateWorkerDeployment(accountID, scriptName string, deployment cloudflare.WorkerDeployment) (cloudflare.WorkerDeployment, error) {
+	if f.CreateWorkerDeploymentFunc != nil {
+		return f.CreateWorkerDeploymentFunc(accountID, scriptName, deployment)
+	}
+	return cloudflare.WorkerDeployment{}, fmt.Errorf("cloudflarefake: CreateWorkerDeployment not implemented")
+}
+
+// CreateZone calls f.CreateZoneFunc.
+func (f *Fake) CreateZone(name string, jumpstart bool, org cloudflare.Organization) (cloudflare.Zone, error) {
+	if f.CreateZoneFunc != nil {
+		return f.CreateZoneFunc(name, jumpstart, org)
+	}
+	return cloudflare.Zone{}, fmt.Errorf("cloudflarefake: CreateZone not implemented")
+}
+
+// CreateZoneAccessRule calls f.CreateZoneAccessRuleFunc.
+func (f *Fake) CreateZoneAccessRule(zoneID string, rule cloudflare.AccessRule) (cloudflare.AccessRule, error) {
+	if f.CreateZoneAccessRuleFunc != nil {
+		return f.CreateZoneAccessRuleFunc(zoneID, rule)
+	}
+	return cloudflare.AccessRule{}, fmt.Errorf("cloudflarefake: CreateZoneAccessRule not implemented")
+}
+
+// CreateZoneHold calls f.CreateZoneHoldFunc.
+func (f *Fake) CreateZoneHold(zoneID string, includeSubdomains bool) (cloudflare.ZoneHold, error) {
+	if f.CreateZoneHoldFunc != nil {
+		return f.CreateZoneHoldFunc(zoneID, includeSubdomains)
+	}
+	return cloudflare.ZoneHold{}, fmt.Errorf("cloudflarefake: CreateZoneHold not implemented")
+}
+
+// CreateZoneLockdown calls f.CreateZoneLockdownFunc.
+func (f *Fake) CreateZoneLockdown(zoneID string, rule cloudflare.ZoneLockdown) (cloudflare.ZoneLockdown, error) {
+	if f.CreateZoneLockdownFunc != nil {
+		return f.CreateZoneLockdownFunc(zoneID, rule)
+	}
+	return cloudflare.ZoneLockdown{}, fmt.Errorf("cloudflarefake: CreateZoneLockdown not implemented")
+}
+
+// CreateZoneWithParams calls f.CreateZoneWithParamsFunc.
+func (f *Fake) CreateZoneWithParams(params cloudflare.ZoneCreateParams) (cloudflare.Zone, error) {
+	if f.CreateZoneWithParamsFunc != nil {
+		return f.CreateZoneWithParamsFunc(params)
+	}
+	return cloudflare.Zone{}, fmt.Errorf("cloudflarefake: CreateZoneWithParams not implemented")
+}
+
+// CustomErrorRules calls f.CustomErrorRulesFunc.
+func (f *Fake) CustomErrorRules(zoneID string) ([]cloudflare.CustomErrorRule, error) {
+	if f.CustomErrorRulesFunc != nil {
+		return f.CustomErrorRulesFunc(zoneID)
+	}
+	return nil, fmt.Errorf("cloudflarefake: CustomErrorRules not implemented")
+}
+
+// CustomPage calls f.CustomPageFunc.
+func (f *Fake) CustomPage(options cloudflare.CustomPageOptions, pageID string) (cloudflare.CustomPage, error) {
+	if f.CustomPageFunc != nil {
+		return f.CustomPageFunc(options, pageID)
+	}
+	return cloudflare.CustomPage{}, fmt.Errorf("cloudflarefake: CustomPage not implemented")
+}
+
+// CustomPages calls f.CustomPagesFunc.
+func (f *Fake) CustomPages(options cloudflare.CustomPageOptions) ([]cloudflare.CustomPage, error) {
+	if f.CustomPagesFunc != nil {
+		return f.CustomPagesFunc(options)
+	}
+	return nil, fmt.Errorf("cloudflarefake: CustomPages not implemented")
+}
+
+// DLPProfile calls f.DLPProfileFunc.
+func (f *Fake) DLPProfile(accountID, profileID string) (cloudflare.DLPProfile, error) {
+	if f.DLPProfileFunc != nil {
+		return f.DLPProfileFunc(accountID, profileID)
+	}
+	return cloudflare.DLPProfile{}, fmt.Errorf("cloudflarefake: DLPProfile not implemented")
+}
+
+// DLPProfiles calls f.DLPProfilesFunc.
+func (f *Fake) DLPProfiles(accountID string) ([]cloudflare.DLPProfile, error) {
+	if f.DLPProfilesFunc != nil {
+		return f.DLPProfilesFunc(accountID)
+	}
+	return nil, fmt.Errorf("cloudflarefake: DLPProfiles not implemented")
+}
+
+// DNSRecord calls f.DNSRecordFunc.
+func (f *Fake) DNSRecord(zoneID, recordID string) (cloudflare.DNSRecord, error) {
+	if f.DNSRecordFunc != nil {
+		return f.DNSRecordFunc(zoneID, recordID)
+	}
+	return cloudflare.DNSRecord{}, fmt.Errorf("cloudflarefake: DNSRecord not implemented")
+}
+
+// DNSRecords calls f.DNSRecordsFunc.
+func (f *Fake) DNSRecords(zoneID string, rr cloudflare.DNSRecord) ([]cloudflare.DNSRecord, error) {
+	if f.DNSRecordsFunc != nil {
+		return f.DNSRecordsFunc(zoneID, rr)
+	}
+	return nil, fmt.Errorf("cloudflarefake: DNSRecords not implemented")
+}
+
+// DNSRecordsPage calls f.DNSRecordsPageFunc.
+func (f *Fake) DNSRecordsPage(zoneID string, rr cloudflare.DNSRecord, opts cloudflare.PaginationOptions) ([]cloudflare.DNSRecord, cloudflare.ResultInfo, error) {
+	if f.DNSRecordsPageFunc != nil {
+		return f.DNSRecordsPageFunc(zoneID, rr, opts)
+	}
+	return nil, cloudflare.ResultInfo{}, fmt.Errorf("cloudflarefake: DNSRecordsPage not implemented")
+}
+
+// DeleteAccountSubscription calls f.DeleteAccountSubscriptionFunc.
+func (f *Fake) DeleteAccountSubscription(accountID, subscriptionID string) error {
+	if f.DeleteAccountSubscriptionFunc != nil {
+		return f.DeleteAccountSubscriptionFunc(accountID, subscriptionID)
+	}
+	return fmt.Errorf("cloudflarefake: DeleteAccountSubscription not implemented")
+}
+
+// DeleteAddressMap calls f.DeleteAddressMapFunc.
+func (f *Fake) DeleteAddressMap(accountID, addressMapID string) error {
+	if f.DeleteAddressMapFunc != nil {
+		return f.DeleteAddressMapFunc(accountID, addressMapID)
+	}
+	return fmt.Errorf("cloudflarefake: DeleteAddressMap not implemented")
+}
+
+// DeleteDLPProfile calls f.DeleteDLPProfileFunc.
+func (f *Fake) DeleteDLPProfile(accountID, profileID string) error {
+	if f.DeleteDLPProfileFunc != nil {
+		return f.DeleteDLPProfileFunc(accountID, profileID)
+	}
+	return fmt.Errorf("cloudflarefake: DeleteDLPProfile not implemented")
+}
+
+// DeleteDNSRecord calls f.DeleteDNSRecordFunc.
+func (f *Fake) DeleteDNSRecord(zoneID, recordID string) error {
+	if f.DeleteDNSRecordFunc != nil {
+		return f.DeleteDNSRecordFunc(zoneID, recordID)
+	}
+	return fmt.Errorf("cloudflarefake: DeleteDNSRecord not implemented")
+}
+
+// DeleteDNSRecords calls f.DeleteDNSRecordsFunc.
+func (f *Fake) DeleteDNSRecords(zoneID string, ids []string) map[string]error {
+	if f.DeleteDNSRecordsFunc != nil {
+		return f.DeleteDNSRecordsFunc(zoneID, ids)
+	}
+	return nil
+}
+
+// DeleteFilters calls f.DeleteFiltersFunc.
+func (f *Fake) DeleteFilters(zoneID string, filterIDs []string) error {
+	if f.DeleteFiltersFunc != nil {
+		return f.DeleteFiltersFunc(zoneID, filterIDs)
+	}
+	return fmt.Errorf("cloudflarefake: DeleteFilters not implemented")
+}
+
+// DeleteFirewallRules calls f.DeleteFirewallRulesFunc.
+func (f *Fake) DeleteFirewallRules(zoneID string, ruleIDs []string) error {
+	if f.DeleteFirewallRulesFunc != nil {
+		return f.DeleteFirewallRulesFunc(zoneID, ruleIDs)
+	}
+	return fmt.Errorf("cloudflarefake: DeleteFirewallRules not implemented")
+}
+
+// DeleteKeyless calls f.DeleteKeylessFunc.
+func (f *Fake) DeleteKeyless() {
+	if f.DeleteKeylessFunc != nil {
+		f.DeleteKeylessFunc()
+		return
+	}
+}
+
+// DeletePageRule calls f.DeletePageRuleFunc.
+func (f *Fake) DeletePageRule(zoneID, ruleID string) error {
+	if f.DeletePageRuleFunc != nil {
+		return f.DeletePageRuleFunc(zoneID, ruleID)
+	}
+	return fmt.Errorf("cloudflarefake: DeletePageRule not implemented")
+}
+
+// DeletePageRules calls f.DeletePageRulesFunc.
+func (f *Fake) DeletePageRules(zoneID string, ids []string) map[string]error {
+	if f.DeletePageRulesFunc != nil {
+		return f.DeletePageRulesFunc(zoneID, ids)
+	}
+	return nil
+}
+
+// DeleteRailgun calls f.DeleteRailgunFunc.
+func (f *Fake) DeleteRailgun(railgunID string) error {
+	if f.DeleteRailgunFunc != nil {
+		return f.DeleteRailgunFunc(railgunID)
+	}
+	return fmt.Errorf("cloudflarefake: DeleteRailgun not implemented")
+}
+
+// DeleteRateLimit calls f.DeleteRateLimitFunc.
+func (f *Fake) DeleteRateLimit(zoneID, limitID string) error {
+	if f.DeleteRateLimitFunc != nil {
+		return f.DeleteRateLimitFunc(zoneID, limitID)
+	}
+	return fmt.Errorf("cloudflarefake: DeleteRateLimit not implemented")
+}
+
+// DeleteSSL calls f.DeleteSSLFunc.
+func (f *Fake) DeleteSSL(zoneID, certificateID string) error {
+	if f.DeleteSSLFunc != nil {
+		return f.DeleteSSLFunc(zoneID, certificateID)
+	}
+	return fmt.Errorf("cloudflarefake: DeleteSSL not implemented")
+}
+
+// DeleteStreamLiveInput calls f.DeleteStreamLiveInputFunc.
+func (f *Fake) DeleteStreamLiveInput(accountID, inputID string) error {
+	if f.DeleteStreamLiveInputFunc != nil {
+		return f.DeleteStreamLiveInputFunc(accountID, inputID)
+	}
+	return fmt.Errorf("cloudflarefake: DeleteStreamLiveInput not implemented")
+}
+
+// DeleteStreamLiveInputOutput calls f.DeleteStreamLiveInputOutputFunc.
+func (f *Fake) DeleteStreamLiveInputOutput(accountID, inputID, outputID string) error {
+	if f.DeleteStreamLiveInputOutputFunc != nil {
+		return f.DeleteStreamLiveInputOutputFunc(accountID, inputID, outputID)
+	}
+	return fmt.Errorf("cloudflarefake: DeleteStreamLiveInputOutput not implemented")
+}
+
+// DeleteStreamWebhook calls f.DeleteStreamWebhookFunc.
+func (f *Fake) DeleteStreamWebhook(accountID string) error {
+	if f.DeleteStreamWebhookFunc != nil {
+		return f.DeleteStreamWebhookFunc(accountID)
+	}
+	return fmt.Errorf("cloudflarefake: DeleteStreamWebhook not implemented")
+}
+
+// DeleteUserAgentRule calls f.DeleteUserAgentRuleFunc.
+func (f *Fake) DeleteUserAgentRule(zoneID, ruleID string) error {
+	if f.DeleteUserAgentRuleFunc != nil {
+		return f.DeleteUserAgentRuleFunc(zoneID, ruleID)
+	}
+	return fmt.Errorf("cloudflarefake: DeleteUserAgentRule not implemented")
+}
+
+// DeleteVirtualDNS calls f.DeleteVirtualDNSFunc.
+func (f *Fake) DeleteVirtualDNS(virtualDNSID string) error {
+	if f.DeleteVirtualDNSFunc != nil {
+		return f.DeleteVirtualDNSFunc(virtualDNSID)
+	}
+	return fmt.Errorf("cloudflarefake: DeleteVirtualDNS not implemented")
+}
+
+// DeleteZone calls f.DeleteZoneFunc.
+func (f *Fake) DeleteZone(zoneID string) (cloudflare.ZoneID, error) {
+	if f.DeleteZoneFunc != nil {
+		return f.DeleteZoneFunc(zoneID)
+	}
+	return cloudflare.ZoneID{}, fmt.Errorf("cloudflarefake: DeleteZone not implemented")
+}
+
+// DeleteZoneAccessRule calls f.DeleteZoneAccessRuleFunc.
+func (f *Fake) DeleteZoneAccessRule(zoneID, ruleID string) error {
+	if f.DeleteZoneAccessRuleFunc != nil {
+		return f.DeleteZoneAccessRuleFunc(zoneID, ruleID)
+	}
+	return fmt.Errorf("cloudflarefake: DeleteZoneAccessRule not implemented")
+}
+
+// DeleteZoneDNSSEC calls f.DeleteZoneDNSSECFunc.
+func (f *Fake) DeleteZoneDNSSEC(zoneID string) error {
+	if f.DeleteZoneDNSSECFunc != nil {
+		return f.DeleteZoneDNSSECFunc(zoneID)
+	}
+	return fmt.Errorf("cloudflarefake: DeleteZoneDNSSEC not implemented")
+}
+
+// DeleteZoneHold calls f.DeleteZoneHoldFunc.
+func (f *Fake) DeleteZoneHold(zoneID string, holdAfter time.Time) (cloudflare.ZoneHold, error) {
+	if f.DeleteZoneHoldFunc != nil {
+		return f.DeleteZoneHoldFunc(zoneID, holdAfter)
+	}
+	return cloudflare.ZoneHold{}, fmt.Errorf("cloudflarefake: DeleteZoneHold not implemented")
+}
+
+// DeleteZoneLockdown calls f.DeleteZoneLockdownFunc.
+func (f *Fake) DeleteZoneLockdown(zoneID, ruleID string) error {
+	if f.DeleteZoneLockdownFunc != nil {
+		return f.DeleteZoneLockdownFunc(zoneID, ruleID)
+	}
+	return fmt.Errorf("cloudflarefake: DeleteZoneLockdown not implemented")
+}
+
+// Device calls f.DeviceFunc.
+func (f *Fake) Device(accountID, deviceID string) (cloudflare.Device, error) {
+	if f.DeviceFunc != nil {
+		return f.DeviceFunc(accountID, deviceID)
+	}
+	return cloudflare.Device{}, fmt.Errorf("cloudflarefake: Device not implemented")
+}
+
+// DeviceOverrideCodes calls f.DeviceOverrideCodesFunc.
+func (f *Fake) DeviceOverrideCodes(accountID, deviceID string) (cloudflare.DeviceOverrideCodes, error) {
+	if f.DeviceOverrideCodesFunc != nil {
+		return f.DeviceOverrideCodesFunc(accountID, deviceID)
+	}
+	return cloudflare.DeviceOverrideCodes{}, fmt.Errorf("cloudflarefake: DeviceOverrideCodes not implemented")
+}
+
+// Devices calls f.DevicesFunc.
+func (f *Fake) Devices(accountID string) ([]cloudflare.Device, error) {
+	if f.DevicesFunc != nil {
+		return f.DevicesFunc(accountID)
+	}
+	return nil, fmt.Errorf("cloudflarefake: Devices not implemented")
+}
+
+// DisableRailgun calls f.DisableRailgunFunc.
+func (f *Fake) DisableRailgun(railgunID string) (cloudflare.Railgun, error) {
+	if f.DisableRailgunFunc != nil {
+		return f.DisableRailgunFunc(railgunID)
+	}
+	return cloudflare.Railgun{}, fmt.Errorf("cloudflarefake: DisableRailgun not implemented")
+}
+
+// DisconnectZoneRailgun calls f.DisconnectZoneRailgunFunc.
+func (f *Fake) DisconnectZoneRailgun(zoneID, railgunID string) (cloudflare.ZoneRailgun, error) {
+	if f.DisconnectZoneRailgunFunc != nil {
+		return f.DisconnectZoneRailgunFunc(zoneID, railgunID)
+	}
+	return cloudflare.ZoneRailgun{}, fmt.Errorf("cloudflarefake: DisconnectZoneRailgun not implemented")
+}
+
+// EditZone calls f.EditZoneFunc.
+func (f *Fake) EditZone(zoneID string, zoneOpts cloudflare.ZoneOptions) (cloudflare.Zone, error) {
+	if f.EditZoneFunc != nil {
+		return f.EditZoneFunc(zoneID, zoneOpts)
+	}
+	return cloudflare.Zone{}, fmt.Errorf("cloudflarefake: EditZone not implemented")
+}
+
+// EditZoneSettings calls f.EditZoneSettingsFunc.
+func (f *Fake) EditZoneSettings(zoneID string, settings []cloudflare.ZoneSetting) ([]cloudflare.ZoneSetting, error) {
+	if f.EditZoneSettingsFunc != nil {
+		return f.EditZoneSettingsFunc(zoneID, settings)
+	}
+	return nil, fmt.Errorf("cloudflarefake: EditZoneSettings not implemented")
+}
+
+// EnableRailgun calls f.EnableRailgunFunc.
+func (f *Fake) EnableRailgun(railgunID string) (cloudflare.Railgun, error) {
+	if f.EnableRailgunFunc != nil {
+		return f.EnableRailgunFunc(railgunID)
+	}
+	return cloudflare.Railgun{}, fmt.Errorf("cloudflarefake: EnableRailgun not implemented")
+}
+
+// EnableStreamLiveInputOutput calls f.EnableStreamLiveInputOutputFunc.
+func (f *Fake) EnableStreamLiveInputOutput(accountID, inputID, outputID string, enabled bool) (cloudflare.StreamLiveInputOutput, error) {
+	if f.EnableStreamLiveInputOutputFunc != nil {
+		return f.EnableStreamLiveInputOutputFunc(accountID, inputID, outputID, enabled)
+	}
+	return cloudflare.StreamLiveInputOutput{}, fmt.Errorf("cloudflarefake: EnableStreamLiveInputOutput not implemented")
+}
+
+// EnableZoneDNSSEC calls f.EnableZoneDNSSECFunc.
+func (f *Fake) EnableZoneDNSSEC(zoneID string) (cloudflare.ZoneDNSSEC, error) {
+	if f.EnableZoneDNSSECFunc != nil {
+		return f.EnableZoneDNSSECFunc(zoneID)
+	}
+	return cloudflare.ZoneDNSSEC{}, fmt.Errorf("cloudflarefake: EnableZoneDNSSEC not implemented")
+}
+
+// ExportDNSRecords calls f.ExportDNSRecordsFunc.
+func (f *Fake) ExportDNSRecords(zoneID string, w io.Writer) (int64, error) {
+	if f.ExportDNSRecordsFunc != nil {
+		return f.ExportDNSRecordsFunc(zoneID, w)
+	}
+	return 0, fmt.Errorf("cloudflarefake: ExportDNSRecords not implemented")
+}
+
+// ExportPageRules calls f.ExportPageRulesFunc.
+func (f *Fake) ExportPageRules(zoneID string) (cloudflare.PageRuleExport, error) {
+	if f.ExportPageRulesFunc != nil {
+		return f.ExportPageRulesFunc(zoneID)
+	}
+	return cloudflare.PageRuleExport{}, fmt.Errorf("cloudflarefake: ExportPageRules not implemented")
+}
+
+// ExportZone calls f.ExportZoneFunc.
+func (f *Fake) ExportZone(zoneID string) (cloudflare.ZoneExport, error) {
+	if f.ExportZoneFunc != nil {
+		return f.ExportZoneFunc(zoneID)
+	}
+	return cloudflare.ZoneExport{}, fmt.Errorf("cloudflarefake: ExportZone not implemented")
+}
+
+// FallbackDomains calls f.FallbackDomainsFunc.
+func (f *Fake) FallbackDomains(accountID, policyID string) ([]cloudflare.FallbackDomain, error) {
+	if f.FallbackDomainsFunc != nil {
+		return f.FallbackDomainsFunc(accountID, policyID)
+	}
+	return nil, fmt.Errorf("cloudflarefake: FallbackDomains not implemented")
+}
+
+// Filters calls f.FiltersFunc.
+func (f *Fake) Filters(zoneID string) ([]cloudflare.Filter, error) {
+	if f.FiltersFunc != nil {
+		return f.FiltersFunc(zoneID)
+	}
+	return nil, fmt.Errorf("cloudflarefake: Filters not implemented")
+}
+
+// FindPageRuleByTarget calls f.FindPageRuleByTargetFunc.
+func (f *Fake) FindPageRuleByTarget(zoneID, urlPattern string) (cloudflare.PageRule, error) {
+	if f.FindPageRuleByTargetFunc != nil {
+		return f.FindPageRuleByTargetFunc(zoneID, urlPattern)
+	}
+	return cloudflare.PageRule{}, fmt.Errorf("cloudflarefake: FindPageRuleByTarget not implemented")
+}
+
+// FirewallRules calls f.FirewallRulesFunc.
+func (f *Fake) FirewallRules(zoneID string) ([]cloudflare.FirewallRule, error) {
+	if f.FirewallRulesFunc != nil {
+		return f.FirewallRulesFunc(zoneID)
+	}
+	return nil, fmt.Errorf("cloudflarefake: FirewallRules not implemented")
+}
+
+// ForEachZone calls f.ForEachZoneFunc.
+func (f *Fake) ForEachZone(opts cloudflare.ForEachZoneOptions, fn func(cloudflare.Zone) error) error {
+	if f.ForEachZoneFunc != nil {
+		return f.ForEachZoneFunc(opts, fn)
+	}
+	return fmt.Errorf("cloudflarefake: ForEachZone not implemented")
+}
+
+// ForgetZoneIDs calls f.ForgetZoneIDsFunc.
+func (f *Fake) ForgetZoneIDs(zoneNames ...string) {
+	if f.ForgetZoneIDsFunc != nil {
+		f.ForgetZoneIDsFunc(zoneNames...)
+		return
+	}
+}
+
+// GatewayAppTypes calls f.GatewayAppTypesFunc.
+func (f *Fake) GatewayAppTypes(accountID string) ([]cloudflare.GatewayAppType, error) {
+	if f.GatewayAppTypesFunc != nil {
+		return f.GatewayAppTypesFunc(accountID)
+	}
+	return nil, fmt.Errorf("cloudflarefake: GatewayAppTypes not implemented")
+}
+
+// GatewayCategories calls f.GatewayCategoriesFunc.
+func (f *Fake) GatewayCategories(accountID string) ([]cloudflare.GatewayCategory, error) {
+	if f.GatewayCategoriesFunc != nil {
+		return f.GatewayCategoriesFunc(accountID)
+	}
+	return nil, fmt.Errorf("cloudflarefake: GatewayCategories not implemented")
+}
+
+// GetZoneHold calls f.GetZoneHoldFunc.
+func (f *Fake) GetZoneHold(zoneID string) (cloudflare.ZoneHold, error) {
+	if f.GetZoneHoldFunc != nil {
+		return f.GetZoneHoldFunc(zoneID)
+	}
+	return cloudflare.ZoneHold{}, fmt.Errorf("cloudflarefake: GetZoneHold not implemented")
+}
+
+// GetZoneSettings calls f.GetZoneSettingsFunc.
+func (f *Fake) GetZoneSettings(zoneID string) ([]cloudflare.ZoneSetting, error) {
+	if f.GetZoneSettingsFunc != nil {
+		return f.GetZoneSettingsFunc(zoneID)
+	}
+	return nil, fmt.Errorf("cloudflarefake: GetZoneSettings not implemented")
+}
+
+// IPs calls f.IPsFunc.
+func (f *Fake) IPs() (cloudflare.IPRanges, error) {
+	if f.IPsFunc != nil {
+		return f.IPsFunc()
+	}
+	return cloudflare.IPRanges{}, fmt.Errorf("cloudflarefake: IPs not implemented")
+}
+
+// ImagesBatchToken calls f.ImagesBatchTokenFunc.
+func (f *Fake) ImagesBatchToken(accountID string) (cloudflare.ImagesBatchToken, error) {
+	if f.ImagesBatchTokenFunc != nil {
+		return f.ImagesBatchTokenFunc(accountID)
+	}
+	return cloudflare.ImagesBatchToken{}, fmt.Errorf("cloudflarefake: ImagesBatchToken not implemented")
+}
+
+// ImportDNSRecords calls f.ImportDNSRecordsFunc.
+func (f *Fake) ImportDNSRecords(zoneID string, r io.Reader, size int64) (cloudflare.DNSImportResult, error) {
+	if f.ImportDNSRecordsFunc != nil {
+		return f.ImportDNSRecordsFunc(zoneID, r, size)
+	}
+	return cloudflare.DNSImportResult{}, fmt.Errorf("cloudflarefake: ImportDNSRecords not implemented")
+}
+
+// ImportPageRules calls f.ImportPageRulesFunc.
+func (f *Fake) ImportPageRules(zoneID string, doc cloudflare.PageRuleExport, replace bool) ([]cloudflare.ZoneConfigChange, error) {
+	if f.ImportPageRulesFunc != nil {
+		return f.ImportPageRulesFunc(zoneID, doc, replace)
+	}
+	return nil, fmt.Errorf("cloudflarefake: ImportPageRules not implemented")
+}
+
+// ImportZone calls f.ImportZoneFunc.
+func (f *Fake) ImportZone(zoneID string, export cloudflare.ZoneExport) ([]cloudflare.ZoneImportResult, error) {
+	if f.ImportZoneFunc != nil {
+		return f.ImportZoneFunc(zoneID, export)
+	}
+	return nil, fmt.Errorf("cloudflarefake: ImportZone not implemented")
+}
+
+// Keyless calls f.KeylessFunc.
+func (f *Fake) Keyless() {
+	if f.KeylessFunc != nil {
+		f.KeylessFunc()
+		return
+	}
+}
+
+// ListAllRateLimits calls f.ListAllRateLimitsFunc.
+func (f *Fake) ListAllRateLimits(zoneID string) ([]cloudflare.ZoneRateLimit, error) {
+	if f.ListAllRateLimitsFunc != nil {
+		return f.ListAllRateLimitsFunc(zoneID)
+	}
+	return nil, fmt.Errorf("cloudflarefake: ListAllRateLimits not implemented")
+}
+
+// ListAvailablePageRuleSettings calls f.ListAvailablePageRuleSettingsFunc.
+func (f *Fake) ListAvailablePageRuleSettings(zoneID string) ([]cloudflare.PageRuleSetting, error) {
+	if f.ListAvailablePageRuleSettingsFunc != nil {
+		return f.ListAvailablePageRuleSettingsFunc(zoneID)
+	}
+	return nil, fmt.Errorf("cloudflarefake: ListAvailablePageRuleSettings not implemented")
+}
+
+// ListImages calls f.ListImagesFunc.
+func (f *Fake) ListImages(accountID string, opts cloudflare.ImagesListOptions) ([]cloudflare.Image, string, error) {
+	if f.ListImagesFunc != nil {
+		return f.ListImagesFunc(accountID, opts)
+	}
+	return nil, "", fmt.Errorf("cloudflarefake: ListImages not implemented")
+}
+
+// ListKeyless calls f.ListKeylessFunc.
+func (f *Fake) ListKeyless() {
+	if f.ListKeylessFunc != nil {
+		f.ListKeylessFunc()
+		return
+	}
+}
+
+// ListPageRules calls f.ListPageRulesFunc.
+func (f *Fake) ListPageRules(zoneID string) ([]cloudflare.PageRule, error) {
+	if f.ListPageRulesFunc != nil {
+		return f.ListPageRulesFunc(zoneID)
+	}
+	return nil, fmt.Errorf("cloudflarefake: ListPageRules not implemented")
+}
+
+// ListPageRulesWithParams calls f.ListPageRulesWithParamsFunc.
+func (f *Fake) ListPageRulesWithParams(zoneID string, params cloudflare.PageRuleListParams) ([]cloudflare.PageRule, error) {
+	if f.ListPageRulesWithParamsFunc != nil {
+		return f.ListPageRulesWithParamsFunc(zoneID, params)
+	}
+	return nil, fmt.Errorf("cloudflarefake: ListPageRulesWithParams not implemented")
+}
+
+// ListRailguns calls f.ListRailgunsFunc.
+func (f *Fake) ListRailguns(options cloudflare.RailgunListOptions) ([]cloudflare.Railgun, error) {
+	if f.ListRailgunsFunc != nil {
+		return f.ListRailgunsFunc(options)
+	}
+	return nil, fmt.Errorf("cloudflarefake: ListRailguns not implemented")
+}
+
+// ListSSL calls f.ListSSLFunc.
+func (f *Fake) ListSSL(zoneID string) ([]cloudflare.ZoneCustomSSL, error) {
+	if f.ListSSLFunc != nil {
+		return f.ListSSLFunc(zoneID)
+	}
+	return nil, fmt.Errorf("cloudflarefake: ListSSL not implemented")
+}
+
+// ListUserAgentRules calls f.ListUserAgentRulesFunc.
+func (f *Fake) ListUserAgentRules(zoneID string) ([]cloudflare.UserAgentRule, error) {
+	if f.ListUserAgentRulesFunc != nil {
+		return f.ListUserAgentRulesFunc(zoneID)
+	}
+	return nil, fmt.Errorf("cloudflarefake: ListUserAgentRules not implemented")
+}
+
+// ListVirtualDNS calls f.ListVirtualDNSFunc.
+func (f *Fake) ListVirtualDNS() ([]*cloudflare.VirtualDNS, error) {
+	if f.ListVirtualDNSFunc != nil {
+		return f.ListVirtualDNSFunc()
+	}
+	return nil, fmt.Errorf("cloudflarefake: ListVirtualDNS not implemented")
+}
+
+// ListWAFPackages calls f.ListWAFPackagesFunc.
+func (f *Fake) ListWAFPackages(zoneID string) ([]cloudflare.WAFPackage, error) {
+	if f.ListWAFPackagesFunc != nil {
+		return f.ListWAFPackagesFunc(zoneID)
+	}
+	return nil, fmt.Errorf("cloudflarefake: ListWAFPackages not implemented")
+}
+
+// ListWAFRules calls f.ListWAFRulesFunc.
+func (f *Fake) ListWAFRules(zoneID, packageID string) ([]cloudflare.WAFRule, error) {
+	if f.ListWAFRulesFunc != nil {
+		return f.ListWAFRulesFunc(zoneID, packageID)
+	}
+	return nil, fmt.Errorf("cloudflarefake: ListWAFRules not implemented")
+}
+
+// ListZones calls f.ListZonesFunc.
+func (f *Fake) ListZones(z ...string) ([]cloudflare.Zone, error) {
+	if f.ListZonesFunc != nil {
+		return f.ListZonesFunc(z...)
+	}
+	return nil, fmt.Errorf("cloudflarefake: ListZones not implemented")
+}
+
+// ListZonesPage calls f.ListZonesPageFunc.
+func (f *Fake) ListZonesPage(opts cloudflare.PaginationOptions) ([]cloudflare.Zone, cloudflare.ResultInfo, error) {
+	if f.ListZonesPageFunc != nil {
+		return f.ListZonesPageFunc(opts)
+	}
+	return nil, cloudflare.ResultInfo{}, fmt.Errorf("cloudflarefake: ListZonesPage not implemented")
+}
+
+// ListZonesWithParams calls f.ListZonesWithParamsFunc.
+func (f *Fake) ListZonesWithParams(params cloudflare.ZoneListParams) ([]cloudflare.Zone, error) {
+	if f.ListZonesWithParamsFunc != nil {
+		return f.ListZonesWithParamsFunc(params)
+	}
+	return nil, fmt.Errorf("cloudflarefake: ListZonesWithParams not implemented")
+}
+
+// OriginCertificate calls f.OriginCertificateFunc.
+func (f *Fake) OriginCertificate(certificateID string) (cloudflare.OriginCACertificate, error) {
+	if f.OriginCertificateFunc != nil {
+		return f.OriginCertificateFunc(certificateID)
+	}
+	return cloudflare.OriginCACertificate{}, fmt.Errorf("cloudflarefake: OriginCertificate not implemented")
+}
+
+// OriginCertificates calls f.OriginCertificatesFunc.
+func (f *Fake) OriginCertificates(zoneID string) ([]cloudflare.OriginCACertificate, error) {
+	if f.OriginCertificatesFunc != nil {
+		return f.OriginCertificatesFunc(zoneID)
+	}
+	return nil, fmt.Errorf("cloudflarefake: OriginCertificates not implemented")
+}
+
+// PageRule calls f.PageRuleFunc.
+func (f *Fake) PageRule(zoneID, ruleID string) (cloudflare.PageRule, error) {
+	if f.PageRuleFunc != nil {
+		return f.PageRuleFunc(zoneID, ruleID)
+	}
+	return cloudflare.PageRule{}, fmt.Errorf("cloudflarefake: PageRule not implemented")
+}
+
+// Paginate calls f.PaginateFunc.
+func (f *Fake) Paginate(path string, query url.Values, fn func(item json.RawMessage) error) error {
+	if f.PaginateFunc != nil {
+		return f.PaginateFunc(path, query, fn)
+	}
+	return fmt.Errorf("cloudflarefake: Paginate not implemented")
+}
+
+// PauseAllPageRules calls f.PauseAllPageRulesFunc.
+func (f *Fake) PauseAllPageRules(zoneID string) ([]string, error) {
+	if f.PauseAllPageRulesFunc != nil {
+		return f.PauseAllPageRulesFunc(zoneID)
+	}
+	return nil, fmt.Errorf("cloudflarefake: PauseAllPageRules not implemented")
+}
+
+// PlanZoneConfig calls f.PlanZoneConfigFunc.
+func (f *Fake) PlanZoneConfig(zoneID string, config cloudflare.ZoneConfig) (cloudflare.ZoneConfigPlan, error) {
+	if f.PlanZoneConfigFunc != nil {
+		return f.PlanZoneConfigFunc(zoneID, config)
+	}
+	return cloudflare.ZoneConfigPlan{}, fmt.Errorf("cloudflarefake: PlanZoneConfig not implemented")
+}
+
+// PrefixAdvertisementStatus calls f.PrefixAdvertisementStatusFunc.
+func (f *Fake) PrefixAdvertisementStatus(accountID, prefixID string) (cloudflare.PrefixAdvertisementStatus, error) {
+	if f.PrefixAdvertisementStatusFunc != nil {
+		return f.PrefixAdvertisementStatusFunc(accountID, prefixID)
+	}
+	return cloudflare.PrefixAdvertisementStatus{}, fmt.Errorf("cloudflarefake: PrefixAdvertisementStatus not implemented")
+}
+
+// PullQueueMessages calls f.PullQueueMessagesFunc.
+func (f *Fake) PullQueueMessages(accountID, queueID string, opts cloudflare.QueuePullOptions) ([]cloudflare.QueueMessage, error) {
+	if f.PullQueueMessagesFunc != nil {
+		return f.PullQueueMessagesFunc(accountID, queueID, opts)
+	}
+	return nil, fmt.Errorf("cloudflarefake: PullQueueMessages not implemented")
+}
+
+// PurgeCache calls f.PurgeCacheFunc.
+func (f *Fake) PurgeCache(zoneID string, pcr cloudflare.PurgeCacheRequest) (cloudflare.PurgeCacheResponse, error) {
+	if f.PurgeCacheFunc != nil {
+		return f.PurgeCacheFunc(zoneID, pcr)
+	}
+	return cloudflare.PurgeCacheResponse{}, fmt.Errorf("cloudflarefake: PurgeCache not implemented")
+}
+
+// PurgeEverything calls f.PurgeEverythingFunc.
+func (f *Fake) PurgeEverything(zoneID string) (cloudflare.PurgeCacheResponse, error) {
+	if f.PurgeEverythingFunc != nil {
+		return f.PurgeEverythingFunc(zoneID)
+	}
+	return cloudflare.PurgeCacheResponse{}, fmt.Errorf("cloudflarefake: PurgeEverything not implemented")
+}
+
+// RailgunDetails calls f.RailgunDetailsFunc.
+func (f *Fake) RailgunDetails(railgunID string) (cloudflare.Railgun, error) {
+	if f.RailgunDetailsFunc != nil {
+		return f.RailgunDetailsFunc(railgunID)
+	}
+	return cloudflare.Railgun{}, fmt.Errorf("cloudflarefake: RailgunDetails not implemented")
+}
+
+// RailgunZones calls f.RailgunZonesFunc.
+func (f *Fake) RailgunZones(railgunID string) ([]cloudflare.Zone, error) {
+	if f.RailgunZonesFunc != nil {
+		return f.RailgunZonesFunc(railgunID)
+	}
+	return nil, fmt.Errorf("cloudflarefake: RailgunZones not implemented")
+}
+
+// Raw calls f.RawFunc.
+func (f *Fake) Raw(ctx context.Context, method, path string, body interface{}, header http.Header) ([]byte, error) {
+	if f.RawFunc != nil {
+		return f.RawFunc(ctx, method, path, body, header)
+	}
+	return nil, fmt.Errorf("cloudflarefake: Raw not implemented")
+}
+
+// RegistrarDomain calls f.RegistrarDomainFunc.
+func (f *Fake) RegistrarDomain(accountID, domainName string) (cloudflare.RegistrarDomain, error) {
+	if f.RegistrarDomainFunc != nil {
+		return f.RegistrarDomainFunc(accountID, domainName)
+	}
+	return cloudflare.RegistrarDomain{}, fmt.Errorf("cloudflarefake: RegistrarDomain not implemented")
+}
+
+// RegistrarDomains calls f.RegistrarDomainsFunc.
+func (f *Fake) RegistrarDomains(accountID string) ([]cloudflare.RegistrarDomain, error) {
+	if f.RegistrarDomainsFunc != nil {
+		return f.RegistrarDomainsFunc(accountID)
+	}
+	return nil, fmt.Errorf("cloudflarefake: RegistrarDomains not implemented")
+}
+
+// RemoveAddressMapMembership calls f.RemoveAddressMapMembershipFunc.
+func (f *Fake) RemoveAddressMapMembership(accountID, addressMapID string, membership cloudflare.AddressMapMembership) error {
+	if f.RemoveAddressMapMembershipFunc != nil {
+		return f.RemoveAddressMapMembershipFunc(accountID, addressMapID, membership)
+	}
+	return fmt.Errorf("cloudflarefake: RemoveAddressMapMembership not implemented")
+}
+
+// RemoveIPFromAddressMap calls f.RemoveIPFromAddressMapFunc.
+func (f *Fake) RemoveIPFromAddressMap(accountID, addressMapID, ip string) error {
+	if f.RemoveIPFromAddressMapFunc != nil {
+		return f.RemoveIPFromAddressMapFunc(accountID, addressMapID, ip)
+	}
+	return fmt.Errorf("cloudflarefake: RemoveIPFromAddressMap not implemented")
+}
+
+// ReorderPageRules calls f.ReorderPageRulesFunc.
+func (f *Fake) ReorderPageRules(zoneID string, orderedIDs []string) error {
+	if f.ReorderPageRulesFunc != nil {
+		return f.ReorderPageRulesFunc(zoneID, orderedIDs)
+	}
+	return fmt.Errorf("cloudflarefake: ReorderPageRules not implemented")
+}
+
+// ReprioritizeSSL calls f.ReprioritizeSSLFunc.
+func (f *Fake) ReprioritizeSSL(zoneID string, p []cloudflare.ZoneCustomSSLPriority) ([]cloudflare.ZoneCustomSSL, error) {
+	if f.ReprioritizeSSLFunc != nil {
+		return f.ReprioritizeSSLFunc(zoneID, p)
+	}
+	return nil, fmt.Errorf("cloudflarefake: ReprioritizeSSL not implemented")
+}
+
+// ResumeAllPageRules calls f.ResumeAllPageRulesFunc.
+func (f *Fake) ResumeAllPageRules(zoneID string) ([]string, error) {
+	if f.ResumeAllPageRulesFunc != nil {
+		return f.ResumeAllPageRulesFunc(zoneID)
+	}
+	return nil, fmt.Errorf("cloudflarefake: ResumeAllPageRules not implemented")
+}
+
+// RevokeDevices calls f.RevokeDevicesFunc.
+func (f *Fake) RevokeDevices(accountID string, deviceIDs []string) error {
+	if f.RevokeDevicesFunc != nil {
+		return f.RevokeDevicesFunc(accountID, deviceIDs)
+	}
+	return fmt.Errorf("cloudflarefake: RevokeDevices not implemented")
+}
+
+// RevokeOriginCertificate calls f.RevokeOriginCertificateFunc.
+func (f *Fake) RevokeOriginCertificate(certificateID string) (string, error) {
+	if f.RevokeOriginCertificateFunc != nil {
+		return f.RevokeOriginCertificateFunc(certificateID)
+	}
+	return "", fmt.Errorf("cloudflarefake: RevokeOriginCertificate not implemented")
+}
+
+// SSLDetails calls f.SSLDetailsFunc.
+func (f *Fake) SSLDetails(zoneID, certificateID string) (cloudflare.ZoneCustomSSL, error) {
+	if f.SSLDetailsFunc != nil {
+		return f.SSLDetailsFunc(zoneID, certificateID)
+	}
+	return cloudflare.ZoneCustomSSL{}, fmt.Errorf("cloudflarefake: SSLDetails not implemented")
+}
+
+// SetCustomErrorRules calls f.SetCustomErrorRulesFunc.
+func (f *Fake) SetCustomErrorRules(zoneID string, rules []cloudflare.CustomErrorRule) ([]cloudflare.CustomErrorRule, error) {
+	if f.SetCustomErrorRulesFunc != nil {
+		return f.SetCustomErrorRulesFunc(zoneID, rules)
+	}
+	return nil, fmt.Errorf("cloudflarefake: SetCustomErrorRules not implemented")
+}
+
+// SetPageRuleStatus calls f.SetPageRuleStatusFunc.
+func (f *Fake) SetPageRuleStatus(zoneID, ruleID string, status cloudflare.PageRuleStatus) (cloudflare.PageRule, error) {
+	if f.SetPageRuleStatusFunc != nil {
+		return f.SetPageRuleStatusFunc(zoneID, ruleID, status)
+	}
+	return cloudflare.PageRule{}, fmt.Errorf("cloudflarefake: SetPageRuleStatus not implemented")
+}
+
+// SetPageRulesStatus calls f.SetPageRulesStatusFunc.
+func (f *Fake) SetPageRulesStatus(zoneID string, ids []string, status cloudflare.PageRuleStatus) map[string]error {
+	if f.SetPageRulesStatusFunc != nil {
+		return f.SetPageRulesStatusFunc(zoneID, ids, status)
+	}
+	return nil
+}
+
+// SetStreamWebhook calls f.SetStreamWebhookFunc.
+func (f *Fake) SetStreamWebhook(accountID, notificationURL string) (cloudflare.StreamWebhook, error) {
+	if f.SetStreamWebhookFunc != nil {
+		return f.SetStreamWebhookFunc(accountID, notificationURL)
+	}
+	return cloudflare.StreamWebhook{}, fmt.Errorf("cloudflarefake: SetStreamWebhook not implemented")
+}
+
+// SetZoneDevelopmentMode calls f.SetZoneDevelopmentModeFunc.
+func (f *Fake) SetZoneDevelopmentMode(zoneID string, on bool) (cloudflare.ZoneDevelopmentMode, error) {
+	if f.SetZoneDevelopmentModeFunc != nil {
+		return f.SetZoneDevelopmentModeFunc(zoneID, on)
+	}
+	return cloudflare.ZoneDevelopmentMode{}, fmt.Errorf("cloudflarefake: SetZoneDevelopmentMode not implemented")
+}
+
+// SplitTunnel calls f.SplitTunnelFunc.
+func (f *Fake) SplitTunnel(accountID, policyID, mode string) ([]cloudflare.SplitTunnel, error) {
+	if f.SplitTunnelFunc != nil {
+		return f.SplitTunnelFunc(accountID, policyID, mode)
+	}
+	return nil, fmt.Errorf("cloudflarefake: SplitTunnel not implemented")
+}
+
+// StreamDNSRecords calls f.StreamDNSRecordsFunc.
+func (f *Fake) StreamDNSRecords(zoneID string, rr cloudflare.DNSRecord, fn func(cloudflare.DNSRecord) error) error {
+	if f.StreamDNSRecordsFunc != nil {
+		return f.StreamDNSRecordsFunc(zoneID, rr, fn)
+	}
+	return fmt.Errorf("cloudflarefake: StreamDNSRecords not implemented")
+}
+
+// StreamLiveInput calls f.StreamLiveInputFunc.
+func (f *Fake) StreamLiveInput(accountID, inputID string) (cloudflare.StreamLiveInput, error) {
+	if f.StreamLiveInputFunc != nil {
+		return f.StreamLiveInputFunc(accountID, inputID)
+	}
+	return cloudflare.StreamLiveInput{}, fmt.Errorf("cloudflarefake: StreamLiveInput not implemented")
+}
+
+// StreamLiveInputOutputs calls f.StreamLiveInputOutputsFunc.
+func (f *Fake) StreamLiveInputOutputs(accountID, inputID string) ([]cloudflare.StreamLiveInputOutput, error) {
+	if f.StreamLiveInputOutputsFunc != nil {
+		return f.StreamLiveInputOutputsFunc(accountID, inputID)
+	}
+	return nil, fmt.Errorf("cloudflarefake: StreamLiveInputOutputs not implemented")
+}
+
+// StreamLiveInputs calls f.StreamLiveInputsFunc.
+func (f *Fake) StreamLiveInputs(accountID string) ([]cloudflare.StreamLiveInput, error) {
+	if f.StreamLiveInputsFunc != nil {
+		return f.StreamLiveInputsFunc(accountID)
+	}
+	return nil, fmt.Errorf("cloudflarefake: StreamLiveInputs not implemented")
+}
+
+// StreamPageRules calls f.StreamPageRulesFunc.
+func (f *Fake) StreamPageRules(zoneID string, fn func(cloudflare.PageRule) error) error {
+	if f.StreamPageRulesFunc != nil {
+		return f.StreamPageRulesFunc(zoneID, fn)
+	}
+	return fmt.Errorf("cloudflarefake: StreamPageRules not implemented")
+}
+
+// StreamWebhook calls f.StreamWebhookFunc.
+func (f *Fake) StreamWebhook(accountID string) (cloudflare.StreamWebhook, error) {
+	if f.StreamWebhookFunc != nil {
+		return f.StreamWebhookFunc(accountID)
+	}
+	return cloudflare.StreamWebhook{}, fmt.Errorf("cloudflarefake: StreamWebhook not implemented")
+}
+
+// StreamZoneAnalyticsByColocation calls f.StreamZoneAnalyticsByColocationFunc.
+func (f *Fake) StreamZoneAnalyticsByColocation(zoneID string, options cloudflare.ZoneAnalyticsOptions, fn func(cloudflare.ZoneAnalyticsColocation) error) error {
+	if f.StreamZoneAnalyticsByColocationFunc != nil {
+		return f.StreamZoneAnalyticsByColocationFunc(zoneID, options, fn)
+	}
+	return fmt.Errorf("cloudflarefake: StreamZoneAnalyticsByColocation not implemented")
+}
+
+// SyncDNSRecords calls f.SyncDNSRecordsFunc.
+func (f *Fake) SyncDNSRecords(zoneID string, desired []cloudflare.DNSRecord, opts cloudflare.DNSSyncOptions) ([]cloudflare.ZoneConfigChange, error) {
+	if f.SyncDNSRecordsFunc != nil {
+		return f.SyncDNSRecordsFunc(zoneID, desired, opts)
+	}
+	return nil, fmt.Errorf("cloudflarefake: SyncDNSRecords not implemented")
+}
+
+// SyncFirewallRules calls f.SyncFirewallRulesFunc.
+func (f *Fake) SyncFirewallRules(zoneID string, desired []cloudflare.FirewallRule, opts cloudflare.FirewallSyncOptions) ([]cloudflare.ZoneConfigChange, error) {
+	if f.SyncFirewallRulesFunc != nil {
+		return f.SyncFirewallRulesFunc(zoneID, desired, opts)
+	}
+	return nil, fmt.Errorf("cloudflarefake: SyncFirewallRules not implemented")
+}
+
+// SyncPageRules calls f.SyncPageRulesFunc.
+func (f *Fake) SyncPageRules(zoneID string, desired []cloudflare.PageRule, opts cloudflare.PageRuleSyncOptions) ([]cloudflare.ZoneConfigChange, error) {
+	if f.SyncPageRulesFunc != nil {
+		return f.SyncPageRulesFunc(zoneID, desired, opts)
+	}
+	return nil, fmt.Errorf("cloudflarefake: SyncPageRules not implemented")
+}
+
+// SyncZoneAccessRules calls f.SyncZoneAccessRulesFunc.
+func (f *Fake) SyncZoneAccessRules(zoneID string, desired []cloudflare.AccessRule, opts cloudflare.FirewallSyncOptions) ([]cloudflare.ZoneConfigChange, error) {
+	if f.SyncZoneAccessRulesFunc != nil {
+		return f.SyncZoneAccessRulesFunc(zoneID, desired, opts)
+	}
+	return nil, fmt.Errorf("cloudflarefake: SyncZoneAccessRules not implemented")
+}
+
+// TestRailgunConnection calls f.TestRailgunConnectionFunc.
+func (f *Fake) TestRailgunConnection(zoneID, railgunID string) (cloudflare.RailgunDiagnosis, error) {
+	if f.TestRailgunConnectionFunc != nil {
+		return f.TestRailgunConnectionFunc(zoneID, railgunID)
+	}
+	return cloudflare.RailgunDiagnosis{}, fmt.Errorf("cloudflarefake: TestRailgunConnection not implemented")
+}
+
+// TransferRegistrarDomain calls f.TransferRegistrarDomainFunc.
+func (f *Fake) TransferRegistrarDomain(accountID, domainName, authCode string) ([]cloudflare.RegistrarDomain, error) {
+	if f.TransferRegistrarDomainFunc != nil {
+		return f.TransferRegistrarDomainFunc(accountID, domainName, authCode)
+	}
+	return nil, fmt.Errorf("cloudflarefake: TransferRegistrarDomain not implemented")
+}
+
+// UnrevokeDevices calls f.UnrevokeDevicesFunc.
+func (f *Fake) UnrevokeDevices(accountID string, deviceIDs []string) error {
+	if f.UnrevokeDevicesFunc != nil {
+		return f.UnrevokeDevicesFunc(accountID, deviceIDs)
+	}
+	return fmt.Errorf("cloudflarefake: UnrevokeDevices not implemented")
+}
+
+// UpdateAccountSubscription calls f.UpdateAccountSubscriptionFunc.
+func (f *Fake) UpdateAccountSubscription(accountID, subscriptionID string, sub cloudflare.Subscription) (cloudflare.Subscription, error) {
+	if f.UpdateAccountSubscriptionFunc != nil {
+		return f.UpdateAccountSubscriptionFunc(accountID, subscriptionID, sub)
+	}
+	return cloudflare.Subscription{}, fmt.Errorf("cloudflarefake: UpdateAccountSubscription not implemented")
+}
+
+// UpdateAddressMap calls f.UpdateAddressMapFunc.
+func (f *Fake) UpdateAddressMap(accountID, addressMapID string, addressMap cloudflare.AddressMap) (cloudflare.AddressMap, error) {
+	if f.UpdateAddressMapFunc != nil {
+		return f.UpdateAddressMapFunc(accountID, addressMapID, addressMap)
+	}
+	return cloudflare.AddressMap{}, fmt.Errorf("cloudflarefake: UpdateAddressMap not implemented")
+}
+
+// UpdateCustomPage calls f.UpdateCustomPageFunc.
+func (f *Fake) UpdateCustomPage(options cloudflare.CustomPageOptions, pageID string, params cloudflare.CustomPageParameters) (cloudflare.CustomPage, error) {
+	if f.UpdateCustomPageFunc != nil {
+		return f.UpdateCustomPageFunc(options, pageID, params)
+	}
+	return cloudflare.CustomPage{}, fmt.Errorf("cloudflarefake: UpdateCustomPage not implemented")
+}
+
+// UpdateDLPProfile calls f.UpdateDLPProfileFunc.
+func (f *Fake) UpdateDLPProfile(accountID string, profile cloudflare.DLPProfile) (cloudflare.DLPProfile, error) {
+	if f.UpdateDLPProfileFunc != nil {
+		return f.UpdateDLPProfileFunc(accountID, profile)
+	}
+	return cloudflare.DLPProfile{}, fmt.Errorf("cloudflarefake: UpdateDLPProfile not implemented")
+}
+
+// UpdateDNSRecord calls f.UpdateDNSRecordFunc.
+func (f *Fake) UpdateDNSRecord(zoneID, recordID string, rr cloudflare.DNSRecord) error {
+	if f.UpdateDNSRecordFunc != nil {
+		return f.UpdateDNSRecordFunc(zoneID, recordID, rr)
+	}
+	return fmt.Errorf("cloudflarefake: UpdateDNSRecord not implemented")
+}
+
+// UpdateFallbackDomains calls f.UpdateFallbackDomainsFunc.
+func (f *Fake) UpdateFallbackDomains(accountID, policyID string, domains []cloudflare.FallbackDomain) ([]cloudflare.FallbackDomain, error) {
+	if f.UpdateFallbackDomainsFunc != nil {
+		return f.UpdateFallbackDomainsFunc(accountID, policyID, domains)
+	}
+	return nil, fmt.Errorf("cloudflarefake: UpdateFallbackDomains not implemented")
+}
+
+// UpdateFilters calls f.UpdateFiltersFunc.
+func (f *Fake) UpdateFilters(zoneID string, filters []cloudflare.Filter) ([]cloudflare.Filter, error) {
+	if f.UpdateFiltersFunc != nil {
+		return f.UpdateFiltersFunc(zoneID, filters)
+	}
+	return nil, fmt.Errorf("cloudflarefake: UpdateFilters not implemented")
+}
+
+// UpdateFirewallRules calls f.UpdateFirewallRulesFunc.
+func (f *Fake) UpdateFirewallRules(zoneID string, rules []cloudflare.FirewallRule) ([]cloudflare.FirewallRule, error) {
+	if f.UpdateFirewallRulesFunc != nil {
+		return f.UpdateFirewallRulesFunc(zoneID, rules)
+	}
+	return nil, fmt.Errorf("cloudflarefake: UpdateFirewallRules not implemented")
+}
+
+// UpdateKeyless calls f.UpdateKeylessFunc.
+func (f *Fake) UpdateKeyless() {
+	if f.UpdateKeylessFunc != nil {
+		f.UpdateKeylessFunc()
+		return
+	}
+}
+
+// UpdatePageRule calls f.UpdatePageRuleFunc.
+func (f *Fake) UpdatePageRule(zoneID, ruleID string, rule cloudflare.PageRule) (cloudflare.PageRule, error) {
+	if f.UpdatePageRuleFunc != nil {
+		return f.UpdatePageRuleFunc(zoneID, ruleID, rule)
+	}
+	return cloudflare.PageRule{}, fmt.Errorf("cloudflarefake: UpdatePageRule not implemented")
+}
+
+// UpdatePrefixAdvertisementStatus calls f.UpdatePrefixAdvertisementStatusFunc.
+func (f *Fake) UpdatePrefixAdvertisementStatus(accountID, prefixID string, advertised bool) (cloudflare.PrefixAdvertisementStatus, error) {
+	if f.UpdatePrefixAdvertisementStatusFunc != nil {
+		return f.UpdatePrefixAdvertisementStatusFunc(accountID, prefixID, advertised)
+	}
+	return cloudflare.PrefixAdvertisementStatus{}, fmt.Errorf("cloudflarefake: UpdatePrefixAdvertisementStatus not implemented")
+}
+
+// UpdateRateLimit calls f.UpdateRateLimitFunc.
+func (f *Fake) UpdateRateLimit(zoneID, limitID string, limit cloudflare.ZoneRateLimit) (cloudflare.ZoneRateLimit, error) {
+	if f.UpdateRateLimitFunc != nil {
+		return f.UpdateRateLimitFunc(zoneID, limitID, limit)
+	}
+	return cloudflare.ZoneRateLimit{}, fmt.Errorf("cloudflarefake: UpdateRateLimit not implemented")
+}
+
+// UpdateSSL calls f.UpdateSSLFunc.
+func (f *Fake) UpdateSSL(zoneID, certificateID string, options cloudflare.ZoneCustomSSLOptions) (cloudflare.ZoneCustomSSL, error) {
+	if f.UpdateSSLFunc != nil {
+		return f.UpdateSSLFunc(zoneID, certificateID, options)
+	}
+	return cloudflare.ZoneCustomSSL{}, fmt.Errorf("cloudflarefake: UpdateSSL not implemented")
+}
+
+// UpdateSplitTunnel calls f.UpdateSplitTunnelFunc.
+func (f *Fake) UpdateSplitTunnel(accountID, policyID, mode string, tunnels []cloudflare.SplitTunnel) ([]cloudflare.SplitTunnel, error) {
+	if f.UpdateSplitTunnelFunc != nil {
+		return f.UpdateSplitTunnelFunc(accountID, policyID, mode, tunnels)
+	}
+	return nil, fmt.Errorf("cloudflarefake: UpdateSplitTunnel not implemented")
+}
+
+// UpdateStreamLiveInput calls f.UpdateStreamLiveInputFunc.
+func (f *Fake) UpdateStreamLiveInput(accountID, inputID string, input cloudflare.StreamLiveInput) (cloudflare.StreamLiveInput, error) {
+	if f.UpdateStreamLiveInputFunc != nil {
+		return f.UpdateStreamLiveInputFunc(accountID, inputID, input)
+	}
+	return cloudflare.StreamLiveInput{}, fmt.Errorf("cloudflarefake: UpdateStreamLiveInput not implemented")
+}
+
+// UpdateUser calls f.UpdateUserFunc.
+func (f *Fake) UpdateUser() (cloudflare.User, error) {
+	if f.UpdateUserFunc != nil {
+		return f.UpdateUserFunc()
+	}
+	return cloudflare.User{}, fmt.Errorf("cloudflarefake: UpdateUser not implemented")
+}
+
+// UpdateUserAgentRule calls f.UpdateUserAgentRuleFunc.
+func (f *Fake) UpdateUserAgentRule(zoneID, ruleID string, rule cloudflare.UserAgentRule) (cloudflare.UserAgentRule, error) {
+	if f.UpdateUserAgentRuleFunc != nil {
+		return f.UpdateUserAgentRuleFunc(zoneID, ruleID, rule)
+	}
+	return cloudflare.UserAgentRule{}, fmt.Errorf("cloudflarefake: UpdateUserAgentRule not implemented")
+}
+
+// UpdateVirtualDNS calls f.UpdateVirtualDNSFunc.
+func (f *Fake) UpdateVirtualDNS(virtualDNSID string, vv cloudflare.VirtualDNS) error {
+	if f.UpdateVirtualDNSFunc != nil {
+		return f.UpdateVirtualDNSFunc(virtualDNSID, vv)
+	}
+	return fmt.Errorf("cloudflarefake: UpdateVirtualDNS not implemented")
+}
+
+// UpdateWorkerScriptSettings calls f.UpdateWorkerScriptSettingsFunc.
+func (f *Fake) UpdateWorkerScriptSettings(accountID, scriptName string, settings cloudflare.WorkerScriptSettings) (cloudflare.WorkerScriptSettings, error) {
+	if f.UpdateWorkerScriptSettingsFunc != nil {
+		return f.UpdateWorkerScriptSettingsFunc(accountID, scriptName, settings)
+	}
+	return cloudflare.WorkerScriptSettings{}, fmt.Errorf("cloudflarefake: UpdateWorkerScriptSettings not implemented")
+}
+
+// UpdateZoneAccessRule calls f.UpdateZoneAccessRuleFunc.
+func (f *Fake) UpdateZoneAccessRule(zoneID, ruleID string, rule cloudflare.AccessRule) (cloudflare.AccessRule, error) {
+	if f.UpdateZoneAccessRuleFunc != nil {
+		return f.UpdateZoneAccessRuleFunc(zoneID, ruleID, rule)
+	}
+	return cloudflare.AccessRule{}, fmt.Errorf("cloudflarefake: UpdateZoneAccessRule not implemented")
+}
+
+// UpdateZoneLockdown calls f.UpdateZoneLockdownFunc.
+func (f *Fake) UpdateZoneLockdown(zoneID, ruleID string, rule cloudflare.ZoneLockdown) (cloudflare.ZoneLockdown, error) {
+	if f.UpdateZoneLockdownFunc != nil {
+		return f.UpdateZoneLockdownFunc(zoneID, ruleID, rule)
+	}
+	return cloudflare.ZoneLockdown{}, fmt.Errorf("cloudflarefake: UpdateZoneLockdown not implemented")
+}
+
+// UpdateZoneRulesetPhaseEntrypoint calls f.UpdateZoneRulesetPhaseEntrypointFunc.
+func (f *Fake) UpdateZoneRulesetPhaseEntrypoint(zoneID, phase string, rs cloudflare.Ruleset) (cloudflare.Ruleset, error) {
+	if f.UpdateZoneRulesetPhaseEntrypointFunc != nil {
+		return f.UpdateZoneRulesetPhaseEntrypointFunc(zoneID, phase, rs)
+	}
+	return cloudflare.Ruleset{}, fmt.Errorf("cloudflarefake: UpdateZoneRulesetPhaseEntrypoint not implemented")
+}
+
+// UpdateZoneSettings calls f.UpdateZoneSettingsFunc.
+func (f *Fake) UpdateZoneSettings(zoneID string, settings cloudflare.ZoneSettings) (cloudflare.ZoneSettings, error) {
+	if f.UpdateZoneSettingsFunc != nil {
+		return f.UpdateZoneSettingsFunc(zoneID, settings)
+	}
+	return cloudflare.ZoneSettings{}, fmt.Errorf("cloudflarefake: UpdateZoneSettings not implemented")
+}
+
+// UpdateZoneSubscription calls f.UpdateZoneSubscriptionFunc.
+func (f *Fake) UpdateZoneSubscription(zoneID string, sub cloudflare.Subscription) (cloudflare.Subscription, error) {
+	if f.UpdateZoneSubscriptionFunc != nil {
+		return f.UpdateZoneSubscriptionFunc(zoneID, sub)
+	}
+	return cloudflare.Subscription{}, fmt.Errorf("cloudflarefake: UpdateZoneSubscription not implemented")
+}
+
+// UserDetails calls f.UserDetailsFunc.
+func (f *Fake) UserDetails() (cloudflare.User, error) {
+	if f.UserDetailsFunc != nil {
+		return f.UserDetailsFunc()
+	}
+	return cloudflare.User{}, fmt.Errorf("cloudflarefake: UserDetails not implemented")
+}
+
+// VerifyAPIToken calls f.VerifyAPITokenFunc.
+func (f *Fake) VerifyAPIToken() (cloudflare.APITokenVerification, error) {
+	if f.VerifyAPITokenFunc != nil {
+		return f.VerifyAPITokenFunc()
+	}
+	return cloudflare.APITokenVerification{}, fmt.Errorf("cloudflarefake: VerifyAPIToken not implemented")
+}
+
+// VirtualDNS calls f.VirtualDNSFunc.
+func (f *Fake) VirtualDNS(virtualDNSID string) (*cloudflare.VirtualDNS, error) {
+	if f.VirtualDNSFunc != nil {
+		return f.VirtualDNSFunc(virtualDNSID)
+	}
+	return nil, fmt.Errorf("cloudflarefake: VirtualDNS not implemented")
+}
+
+// WaitForPageRuleStatus calls f.WaitForPageRuleStatusFunc.
+func (f *Fake) WaitForPageRuleStatus(ctx context.Context, zoneID, ruleID string, status cloudflare.PageRuleStatus) (cloudflare.PageRule, error) {
+	if f.WaitForPageRuleStatusFunc != nil {
+		return f.WaitForPageRuleStatusFunc(ctx, zoneID, ruleID, status)
+	}
+	return cloudflare.PageRule{}, fmt.Errorf("cloudflarefake: WaitForPageRuleStatus not implemented")
+}
+
+// WaitForZoneActive calls f.WaitForZoneActiveFunc.
+func (f *Fake) WaitForZoneActive(ctx context.Context, zoneID string) (cloudflare.Zone, error) {
+	if f.WaitForZoneActiveFunc != nil {
+		return f.WaitForZoneActiveFunc(ctx, zoneID)
+	}
+	return cloudflare.Zone{}, fmt.Errorf("cloudflarefake: WaitForZoneActive not implemented")
+}
+
+// WithdrawPrefix calls f.WithdrawPrefixFunc.
+func (f *Fake) WithdrawPrefix(accountID, prefixID string) (cloudflare.PrefixAdvertisementStatus, error) {
+	if f.WithdrawPrefixFunc != nil {
+		return f.WithdrawPrefixFunc(accountID, prefixID)
+	}
+	return cloudflare.PrefixAdvertisementStatus{}, fmt.Errorf("cloudflarefake: WithdrawPrefix not implemented")
+}
+
+// WorkerDeployment calls f.WorkerDeploymentFunc.
+func (f *Fake) WorkerDeployment(accountID, scriptName, deploymentID string) (cloudflare.WorkerDeployment, error) {
+	if f.WorkerDeploymentFunc != nil {
+		return f.WorkerDeploymentFunc(accountID, scriptName, deploymentID)
+	}
+	return cloudflare.WorkerDeployment{}, fmt.Errorf("cloudflarefake: WorkerDeployment not implemented")
+}
+
+// WorkerDeployments calls f.WorkerDeploymentsFunc.
+func (f *Fake) WorkerDeployments(accountID, scriptName string) ([]cloudflare.WorkerDeployment, error) {
+	if f.WorkerDeploymentsFunc != nil {
+		return f.WorkerDeploymentsFunc(accountID, scriptName)
+	}
+	return nil, fmt.Errorf("cloudflarefake: WorkerDeployments not implemented")
+}
+
+// WorkerScriptSettings calls f.WorkerScriptSettingsFunc.
+func (f *Fake) WorkerScriptSettings(accountID, scriptName string) (cloudflare.WorkerScriptSettings, error) {
+	if f.WorkerScriptSettingsFunc != nil {
+		return f.WorkerScriptSettingsFunc(accountID, scriptName)
+	}
+	return cloudflare.WorkerScriptSettings{}, fmt.Errorf("cloudflarefake: WorkerScriptSettings not implemented")
+}
+
+// WorkerVersions calls f.WorkerVersionsFunc.
+func (f *Fake) WorkerVersions(accountID, scriptName string) ([]cloudflare.WorkerVersion, error) {
+	if f.WorkerVersionsFunc != nil {
+		return f.WorkerVersionsFunc(accountID, scriptName)
+	}
+	return nil, fmt.Errorf("cloudflarefake: WorkerVersions not implemented")
+}
+
+// ZoneAccessRules calls f.ZoneAccessRulesFunc.
+func (f *Fake) ZoneAccessRules(zoneID string) ([]cloudflare.AccessRule, error) {
+	if f.ZoneAccessRulesFunc != nil {
+		return f.ZoneAccessRulesFunc(zoneID)
+	}
+	return nil, fmt.Errorf("cloudflarefake: ZoneAccessRules not implemented")
+}
+
+// ZoneActivationCheck calls f.ZoneActivationCheckFunc.
+func (f *Fake) ZoneActivationCheck(zoneID string) (cloudflare.Response, error) {
+	if f.ZoneActivationCheckFunc != nil {
+		return f.ZoneActivationCheckFunc(zoneID)
+	}
+	return cloudflare.Response{}, fmt.Errorf("cloudflarefake: ZoneActivationCheck not implemented")
+}
+
+// ZoneAnalyticsByColocation calls f.ZoneAnalyticsByColocationFunc.
+func (f *Fake) ZoneAnalyticsByColocation(zoneID string, options cloudflare.ZoneAnalyticsOptions) ([]cloudflare.ZoneAnalyticsColocation, error) {
+	if f.ZoneAnalyticsByColocationFunc != nil {
+		return f.ZoneAnalyticsByColocationFunc(zoneID, options)
+	}
+	return nil, fmt.Errorf("cloudflarefake: ZoneAnalyticsByColocation not implemented")
+}
+
+// ZoneAnalyticsDashboard calls f.ZoneAnalyticsDashboardFunc.
+func (f *Fake) ZoneAnalyticsDashboard(zoneID string, options cloudflare.ZoneAnalyticsOptions) (cloudflare.ZoneAnalyticsData, error) {
+	if f.ZoneAnalyticsDashboardFunc != nil {
+		return f.ZoneAnalyticsDashboardFunc(zoneID, options)
+	}
+	return cloudflare.ZoneAnalyticsData{}, fmt.Errorf("cloudflarefake: ZoneAnalyticsDashboard not implemented")
+}
+
+// ZoneAnalyticsDashboardRaw calls f.ZoneAnalyticsDashboardRawFunc.
+func (f *Fake) ZoneAnalyticsDashboardRaw(zoneID string, options cloudflare.ZoneAnalyticsOptions) (json.RawMessage, error) {
+	if f.ZoneAnalyticsDashboardRawFunc != nil {
+		return f.ZoneAnalyticsDashboardRawFunc(zoneID, options)
+	}
+	return json.RawMessage{}, fmt.Errorf("cloudflarefake: ZoneAnalyticsDashboardRaw not implemented")
+}
+
+// ZoneDNSSECStatus calls f.ZoneDNSSECStatusFunc.
+func (f *Fake) ZoneDNSSECStatus(zoneID string) (cloudflare.ZoneDNSSEC, error) {
+	if f.ZoneDNSSECStatusFunc != nil {
+		return f.ZoneDNSSECStatusFunc(zoneID)
+	}
+	return cloudflare.ZoneDNSSEC{}, fmt.Errorf("cloudflarefake: ZoneDNSSECStatus not implemented")
+}
+
+// ZoneDetails calls f.ZoneDetailsFunc.
+func (f *Fake) ZoneDetails(zoneID string) (cloudflare.Zone, error) {
+	if f.ZoneDetailsFunc != nil {
+		return f.ZoneDetailsFunc(zoneID)
+	}
+	return cloudflare.Zone{}, fmt.Errorf("cloudflarefake: ZoneDetails not implemented")
+}
+
+// ZoneDevelopmentMode calls f.ZoneDevelopmentModeFunc.
+func (f *Fake) ZoneDevelopmentMode(zoneID string) (cloudflare.ZoneDevelopmentMode, error) {
+	if f.ZoneDevelopmentModeFunc != nil {
+		return f.ZoneDevelopmentModeFunc(zoneID)
+	}
+	return cloudflare.ZoneDevelopmentMode{}, fmt.Errorf("cloudflarefake: ZoneDevelopmentMode not implemented")
+}
+
+// ZoneIDByName calls f.ZoneIDByNameFunc.
+func (f *Fake) ZoneIDByName(zoneName string) (string, error) {
+	if f.ZoneIDByNameFunc != nil {
+		return f.ZoneIDByNameFunc(zoneName)
+	}
+	return "", fmt.Errorf("cloudflarefake: ZoneIDByName not implemented")
+}
+
+// ZoneInventory calls f.ZoneInventoryFunc.
+func (f *Fake) ZoneInventory(opts cloudflare.ZoneInventoryOptions) ([]cloudflare.AccountZones, error) {
+	if f.ZoneInventoryFunc != nil {
+		return f.ZoneInventoryFunc(opts)
+	}
+	return nil, fmt.Errorf("cloudflarefake: ZoneInventory not implemented")
+}
+
+// ZoneLockdowns calls f.ZoneLockdownsFunc.
+func (f *Fake) ZoneLockdowns(zoneID string) ([]cloudflare.ZoneLockdown, error) {
+	if f.ZoneLockdownsFunc != nil {
+		return f.ZoneLockdownsFunc(zoneID)
+	}
+	return nil, fmt.Errorf("cloudflarefake: ZoneLockdowns not implemented")
+}
+
+// ZonePlanDetails calls f.ZonePlanDetailsFunc.
+func (f *Fake) ZonePlanDetails(zoneID, planID string) (cloudflare.ZonePlan, error) {
+	if f.ZonePlanDetailsFunc != nil {
+		return f.ZonePlanDetailsFunc(zoneID, planID)
+	}
+	return cloudflare.ZonePlan{}, fmt.Errorf("cloudflarefake: ZonePlanDetails not implemented")
+}
+
+// ZoneRailgunDetails calls f.ZoneRailgunDetailsFunc.
+func (f *Fake) ZoneRailgunDetails(zoneID, railgunID string) (cloudflare.ZoneRailgun, error) {
+	if f.ZoneRailgunDetailsFunc != nil {
+		return f.ZoneRailgunDetailsFunc(zoneID, railgunID)
+	}
+	return cloudflare.ZoneRailgun{}, fmt.Errorf("cloudflarefake: ZoneRailgunDetails not implemented")
+}
+
+// ZoneRailguns calls f.ZoneRailgunsFunc.
+func (f *Fake) ZoneRailguns(zoneID string) ([]cloudflare.ZoneRailgun, error) {
+	if f.ZoneRailgunsFunc != nil {
+		return f.ZoneRailgunsFunc(zoneID)
+	}
+	return nil, fmt.Errorf("cloudflarefake: ZoneRailguns not implemented")
+}
+
+// ZoneRulesetPhaseEntrypoint calls f.ZoneRulesetPhaseEntrypointFunc.
+func (f *Fake) ZoneRulesetPhaseEntrypoint(zoneID, phase string) (cloudflare.Ruleset, error) {
+	if f.ZoneRulesetPhaseEntrypointFunc != nil {
+		return f.ZoneRulesetPhaseEntrypointFunc(zoneID, phase)
+	}
+	return cloudflare.Ruleset{}, fmt.Errorf("cloudflarefake: ZoneRulesetPhaseEntrypoint not implemented")
+}
+
+// ZoneSetPaused calls f.ZoneSetPausedFunc.
+func (f *Fake) ZoneSetPaused(zoneID string, paused bool) (cloudflare.Zone, error) {
+	if f.ZoneSetPausedFunc != nil {
+		return f.ZoneSetPausedFunc(zoneID, paused)
+	}
+	return cloudflare.Zone{}, fmt.Errorf("cloudflarefake: ZoneSetPaused not implemented")
+}
+
+// ZoneSetPlan calls f.ZoneSetPlanFunc.
+func (f *Fake) ZoneSetPlan(zoneID string, plan cloudflare.ZonePlan) (cloudflare.Zone, error) {
+	if f.ZoneSetPlanFunc != nil {
+		return f.ZoneSetPlanFunc(zoneID, plan)
+	}
+	return cloudflare.Zone{}, fmt.Errorf("cloudflarefake: ZoneSetPlan not implemented")
+}
+
+// ZoneSetVanityNS calls f.ZoneSetVanityNSFunc.
+func (f *Fake) ZoneSetVanityNS(zoneID string, ns []string) (cloudflare.Zone, error) {
+	if f.ZoneSetVanityNSFunc != nil {
+		return f.ZoneSetVanityNSFunc(zoneID, ns)
+	}
+	return cloudflare.Zone{}, fmt.Errorf("cloudflarefake: ZoneSetVanityNS not implemented")
+}
+
+// ZoneSettings calls f.ZoneSettingsFunc.
+func (f *Fake) ZoneSettings(zoneID string) (cloudflare.ZoneSettings, error) {
+	if f.ZoneSettingsFunc != nil {
+		return f.ZoneSettingsFunc(zoneID)
+	}
+	return cloudflare.ZoneSettings{}, fmt.Errorf("cloudflarefake: ZoneSettings not implemented")
+}
+
+// ZoneSubscription calls f.ZoneSubscriptionFunc.
+func (f *Fake) ZoneSubscription(zoneID string) (cloudflare.Subscription, error) {
+	if f.ZoneSubscriptionFunc != nil {
+		return f.ZoneSubscriptionFunc(zoneID)
+	}
+	return cloudflare.Subscription{}, fmt.Errorf("cloudflarefake: ZoneSubscription not implemented")
+}
@@ -0,0 +1,38 @@
+package cloudflarefake
+
+import (
+	"testing"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/stretchr/testify/assert"
+)
+
+// zoneName is an example of code under test that accepts a cloudflare.Client.
+func zoneName(api cloudflare.Client, zoneID string) (string, error) {
+	z, err := api.ZoneDetails(zoneID)
+	if err != nil {
+		return "", err
+	}
+	return z.Name, nil
+}
+
+func TestFake(t *testing.T) {
+	f := &Fake{
+		ZoneDetailsFunc: func(zoneID string) (cloudflare.Zone, error) {
+			assert.Equal(t, "023e105f4ecef8ad9ca31a8372d0c353", zoneID)
+			return cloudflare.Zone{ID: zoneID, Name: "example.com"}, nil
+		},
+	}
+
+	name, err := zoneName(f, "023e105f4ecef8ad9ca31a8372d0c353")
+	if assert.NoError(t, err) {
+		assert.Equal(t, "example.com", name)
+	}
+}
+
+func TestFake_NotImplemented(t *testing.T) {
+	f := &Fake{}
+
+	_, err := f.ListPageRules("023e105f4ecef8ad9ca31a8372d0c353")
+	assert.EqualError(t, err, "cloudflarefake: ListPageRules not implemented")
+}
@@ -0,0 +1,81 @@
+// Package cloudflareotel traces the API calls made by a cloudflare.API client
+// with OpenTelemetry.
+//
+//	api, err := cloudflare.New(key, email, cloudflareotel.Tracing(tracerProvider))
+//
+// A client span is recorded for each HTTP request, with the request's method,
+// URL and status code, a templated endpoint (e.g. /zones/:zone_id/dns_records)
+// and the zone ID, if any. Retries of a request are recorded as separate spans
+// with a cloudflare.retry_count attribute.
+package cloudflareotel
+
+import (
+	"net/http"
+
+	"github.com/cloudflare/cloudflare-go"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const instrumentationName = "github.com/cloudflare/cloudflare-go/cloudflareotel"
+
+// Attribute keys set on spans in addition to the HTTP semantic conventions.
+const (
+	EndpointKey   = attribute.Key("cloudflare.endpoint")
+	ZoneIDKey     = attribute.Key("cloudflare.zone_id")
+	AccountIDKey  = attribute.Key("cloudflare.account_id")
+	RetryCountKey = attribute.Key("cloudflare.retry_count")
+)
+
+// Tracing returns a client option which records a span for every API request
+// using a tracer from tp. Spans are children of the span in the context of
+// the request, if any.
+func Tracing(tp trace.TracerProvider) cloudflare.Option {
+	return cloudflare.Intercept(&interceptor{tracer: tp.Tracer(instrumentationName)})
+}
+
+type interceptor struct {
+	tracer trace.Tracer
+}
+
+func (i *interceptor) BeforeRequest(req *http.Request) error {
+	endpoint, ids := cloudflare.RequestEndpoint(req)
+	attrs := []attribute.KeyValue{
+		attribute.String("http.request.method", req.Method),
+		attribute.String("url.full", req.URL.String()),
+		attribute.String("server.address", req.URL.Hostname()),
+		EndpointKey.String(endpoint),
+		RetryCountKey.Int(cloudflare.RequestAttempt(req) - 1),
+	}
+	if id, ok := ids["zone_id"]; ok {
+		attrs = append(attrs, ZoneIDKey.String(id))
+	}
+	if id, ok := ids["account_id"]; ok {
+		attrs = append(attrs, AccountIDKey.String(id))
+	}
+
+	ctx, _ := i.tracer.Start(req.Context(), "cloudflare "+req.Method+" "+endpoint,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(attrs...),
+	)
+	// The client keeps using req, so it is updated in place to carry the span
+	// to AfterResponse and to any instrumentation in the HTTP transport.
+	*req = *req.WithContext(ctx)
+	return nil
+}
+
+func (i *interceptor) AfterResponse(res *cloudflare.InterceptedResponse) {
+	span := trace.SpanFromContext(res.Request.Context())
+	defer span.End()
+
+	if res.Err != nil {
+		span.RecordError(res.Err)
+		span.SetStatus(codes.Error, res.Err.Error())
+		return
+	}
+	span.SetAttributes(attribute.Int("http.response.status_code", res.StatusCode))
+	if res.StatusCode >= 400 {
+		span.SetStatus(codes.Error, http.StatusText(res.StatusCode))
+	}
+}
@@ -0,0 +1,51 @@
+package cloudflareotel
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+const zoneID = "023e105f4ecef8ad9ca31a8372d0c353"
+
+func TestTracing(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+	mux.HandleFunc("/zones/"+zoneID, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{"success": true, "errors": [], "messages": [], "result": {"id": %q}}`, zoneID)
+	})
+
+	sr := tracetest.NewSpanRecorder()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(sr))
+	api, err := cloudflare.New("deadbeef", "cloudflare@example.org", cloudflare.BaseURL(server.URL), Tracing(tp))
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	_, err = api.ZoneDetails(zoneID)
+	assert.NoError(t, err)
+	_, err = api.ZoneDetails("missing")
+	assert.Error(t, err)
+
+	spans := sr.Ended()
+	if assert.Len(t, spans, 2) {
+		assert.Equal(t, "cloudflare GET /zones/:zone_id", spans[0].Name())
+		assert.Contains(t, spans[0].Attributes(), ZoneIDKey.String(zoneID))
+		assert.Contains(t, spans[0].Attributes(), RetryCountKey.Int(0))
+		assert.Contains(t, spans[0].Attributes(), attribute.Int("http.response.status_code", 200))
+		assert.Equal(t, codes.Unset, spans[0].Status().Code)
+
+		assert.Equal(t, "cloudflare GET /zones/missing", spans[1].Name())
+		assert.Equal(t, codes.Error, spans[1].Status().Code)
+	}
+}
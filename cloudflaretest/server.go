@@ -0,0 +1,504 @@
+// Package cloudflaretest provides an in-memory fake of the Cloudflare API,
+// served over HTTP, for testing code which uses the cloudflare package without
+// access to the real API.
+//
+// The fake implements zones, DNS records and page rules. Objects are seeded
+// with the Add methods, changed through the API like real objects, and
+// inspected afterwards:
+//
+//	srv := cloudflaretest.NewServer()
+//	defer srv.Close()
+//
+//	zone := srv.AddZone(cloudflare.Zone{Name: "example.com"})
+//	api, err := srv.Client()
+//	if err != nil {
+//		t.Fatal(err)
+//	}
+//
+//	// ... code under test creating records with api ...
+//
+//	records := srv.DNSRecords(zone.ID)
+//
+// Other endpoints can be faked with Handle, using WriteResult and WriteError
+// to produce responses in the API's format.
+package cloudflaretest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cloudflare/cloudflare-go"
+)
+
+// The credentials of clients returned by Server.Client. The fake accepts any
+// credentials, but requires requests to have some.
+const (
+	APIKey   = "deadbeef"
+	APIEmail = "user@example.com"
+)
+
+const defaultPerPage = 20
+
+// Server is a fake Cloudflare API. It is safe for concurrent use.
+type Server struct {
+	// URL is the base URL of the fake API, for use with cloudflare.BaseURL.
+	URL string
+
+	server *httptest.Server
+
+	mu        sync.Mutex
+	handlers  map[string]http.Handler
+	zones     []cloudflare.Zone
+	records   map[string][]cloudflare.DNSRecord
+	pageRules map[string][]cloudflare.PageRule
+	lastID    int
+}
+
+// NewServer starts a fake Cloudflare API with no objects. It should be
+// stopped with Close.
+func NewServer() *Server {
+	s := &Server{
+		handlers:  make(map[string]http.Handler),
+		records:   make(map[string][]cloudflare.DNSRecord),
+		pageRules: make(map[string][]cloudflare.PageRule),
+	}
+	s.server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	s.URL = s.server.URL
+	return s
+}
+
+// Close stops the server.
+func (s *Server) Close() {
+	s.server.Close()
+}
+
+// Client returns an API client for the fake API. Options are applied after
+// the one setting the client's base URL.
+func (s *Server) Client(opts ...cloudflare.Option) (*cloudflare.API, error) {
+	opts = append([]cloudflare.Option{cloudflare.BaseURL(s.URL)}, opts...)
+	return cloudflare.New(APIKey, APIEmail, opts...)
+}
+
+// Handle registers a handler for requests with any method to path (e.g.
+// "/zones/023e105f4ecef8ad9ca31a8372d0c353/settings"), taking precedence over
+// the fake's own endpoints.
+func (s *Server) Handle(path string, handler http.Handler) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.handlers[path] = handler
+}
+
+// AddZone adds a zone, assigning it an ID if it has none, and returns it.
+func (s *Server) AddZone(zone cloudflare.Zone) cloudflare.Zone {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if zone.ID == "" {
+		zone.ID = s.newID()
+	}
+	if zone.Status == "" {
+		zone.Status = "active"
+	}
+	s.zones = append(s.zones, zone)
+	return zone
+}
+
+// AddDNSRecord adds a DNS record to a zone, assigning it an ID if it has
+// none, and returns it.
+func (s *Server) AddDNSRecord(zoneID string, rr cloudflare.DNSRecord) cloudflare.DNSRecord {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	rr = s.newDNSRecord(zoneID, rr)
+	s.records[zoneID] = append(s.records[zoneID], rr)
+	return rr
+}
+
+// AddPageRule adds a page rule to a zone, assigning it an ID if it has none,
+// and returns it.
+func (s *Server) AddPageRule(zoneID string, rule cloudflare.PageRule) cloudflare.PageRule {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	rule = s.newPageRule(rule)
+	s.pageRules[zoneID] = append(s.pageRules[zoneID], rule)
+	return rule
+}
+
+// Zones returns the zones known to the server.
+func (s *Server) Zones() []cloudflare.Zone {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]cloudflare.Zone(nil), s.zones...)
+}
+
+// DNSRecords returns the DNS records of a zone.
+func (s *Server) DNSRecords(zoneID string) []cloudflare.DNSRecord {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]cloudflare.DNSRecord(nil), s.records[zoneID]...)
+}
+
+// PageRules returns the page rules of a zone.
+func (s *Server) PageRules(zoneID string) []cloudflare.PageRule {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]cloudflare.PageRule(nil), s.pageRules[zoneID]...)
+}
+
+// WriteResult writes a successful API response with result.
+func WriteResult(w http.ResponseWriter, result interface{}) {
+	writeResponse(w, http.StatusOK, result, nil, nil)
+}
+
+// WriteError writes a failed API response with an error.
+func WriteError(w http.ResponseWriter, status, code int, message string) {
+	writeResponse(w, status, nil, nil, []cloudflare.ResponseInfo{{Code: code, Message: message}})
+}
+
+func writeResponse(w http.ResponseWriter, status int, result interface{}, info *cloudflare.ResultInfo, errs []cloudflare.ResponseInfo) {
+	resp := struct {
+		Success    bool                      `json:"success"`
+		Errors     []cloudflare.ResponseInfo `json:"errors"`
+		Messages   []cloudflare.ResponseInfo `json:"messages"`
+		Result     interface{}               `json:"result"`
+		ResultInfo *cloudflare.ResultInfo    `json:"result_info,omitempty"`
+	}{
+		Success:    len(errs) == 0,
+		Errors:     errs,
+		Messages:   []cloudflare.ResponseInfo{},
+		Result:     result,
+		ResultInfo: info,
+	}
+	if resp.Errors == nil {
+		resp.Errors = []cloudflare.ResponseInfo{}
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(resp)
+}
+
+// writePage writes the page of items requested by r.
+func writePage(w http.ResponseWriter, r *http.Request, items []interface{}) {
+	q := r.URL.Query()
+	page, _ := strconv.Atoi(q.Get("page"))
+	if page < 1 {
+		page = 1
+	}
+	perPage, _ := strconv.Atoi(q.Get("per_page"))
+	if perPage < 1 {
+		perPage = defaultPerPage
+	}
+
+	start := (page - 1) * perPage
+	if start > len(items) {
+		start = len(items)
+	}
+	end := start + perPage
+	if end > len(items) {
+		end = len(items)
+	}
+	info := cloudflare.ResultInfo{
+		Page:       page,
+		PerPage:    perPage,
+		Count:      end - start,
+		Total:      len(items),
+		TotalPages: (len(items) + perPage - 1) / perPage,
+	}
+	writeResponse(w, http.StatusOK, items[start:end], &info, nil)
+}
+
+func writeNotFound(w http.ResponseWriter, r *http.Request) {
+	WriteError(w, http.StatusNotFound, 7003, "Could not route to "+r.URL.Path+", perhaps your object identifier is invalid?")
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Header.Get("Authorization") == "" && r.Header.Get("X-Auth-Key") == "" && r.Header.Get("X-Auth-User-Service-Key") == "" {
+		WriteError(w, http.StatusBadRequest, 9106, "Missing X-Auth-Key, X-Auth-Email or Authorization headers")
+		return
+	}
+
+	s.mu.Lock()
+	h, ok := s.handlers[r.URL.Path]
+	s.mu.Unlock()
+	if ok {
+		h.ServeHTTP(w, r)
+		return
+	}
+
+	// Paths are /zones[/:zone_id[/:collection[/:id]]].
+	parts := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	if parts[0] != "zones" || len(parts) > 4 {
+		WriteError(w, http.StatusBadRequest, 7000, "No route for that URI")
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(parts) == 1 {
+		s.serveZones(w, r)
+		return
+	}
+	zone := s.zone(parts[1])
+	if zone == nil {
+		writeNotFound(w, r)
+		return
+	}
+	if len(parts) == 2 {
+		s.serveZone(w, r, zone)
+		return
+	}
+	var id string
+	if len(parts) == 4 {
+		id = parts[3]
+	}
+	switch parts[2] {
+	case "dns_records":
+		s.serveDNSRecords(w, r, zone, id)
+	case "pagerules":
+		s.servePageRules(w, r, zone, id)
+	default:
+		WriteError(w, http.StatusBadRequest, 7000, "No route for that URI")
+	}
+}
+
+func (s *Server) serveZones(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "GET" {
+		WriteError(w, http.StatusMethodNotAllowed, 10000, "Method "+r.Method+" not allowed")
+		return
+	}
+	name := r.URL.Query().Get("name")
+	items := []interface{}{}
+	for _, z := range s.zones {
+		if name == "" || z.Name == name {
+			items = append(items, z)
+		}
+	}
+	writePage(w, r, items)
+}
+
+func (s *Server) serveZone(w http.ResponseWriter, r *http.Request, zone *cloudflare.Zone) {
+	switch r.Method {
+	case "GET":
+		WriteResult(w, zone)
+	case "DELETE":
+		for i := range s.zones {
+			if &s.zones[i] == zone {
+				s.zones = append(s.zones[:i], s.zones[i+1:]...)
+				break
+			}
+		}
+		delete(s.records, zone.ID)
+		delete(s.pageRules, zone.ID)
+		WriteResult(w, cloudflare.ZoneID{ID: zone.ID})
+	default:
+		WriteError(w, http.StatusMethodNotAllowed, 10000, "Method "+r.Method+" not allowed")
+	}
+}
+
+func (s *Server) serveDNSRecords(w http.ResponseWriter, r *http.Request, zone *cloudflare.Zone, id string) {
+	records := s.records[zone.ID]
+
+	if id == "" {
+		switch r.Method {
+		case "GET":
+			q := r.URL.Query()
+			items := []interface{}{}
+			for _, rr := range records {
+				if (q.Get("name") == "" || rr.Name == q.Get("name")) &&
+					(q.Get("type") == "" || rr.Type == q.Get("type")) &&
+					(q.Get("content") == "" || rr.Content == q.Get("content")) {
+					items = append(items, rr)
+				}
+			}
+			writePage(w, r, items)
+		case "POST":
+			var rr cloudflare.DNSRecord
+			if err := json.NewDecoder(r.Body).Decode(&rr); err != nil {
+				WriteError(w, http.StatusBadRequest, 9207, "Request body is invalid: "+err.Error())
+				return
+			}
+			if rr.Type == "" || rr.Name == "" {
+				WriteError(w, http.StatusBadRequest, 9000, "DNS record type and name are required")
+				return
+			}
+			rr.ID = ""
+			rr.ZoneName = zone.Name
+			rr = s.newDNSRecord(zone.ID, rr)
+			s.records[zone.ID] = append(records, rr)
+			WriteResult(w, rr)
+		default:
+			WriteError(w, http.StatusMethodNotAllowed, 10000, "Method "+r.Method+" not allowed")
+		}
+		return
+	}
+
+	i := -1
+	for j := range records {
+		if records[j].ID == id {
+			i = j
+		}
+	}
+	if i < 0 {
+		WriteError(w, http.StatusNotFound, 81044, "Record does not exist.")
+		return
+	}
+	switch r.Method {
+	case "GET":
+		WriteResult(w, records[i])
+	case "PUT", "PATCH":
+		rr := records[i]
+		if r.Method == "PUT" {
+			rr = cloudflare.DNSRecord{ZoneID: zone.ID, ZoneName: zone.Name}
+		}
+		if err := decodeChanges(r, &rr); err != nil {
+			WriteError(w, http.StatusBadRequest, 9207, "Request body is invalid: "+err.Error())
+			return
+		}
+		rr.ID = id
+		rr.CreatedOn = records[i].CreatedOn
+		rr.ModifiedOn = time.Now().UTC()
+		records[i] = rr
+		WriteResult(w, rr)
+	case "DELETE":
+		s.records[zone.ID] = append(records[:i], records[i+1:]...)
+		WriteResult(w, struct {
+			ID string `json:"id"`
+		}{id})
+	default:
+		WriteError(w, http.StatusMethodNotAllowed, 10000, "Method "+r.Method+" not allowed")
+	}
+}
+
+func (s *Server) servePageRules(w http.ResponseWriter, r *http.Request, zone *cloudflare.Zone, id string) {
+	rules := s.pageRules[zone.ID]
+
+	if id == "" {
+		switch r.Method {
+		case "GET":
+			WriteResult(w, append([]cloudflare.PageRule{}, rules...))
+		case "POST":
+			var rule cloudflare.PageRule
+			if err := json.NewDecoder(r.Body).Decode(&rule); err != nil {
+				WriteError(w, http.StatusBadRequest, 9207, "Request body is invalid: "+err.Error())
+				return
+			}
+			rule.ID = ""
+			rule = s.newPageRule(rule)
+			s.pageRules[zone.ID] = append(rules, rule)
+			WriteResult(w, rule)
+		default:
+			WriteError(w, http.StatusMethodNotAllowed, 10000, "Method "+r.Method+" not allowed")
+		}
+		return
+	}
+
+	i := -1
+	for j := range rules {
+		if rules[j].ID == id {
+			i = j
+		}
+	}
+	if i < 0 {
+		writeNotFound(w, r)
+		return
+	}
+	switch r.Method {
+	case "GET":
+		WriteResult(w, rules[i])
+	case "PUT", "PATCH":
+		rule := rules[i]
+		if r.Method == "PUT" {
+			rule = cloudflare.PageRule{}
+		}
+		if err := decodeChanges(r, &rule); err != nil {
+			WriteError(w, http.StatusBadRequest, 9207, "Request body is invalid: "+err.Error())
+			return
+		}
+		rule.ID = id
+		rule.CreatedOn = rules[i].CreatedOn
+		rule.ModifiedOn = time.Now().UTC()
+		rules[i] = rule
+		WriteResult(w, rule)
+	case "DELETE":
+		s.pageRules[zone.ID] = append(rules[:i], rules[i+1:]...)
+		WriteResult(w, struct {
+			ID string `json:"id"`
+		}{id})
+	default:
+		WriteError(w, http.StatusMethodNotAllowed, 10000, "Method "+r.Method+" not allowed")
+	}
+}
+
+// decodeChanges decodes the body of r onto v. Fields which are null in the
+// body, as the client sends for unset slices, are left unchanged.
+func decodeChanges(r *http.Request, v interface{}) error {
+	var fields map[string]json.RawMessage
+	if err := json.NewDecoder(r.Body).Decode(&fields); err != nil {
+		return err
+	}
+	for k, f := range fields {
+		if string(f) == "null" {
+			delete(fields, k)
+		}
+	}
+	b, err := json.Marshal(fields)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(b, v)
+}
+
+// zone returns the zone with an ID, or nil. s.mu must be held.
+func (s *Server) zone(id string) *cloudflare.Zone {
+	for i := range s.zones {
+		if s.zones[i].ID == id {
+			return &s.zones[i]
+		}
+	}
+	return nil
+}
+
+// newDNSRecord fills in the fields of a record which the API sets. s.mu must
+// be held.
+func (s *Server) newDNSRecord(zoneID string, rr cloudflare.DNSRecord) cloudflare.DNSRecord {
+	if rr.ID == "" {
+		rr.ID = s.newID()
+	}
+	if rr.TTL == 0 {
+		rr.TTL = 1
+	}
+	rr.ZoneID = zoneID
+	if rr.ZoneName == "" {
+		if z := s.zone(zoneID); z != nil {
+			rr.ZoneName = z.Name
+		}
+	}
+	now := time.Now().UTC()
+	rr.CreatedOn, rr.ModifiedOn = now, now
+	return rr
+}
+
+// newPageRule fills in the fields of a page rule which the API sets. s.mu
+// must be held.
+func (s *Server) newPageRule(rule cloudflare.PageRule) cloudflare.PageRule {
+	if rule.ID == "" {
+		rule.ID = s.newID()
+	}
+	if rule.Status == "" {
+		rule.Status = "disabled"
+	}
+	now := time.Now().UTC()
+	rule.CreatedOn, rule.ModifiedOn = now, now
+	return rule
+}
+
+// newID returns a unique identifier in the API's format. s.mu must be held.
+func (s *Server) newID() string {
+	s.lastID++
+	return fmt.Sprintf("%032x", s.lastID)
+}
@@ -0,0 +1,134 @@
+package cloudflaretest
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestZones(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+
+	zone := srv.AddZone(cloudflare.Zone{Name: "example.com"})
+	srv.AddZone(cloudflare.Zone{Name: "example.org"})
+	api, err := srv.Client()
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	zones, err := api.ListZones()
+	if assert.NoError(t, err) {
+		assert.Len(t, zones, 2)
+	}
+
+	id, err := api.ZoneIDByName("example.com")
+	if assert.NoError(t, err) {
+		assert.Equal(t, zone.ID, id)
+	}
+
+	_, err = api.ZoneDetails("missing")
+	if apiErr, ok := cloudflare.AsAPIError(err); assert.True(t, ok) {
+		assert.True(t, apiErr.IsNotFound())
+	}
+}
+
+func TestDNSRecords(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+
+	zone := srv.AddZone(cloudflare.Zone{Name: "example.com"})
+	for i := 0; i < 25; i++ {
+		srv.AddDNSRecord(zone.ID, cloudflare.DNSRecord{Type: "TXT", Name: "example.com", Content: "v=spf1 -all"})
+	}
+	api, err := srv.Client()
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	// Listing pages through the records.
+	records, err := api.DNSRecords(zone.ID, cloudflare.DNSRecord{Type: "TXT"})
+	if assert.NoError(t, err) {
+		assert.Len(t, records, 25)
+	}
+
+	resp, err := api.CreateDNSRecord(zone.ID, cloudflare.DNSRecord{Type: "A", Name: "www.example.com", Content: "192.0.2.1"})
+	if !assert.NoError(t, err) {
+		return
+	}
+	id := resp.Result.ID
+	assert.NotEmpty(t, id)
+	assert.Equal(t, "example.com", resp.Result.ZoneName)
+
+	err = api.UpdateDNSRecord(zone.ID, id, cloudflare.DNSRecord{Content: "192.0.2.2"})
+	assert.NoError(t, err)
+	rr, err := api.DNSRecord(zone.ID, id)
+	if assert.NoError(t, err) {
+		assert.Equal(t, "192.0.2.2", rr.Content)
+	}
+
+	assert.NoError(t, api.DeleteDNSRecord(zone.ID, id))
+	assert.Len(t, srv.DNSRecords(zone.ID), 25)
+
+	_, err = api.DNSRecord(zone.ID, id)
+	if apiErr, ok := cloudflare.AsAPIError(err); assert.True(t, ok) {
+		assert.True(t, apiErr.HasErrorCode(81044))
+	}
+}
+
+func TestPageRules(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+
+	zone := srv.AddZone(cloudflare.Zone{Name: "example.com"})
+	api, err := srv.Client()
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	rule, err := api.CreatePageRule(zone.ID, cloudflare.PageRule{
+		Targets: []cloudflare.PageRuleTarget{{Target: "url", Constraint: struct {
+			Operator string `json:"operator"`
+			Value    string `json:"value"`
+		}{Operator: "matches", Value: "*example.com/images/*"}}},
+		Actions: []cloudflare.PageRuleAction{{ID: "always_online", Value: "on"}},
+		Status:  "active",
+	})
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	rule, err = api.ChangePageRule(zone.ID, rule.ID, cloudflare.PageRule{Status: cloudflare.PageRuleStatusPaused})
+	if assert.NoError(t, err) {
+		assert.Equal(t, cloudflare.PageRuleStatusPaused, rule.Status)
+		assert.Len(t, rule.Actions, 1)
+	}
+
+	rules, err := api.ListPageRules(zone.ID)
+	if assert.NoError(t, err) && assert.Len(t, rules, 1) {
+		assert.Equal(t, rule.ID, rules[0].ID)
+	}
+
+	assert.NoError(t, api.DeletePageRule(zone.ID, rule.ID))
+	assert.Empty(t, srv.PageRules(zone.ID))
+}
+
+func TestHandle(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+
+	srv.Handle("/user", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		WriteResult(w, cloudflare.User{ID: "1", Email: "user@example.com"})
+	}))
+	api, err := srv.Client()
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	user, err := api.UserDetails()
+	if assert.NoError(t, err) {
+		assert.Equal(t, "user@example.com", user.Email)
+	}
+}
@@ -55,12 +55,19 @@ func makeTable(zones []table, cols ...string) {
 func checkEnv() error {
 	if api == nil {
 		var err error
-		api, err = cloudflare.New(os.Getenv("CF_API_KEY"), os.Getenv("CF_API_EMAIL"))
+		if token := os.Getenv("CF_API_TOKEN"); token != "" {
+			api, err = cloudflare.NewWithAPIToken(token)
+		} else {
+			api, err = cloudflare.New(os.Getenv("CF_API_KEY"), os.Getenv("CF_API_EMAIL"))
+		}
 		if err != nil {
 			log.Fatal(err)
 		}
 	}
 
+	if api.APIToken != "" {
+		return nil
+	}
 	if api.APIKey == "" {
 		return errors.New("API key not defined")
 	}
@@ -0,0 +1,53 @@
+package cloudflare
+
+import (
+	"encoding/json"
+
+	"github.com/pkg/errors"
+)
+
+// Codec encodes and decodes JSON. Its methods have the signatures of
+// json.Marshal and json.Unmarshal, which most alternative JSON packages
+// (e.g. jsoniter and sonic) provide, and must support json.RawMessage and
+// the encoding/json struct tags.
+type Codec interface {
+	Marshal(v interface{}) ([]byte, error)
+	Unmarshal(data []byte, v interface{}) error
+}
+
+// stdCodec is the Codec used by default, backed by encoding/json.
+type stdCodec struct{}
+
+func (stdCodec) Marshal(v interface{}) ([]byte, error) {
+	return json.Marshal(v)
+}
+
+func (stdCodec) Unmarshal(data []byte, v interface{}) error {
+	return json.Unmarshal(data, v)
+}
+
+// JSONCodec makes the client encode request bodies and decode responses with
+// codec in place of encoding/json, e.g. to speed up listing thousands of
+// zones:
+//
+//	api, err := cloudflare.New(key, email, cloudflare.JSONCodec(jsoniter.ConfigCompatibleWithStandardLibrary))
+//
+// Methods which stream their results, such as StreamDNSRecords, use
+// encoding/json to split up the response, but decode each item with codec.
+func JSONCodec(codec Codec) Option {
+	return func(api *API) error {
+		if codec == nil {
+			return errors.New("JSON codec must not be nil")
+		}
+		api.codec = codec
+		return nil
+	}
+}
+
+// json returns the client's Codec.
+func (api *API) json() Codec {
+	if api.codec == nil {
+		return stdCodec{}
+	}
+	return api.codec
+}
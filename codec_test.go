@@ -0,0 +1,130 @@
+package cloudflare
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// countingCodec is a Codec counting its calls.
+type countingCodec struct {
+	stdCodec
+	marshals, unmarshals int
+}
+
+func (c *countingCodec) Marshal(v interface{}) ([]byte, error) {
+	c.marshals++
+	return c.stdCodec.Marshal(v)
+}
+
+func (c *countingCodec) Unmarshal(data []byte, v interface{}) error {
+	c.unmarshals++
+	return c.stdCodec.Unmarshal(data, v)
+}
+
+func TestJSONCodec(t *testing.T) {
+	setup()
+	defer teardown()
+
+	codec := &countingCodec{}
+	assert.Error(t, JSONCodec(nil)(client))
+	assert.NoError(t, JSONCodec(codec)(client))
+
+	mux.HandleFunc("/zones/z1/pagerules/r1", func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		assert.Contains(t, string(body), `"status":"paused"`)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"id": "r1", "priority": "2"}}`)
+	})
+	rule, err := client.ChangePageRule("z1", "r1", PageRule{Status: PageRuleStatusPaused})
+	if assert.NoError(t, err) {
+		assert.Equal(t, FlexibleInt(2), rule.Priority)
+	}
+	assert.Equal(t, 1, codec.marshals)
+	assert.Equal(t, 2, codec.unmarshals)
+}
+
+func TestJSONCodecStream(t *testing.T) {
+	setup()
+	defer teardown()
+
+	codec := &countingCodec{}
+	assert.NoError(t, JSONCodec(codec)(client))
+
+	mux.HandleFunc("/zones/z1/pagerules", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": [{"id": "r1"}, {"id": "r2"}]}`)
+	})
+	var ids []string
+	err := client.StreamPageRules("z1", func(rule PageRule) error {
+		ids = append(ids, rule.ID)
+		return nil
+	})
+	if assert.NoError(t, err) {
+		assert.Equal(t, []string{"r1", "r2"}, ids)
+	}
+	// The success and errors fields, then each item.
+	assert.Equal(t, 4, codec.unmarshals)
+}
+
+// streamCodec is a Codec using json.Encoder and json.Decoder, to compare
+// stdCodec against.
+type streamCodec struct{}
+
+func (streamCodec) Marshal(v interface{}) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(v); err != nil {
+		return nil, err
+	}
+	return bytes.TrimSuffix(buf.Bytes(), []byte("\n")), nil
+}
+
+func (streamCodec) Unmarshal(data []byte, v interface{}) error {
+	return json.NewDecoder(bytes.NewReader(data)).Decode(v)
+}
+
+// BenchmarkListZones measures listing a large account, to compare Codecs.
+func BenchmarkListZones(b *testing.B) {
+	setup()
+	defer teardown()
+
+	const perPage, pages = 50, 20
+	zone := `{"id": "023e105f4ecef8ad9ca31a8372d0c353", "name": "example.com", "status": "active", "paused": false, "type": "full",
+		"name_servers": ["bob.ns.cloudflare.com", "lola.ns.cloudflare.com"], "original_name_servers": ["ns1.example.com"],
+		"plan": {"id": "e592fd9519420ba7405e1307bff33214", "name": "Pro Plan", "price": 20, "currency": "USD", "frequency": "monthly"},
+		"created_on": "2014-01-01T05:20:00.12345Z", "modified_on": "2014-01-01T05:20:00.12345Z"}`
+	result := "[" + strings.TrimSuffix(strings.Repeat(zone+",", perPage), ",") + "]"
+	mux.HandleFunc("/zones", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{"success": true, "errors": [], "messages": [], "result": %s,
+			"result_info": {"page": %s, "per_page": %d, "count": %d, "total_count": %d, "total_pages": %d}}`,
+			result, r.URL.Query().Get("page"), perPage, perPage, perPage*pages, pages)
+	})
+
+	for _, bm := range []struct {
+		name  string
+		codec Codec
+	}{
+		{"encoding/json", stdCodec{}},
+		{"json.Decoder", streamCodec{}},
+	} {
+		b.Run(bm.name, func(b *testing.B) {
+			if err := JSONCodec(bm.codec)(client); err != nil {
+				b.Fatal(err)
+			}
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				zones, err := client.ListZones()
+				if err != nil || len(zones) != perPage*pages {
+					b.Fatal(len(zones), err)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,127 @@
+package cloudflare
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// CompressRequests gzip-compresses request bodies of at least minSize bytes,
+// such as large DNS imports and Workers uploads, which saves time on slow
+// links. Streamed bodies of unknown size are always compressed, and are then
+// sent with chunked encoding.
+//
+// Responses are compressed by the API whenever the client accepts it, which
+// the transport created by New does by default.
+func CompressRequests(minSize int) Option {
+	return func(api *API) error {
+		if minSize < 0 {
+			return errors.New("minimum size to compress must not be negative")
+		}
+		api.compressRequests = true
+		api.compressMinSize = int64(minSize)
+		return nil
+	}
+}
+
+// compressBody returns body compressed, and whether it was, if the client
+// compresses request bodies of its size. JSON bodies are compressed up front,
+// so the request can still be retried and logged; streamed bodies are
+// compressed as they are sent.
+func (api *API) compressBody(body io.Reader) (io.Reader, bool, error) {
+	if !api.compressRequests || body == nil {
+		return body, false, nil
+	}
+	switch b := body.(type) {
+	case *bytes.Reader:
+		if int64(b.Len()) < api.compressMinSize {
+			return body, false, nil
+		}
+		var buf bytes.Buffer
+		gz := gzip.NewWriter(&buf)
+		if _, err := b.WriteTo(gz); err != nil {
+			return nil, false, errors.Wrap(err, "could not compress request body")
+		}
+		if err := gz.Close(); err != nil {
+			return nil, false, errors.Wrap(err, "could not compress request body")
+		}
+		return bytes.NewReader(buf.Bytes()), true, nil
+	case sizedReader:
+		if b.size >= 0 && b.size < api.compressMinSize {
+			return body, false, nil
+		}
+	}
+	return newGzipReader(body), true, nil
+}
+
+// gzipReader compresses a stream as it is read. Compression only starts on the
+// first read, and stops when the reader is closed, which the transport does
+// once a request has been sent or has failed.
+type gzipReader struct {
+	src   io.Reader
+	pr    *io.PipeReader
+	pw    *io.PipeWriter
+	start sync.Once
+}
+
+func newGzipReader(src io.Reader) *gzipReader {
+	pr, pw := io.Pipe()
+	return &gzipReader{src: src, pr: pr, pw: pw}
+}
+
+func (r *gzipReader) Read(p []byte) (int, error) {
+	r.start.Do(func() {
+		go func() {
+			gz := gzip.NewWriter(r.pw)
+			_, err := io.Copy(gz, r.src)
+			if err == nil {
+				err = gz.Close()
+			}
+			r.pw.CloseWithError(err)
+		}()
+	})
+	return r.pr.Read(p)
+}
+
+func (r *gzipReader) Close() error {
+	return r.pr.Close()
+}
+
+// decompressResponse decodes a gzip-compressed response body which the
+// transport has not, as happens when Accept-Encoding was set by the caller or
+// with a custom transport.
+func decompressResponse(resp *http.Response) error {
+	if resp.Uncompressed || !strings.EqualFold(resp.Header.Get("Content-Encoding"), "gzip") {
+		return nil
+	}
+	gz, err := gzip.NewReader(resp.Body)
+	switch {
+	case err == io.EOF:
+		// An empty body, e.g. of a 304 response, is left as it is.
+		return nil
+	case err != nil:
+		resp.Body.Close()
+		return errors.Wrap(err, "could not decompress response body")
+	}
+	resp.Body = gzipBody{Reader: gz, body: resp.Body}
+	resp.Header.Del("Content-Encoding")
+	resp.Header.Del("Content-Length")
+	resp.ContentLength = -1
+	resp.Uncompressed = true
+	return nil
+}
+
+// gzipBody is a decompressed response body.
+type gzipBody struct {
+	*gzip.Reader
+	body io.ReadCloser
+}
+
+func (b gzipBody) Close() error {
+	return b.body.Close()
+}
@@ -0,0 +1,91 @@
+package cloudflare
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// gunzipRequest replaces the body of a compressed request with its
+// decompressed content.
+func gunzipRequest(t *testing.T, r *http.Request) {
+	gz, err := gzip.NewReader(r.Body)
+	if assert.NoError(t, err) {
+		r.Body = ioutil.NopCloser(gz)
+	}
+}
+
+func TestCompressRequests(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var encodings []string
+	mux.HandleFunc("/zones/foo/dns_records", func(w http.ResponseWriter, r *http.Request) {
+		encodings = append(encodings, r.Header.Get("Content-Encoding"))
+		if r.Header.Get("Content-Encoding") == "gzip" {
+			gunzipRequest(t, r)
+		}
+		b, err := ioutil.ReadAll(r.Body)
+		assert.NoError(t, err)
+		assert.Contains(t, string(b), `"type":"TXT"`)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"id": "1"}}`)
+	})
+	mux.HandleFunc("/zones/foo/dns_records/import", func(w http.ResponseWriter, r *http.Request) {
+		encodings = append(encodings, r.Header.Get("Content-Encoding"))
+		gunzipRequest(t, r)
+		f, _, err := r.FormFile("file")
+		if assert.NoError(t, err) {
+			b, err := ioutil.ReadAll(f)
+			assert.NoError(t, err)
+			assert.Equal(t, testBINDFile, string(b))
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"recs_added": 1}}`)
+	})
+
+	assert.NoError(t, CompressRequests(200)(client))
+
+	_, err := client.CreateDNSRecord("foo", DNSRecord{Type: "TXT", Name: "a", Content: "b"})
+	assert.NoError(t, err)
+	_, err = client.CreateDNSRecord("foo", DNSRecord{Type: "TXT", Name: "a", Content: strings.Repeat("b", 300)})
+	assert.NoError(t, err)
+	_, err = client.ImportDNSRecords("foo", strings.NewReader(testBINDFile), -1)
+	assert.NoError(t, err)
+
+	assert.Equal(t, []string{"", "gzip", "gzip"}, encodings)
+
+	_, err = New("deadbeef", "cloudflare@example.org", CompressRequests(-1))
+	assert.Error(t, err)
+}
+
+func TestDecompressResponse(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/foo", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "gzip", r.Header.Get("Accept-Encoding"))
+		var buf bytes.Buffer
+		gz := gzip.NewWriter(&buf)
+		fmt.Fprint(gz, `{"success": true, "errors": [], "messages": [], "result": {"id": "foo", "name": "example.com"}}`)
+		gz.Close()
+		w.Header().Set("content-type", "application/json")
+		w.Header().Set("Content-Encoding", "gzip")
+		w.Write(buf.Bytes())
+	})
+
+	// Setting Accept-Encoding stops the transport decompressing responses
+	// itself.
+	assert.NoError(t, Headers(http.Header{"Accept-Encoding": {"gzip"}})(client))
+
+	zone, err := client.ZoneDetails("foo")
+	if assert.NoError(t, err) {
+		assert.Equal(t, "example.com", zone.Name)
+	}
+}
@@ -1,10 +1,100 @@
 package cloudflare
 
-// https://api.cloudflare.com/#custom-pages-for-a-zone-available-custom-pages
-// GET /zones/:zone_identifier/custom_pages
+import "github.com/pkg/errors"
 
-// https://api.cloudflare.com/#custom-pages-for-a-zone-custom-page-details
-// GET /zones/:zone_identifier/custom_pages/:identifier
+// Custom page IDs. Which pages are available depends on the zone's or
+// account's plan.
+const (
+	CustomPageBasicChallenge   = "basic_challenge"
+	CustomPageManagedChallenge = "managed_challenge"
+	CustomPageWAFChallenge     = "waf_challenge"
+	CustomPageWAFBlock         = "waf_block"
+	CustomPageCountryChallenge = "country_challenge"
+	CustomPageIPBlock          = "ip_block"
+	CustomPageRateLimitBlock   = "ratelimit_block"
+	CustomPageUnderAttack      = "under_attack"
+	CustomPage500Errors        = "500_errors"
+	CustomPage1000Errors       = "1000_errors"
+	CustomPageAlwaysOnline     = "always_online"
+)
 
-// https://api.cloudflare.com/#custom-pages-for-a-zone-update-custom-page-url
-// PUT /zones/:zone_identifier/custom_pages/:identifier
+// Custom page states.
+const (
+	CustomPageStateDefault    = "default"
+	CustomPageStateCustomized = "customized"
+)
+
+// CustomPageOptions selects whether custom pages are read and updated for a
+// zone or for a whole account. Exactly one of the fields must be set; zones
+// without their own page inherit the account's.
+type CustomPageOptions struct {
+	AccountID string
+	ZoneID    string
+}
+
+// CustomPageParameters is the update of a custom page. State is "customized"
+// to serve the page at URL, or "default" with a nil URL to revert to
+// Cloudflare's page.
+type CustomPageParameters struct {
+	URL   interface{} `json:"url"`
+	State string      `json:"state"`
+}
+
+func (o CustomPageOptions) uri() (string, error) {
+	switch {
+	case o.AccountID != "" && o.ZoneID != "":
+		return "", errors.New("custom page options: only one of AccountID and ZoneID may be set")
+	case o.AccountID != "":
+		return "/accounts/" + o.AccountID + "/custom_pages", nil
+	case o.ZoneID != "":
+		return "/zones/" + o.ZoneID + "/custom_pages", nil
+	}
+	return "", errors.New("custom page options: one of AccountID and ZoneID must be set")
+}
+
+// CustomPages lists the custom pages of a zone or account. Only the page
+// types available to the zone's or account's plan are returned.
+//
+// API reference: https://api.cloudflare.com/#custom-pages-for-a-zone-available-custom-pages
+func (api *API) CustomPages(options CustomPageOptions) ([]CustomPage, error) {
+	uri, err := options.uri()
+	if err != nil {
+		return nil, err
+	}
+	var pages []CustomPage
+	if _, err := api.makeRequestResult("GET", uri, nil, &pages); err != nil {
+		return nil, err
+	}
+	return pages, nil
+}
+
+// CustomPage returns a custom page of a zone or account.
+//
+// API reference: https://api.cloudflare.com/#custom-pages-for-a-zone-custom-page-details
+func (api *API) CustomPage(options CustomPageOptions, pageID string) (CustomPage, error) {
+	uri, err := options.uri()
+	if err != nil {
+		return CustomPage{}, err
+	}
+	var page CustomPage
+	if _, err := api.makeRequestResult("GET", uri+"/"+pageID, nil, &page); err != nil {
+		return CustomPage{}, err
+	}
+	return page, nil
+}
+
+// UpdateCustomPage sets the URL and state of a custom page of a zone or
+// account.
+//
+// API reference: https://api.cloudflare.com/#custom-pages-for-a-zone-update-custom-page-url
+func (api *API) UpdateCustomPage(options CustomPageOptions, pageID string, params CustomPageParameters) (CustomPage, error) {
+	uri, err := options.uri()
+	if err != nil {
+		return CustomPage{}, err
+	}
+	var page CustomPage
+	if _, err := api.makeRequestResult("PUT", uri+"/"+pageID, params, &page); err != nil {
+		return CustomPage{}, err
+	}
+	return page, nil
+}
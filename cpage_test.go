@@ -0,0 +1,76 @@
+package cloudflare
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCustomPages(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/accounts/acc/custom_pages", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": [
+				{"id": "basic_challenge", "state": "default", "url": null, "required_tokens": ["::CAPTCHA_BOX::"]},
+				{"id": "500_errors", "state": "customized", "url": "https://example.com/500.html"}
+			]
+		}`)
+	})
+	mux.HandleFunc("/zones/z1/custom_pages/500_errors", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"id": "500_errors", "state": "default"}}`)
+	})
+
+	pages, err := client.CustomPages(CustomPageOptions{AccountID: "acc"})
+	if assert.NoError(t, err) && assert.Len(t, pages, 2) {
+		assert.Equal(t, "", pages[0].URL)
+		assert.Equal(t, []string{"::CAPTCHA_BOX::"}, pages[0].RequiredTokens)
+		assert.Equal(t, "https://example.com/500.html", pages[1].URL)
+	}
+
+	page, err := client.CustomPage(CustomPageOptions{ZoneID: "z1"}, "500_errors")
+	if assert.NoError(t, err) {
+		assert.Equal(t, "default", page.State)
+	}
+
+	_, err = client.CustomPages(CustomPageOptions{})
+	assert.Error(t, err)
+	_, err = client.CustomPages(CustomPageOptions{AccountID: "acc", ZoneID: "z1"})
+	assert.Error(t, err)
+}
+
+func TestUpdateCustomPage(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/z1/custom_pages/500_errors", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PUT", r.Method)
+		var params map[string]interface{}
+		if assert.NoError(t, json.NewDecoder(r.Body).Decode(&params)) {
+			assert.Equal(t, map[string]interface{}{"url": nil, "state": "default"}, params)
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"id": "500_errors", "state": "default", "url": null}}`)
+	})
+
+	page, err := client.UpdateCustomPage(CustomPageOptions{ZoneID: "z1"}, "500_errors", CustomPageParameters{State: "default"})
+	if assert.NoError(t, err) {
+		assert.Equal(t, "500_errors", page.ID)
+	}
+
+	page, err = client.Zone("z1").UpdateCustomPage(CustomPage500Errors, CustomPageParameters{State: CustomPageStateDefault})
+	if assert.NoError(t, err) {
+		assert.Equal(t, CustomPageStateDefault, page.State)
+	}
+}
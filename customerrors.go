@@ -0,0 +1,83 @@
+package cloudflare
+
+// customErrorAction is the ruleset action which serves a custom error.
+const customErrorAction = "serve_error"
+
+// CustomErrorRule serves a custom error response to requests matching its
+// expression, such as those for a path or with a given origin status code
+// (e.g. `http.response.code eq 503 and http.request.uri.path wildcard "/api/*"`).
+type CustomErrorRule struct {
+	ID          string
+	Description string
+	Expression  string
+	Enabled     bool
+	// StatusCode is the status code of the response. If zero, the status of
+	// the original response is kept.
+	StatusCode  int
+	ContentType string
+	Content     string
+}
+
+// CustomErrorRules returns the custom error rules of a zone, from the
+// http_custom_errors ruleset phase.
+//
+// API reference: https://api.cloudflare.com/#zone-rulesets-get-a-zone-entry-point-ruleset
+func (api *API) CustomErrorRules(zoneID string) ([]CustomErrorRule, error) {
+	rs, err := api.ZoneRulesetPhaseEntrypoint(zoneID, RulesetPhaseHTTPCustomErrors)
+	if err != nil {
+		return nil, err
+	}
+	return customErrorRules(rs), nil
+}
+
+// SetCustomErrorRules replaces the custom error rules of a zone, returning the
+// rules as stored.
+//
+// API reference: https://api.cloudflare.com/#zone-rulesets-update-a-zone-entry-point-ruleset
+func (api *API) SetCustomErrorRules(zoneID string, rules []CustomErrorRule) ([]CustomErrorRule, error) {
+	rs := Ruleset{Rules: make([]RulesetRule, len(rules))}
+	for i, r := range rules {
+		rs.Rules[i] = RulesetRule{
+			ID:          r.ID,
+			Action:      customErrorAction,
+			Expression:  r.Expression,
+			Description: r.Description,
+			Enabled:     r.Enabled,
+			ActionParameters: &RulesetRuleActionParameters{
+				StatusCode:  r.StatusCode,
+				ContentType: r.ContentType,
+				Content:     r.Content,
+			},
+		}
+	}
+
+	rs, err := api.UpdateZoneRulesetPhaseEntrypoint(zoneID, RulesetPhaseHTTPCustomErrors, rs)
+	if err != nil {
+		return nil, err
+	}
+	return customErrorRules(rs), nil
+}
+
+// customErrorRules returns the custom error rules of a ruleset, skipping any
+// rules with other actions.
+func customErrorRules(rs Ruleset) []CustomErrorRule {
+	rules := []CustomErrorRule{}
+	for _, r := range rs.Rules {
+		if r.Action != customErrorAction {
+			continue
+		}
+		rule := CustomErrorRule{
+			ID:          r.ID,
+			Description: r.Description,
+			Expression:  r.Expression,
+			Enabled:     r.Enabled,
+		}
+		if p := r.ActionParameters; p != nil {
+			rule.StatusCode = p.StatusCode
+			rule.ContentType = p.ContentType
+			rule.Content = p.Content
+		}
+		rules = append(rules, rule)
+	}
+	return rules
+}
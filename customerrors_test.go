@@ -0,0 +1,79 @@
+package cloudflare
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCustomErrorRules(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/foo/rulesets/phases/http_custom_errors/entrypoint", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		switch r.Method {
+		case "GET":
+			fmt.Fprint(w, `{
+				"success": true,
+				"errors": [],
+				"messages": [],
+				"result": {
+					"id": "rs1",
+					"phase": "http_custom_errors",
+					"rules": [
+						{
+							"id": "r1",
+							"action": "serve_error",
+							"action_parameters": {"content": "{\"error\": \"down\"}", "content_type": "application/json", "status_code": 503},
+							"expression": "http.response.code eq 502",
+							"description": "API outage",
+							"enabled": true
+						}
+					]
+				}
+			}`)
+		case "PUT":
+			var rs Ruleset
+			if assert.NoError(t, json.NewDecoder(r.Body).Decode(&rs)) && assert.Len(t, rs.Rules, 1) {
+				assert.Equal(t, "serve_error", rs.Rules[0].Action)
+				assert.Equal(t, 404, rs.Rules[0].ActionParameters.StatusCode)
+				assert.Equal(t, "text/html", rs.Rules[0].ActionParameters.ContentType)
+			}
+			rs.ID = "rs1"
+			rs.Rules[0].ID = "r2"
+			b, _ := json.Marshal(rs)
+			fmt.Fprintf(w, `{"success": true, "errors": [], "messages": [], "result": %s}`, b)
+		default:
+			t.Errorf("unexpected method %s", r.Method)
+		}
+	})
+
+	rules, err := client.CustomErrorRules("foo")
+	if assert.NoError(t, err) {
+		assert.Equal(t, []CustomErrorRule{{
+			ID:          "r1",
+			Description: "API outage",
+			Expression:  "http.response.code eq 502",
+			Enabled:     true,
+			StatusCode:  503,
+			ContentType: "application/json",
+			Content:     `{"error": "down"}`,
+		}}, rules)
+	}
+
+	rules, err = client.SetCustomErrorRules("foo", []CustomErrorRule{{
+		Expression:  `http.request.uri.path eq "/gone"`,
+		Enabled:     true,
+		StatusCode:  404,
+		ContentType: "text/html",
+		Content:     "<h1>Gone</h1>",
+	}})
+	if assert.NoError(t, err) && assert.Len(t, rules, 1) {
+		assert.Equal(t, "r2", rules[0].ID)
+		assert.Equal(t, "<h1>Gone</h1>", rules[0].Content)
+	}
+}
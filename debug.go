@@ -0,0 +1,82 @@
+package cloudflare
+
+import (
+	"net/http"
+	"sort"
+	"strings"
+)
+
+// Logger is the interface of a logger for DebugLogger, satisfied by
+// *log.Logger.
+type Logger interface {
+	Printf(format string, v ...interface{})
+}
+
+// sensitiveHeaders are the headers whose values are redacted by the debug
+// logger.
+var sensitiveHeaders = map[string]bool{
+	"Authorization":           true,
+	"X-Auth-Key":              true,
+	"X-Auth-User-Service-Key": true,
+	"Cookie":                  true,
+	"Set-Cookie":              true,
+	"Cf-Access-Client-Secret": true,
+}
+
+// DebugLogger logs every request and response to logger: the method and URI,
+// headers with credentials redacted, the request and response bodies and the
+// time taken. This is invaluable when the API rejects a request, but logs the
+// payloads of every call, so should not be left enabled in production.
+func DebugLogger(logger Logger) Option {
+	return Intercept(debugInterceptor{logger})
+}
+
+type debugInterceptor struct {
+	logger Logger
+}
+
+func (d debugInterceptor) BeforeRequest(req *http.Request) error {
+	var body []byte
+	if req.GetBody != nil {
+		if r, err := req.GetBody(); err == nil {
+			buf := getBuffer()
+			defer putBuffer(buf)
+			buf.ReadFrom(r)
+			body = buf.Bytes()
+		}
+	}
+	d.logger.Printf("cloudflare: --> %s %s\n%s%s", req.Method, req.URL, formatHeaders(req.Header), body)
+	return nil
+}
+
+func (d debugInterceptor) AfterResponse(res *InterceptedResponse) {
+	if res.Err != nil {
+		d.logger.Printf("cloudflare: <-- %s %s failed after %s: %v", res.Request.Method, res.Request.URL, res.Latency, res.Err)
+		return
+	}
+	truncated := ""
+	if res.Truncated {
+		truncated = "\n(truncated)"
+	}
+	d.logger.Printf("cloudflare: <-- %d %s %s (%s)\n%s%s%s", res.StatusCode, res.Request.Method, res.Request.URL, res.Latency, formatHeaders(res.Header), res.Body, truncated)
+}
+
+// formatHeaders formats headers one per line in a stable order, redacting
+// sensitive values.
+func formatHeaders(h http.Header) string {
+	keys := make([]string, 0, len(h))
+	for k := range h {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var b strings.Builder
+	for _, k := range keys {
+		v := strings.Join(h[k], ", ")
+		if sensitiveHeaders[http.CanonicalHeaderKey(k)] {
+			v = "[redacted]"
+		}
+		b.WriteString(k + ": " + v + "\n")
+	}
+	return b.String()
+}
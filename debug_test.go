@@ -0,0 +1,40 @@
+package cloudflare
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDebugLogger(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/z1/pagerules/r1", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, `{"success": false, "errors": [{"code": 1004, "message": "Page Rule validation failed"}], "messages": [], "result": null}`)
+	})
+
+	var buf bytes.Buffer
+	assert.NoError(t, DebugLogger(log.New(&buf, "", 0))(client))
+	client.headers.Set("X-Auth-User-Service-Key", "v1.0-secret")
+
+	_, err := client.ChangePageRule("z1", "r1", PageRule{Status: "paused"})
+	assert.Error(t, err)
+
+	out := buf.String()
+	assert.Contains(t, out, "cloudflare: --> PATCH "+server.URL+"/zones/z1/pagerules/r1")
+	assert.Contains(t, out, `"status":"paused"`)
+	assert.Contains(t, out, "X-Auth-Email: "+client.APIEmail)
+	assert.Contains(t, out, "X-Auth-Key: [redacted]")
+	assert.Contains(t, out, "X-Auth-User-Service-Key: [redacted]")
+	assert.NotContains(t, out, client.APIKey)
+	assert.NotContains(t, out, "v1.0-secret")
+	assert.Contains(t, out, "cloudflare: <-- 400 PATCH")
+	assert.Contains(t, out, "Page Rule validation failed")
+}
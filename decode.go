@@ -0,0 +1,96 @@
+package cloudflare
+
+import (
+	"encoding/json"
+	"io"
+
+	"github.com/pkg/errors"
+)
+
+// decodeResultItems decodes a response envelope from r, calling fn with each
+// item of the result array in turn, so that large results are processed
+// incrementally instead of being held in memory. encoding/json is only used to
+// split up the response; the envelope fields are decoded with codec, and fn is
+// expected to decode each item with it too. Errors returned by fn stop
+// decoding and are returned unchanged. The pagination metadata of the response
+// is decoded into info, if it is not nil. If the envelope reports "success":
+// false, an *APIError is returned once the response has been decoded.
+func decodeResultItems(r io.Reader, codec Codec, info *ResultInfo, fn func(item json.RawMessage) error) error {
+	dec := json.NewDecoder(r)
+	if tok, err := dec.Token(); err != nil {
+		return errors.Wrap(err, errUnmarshalError)
+	} else if tok != json.Delim('{') {
+		return errors.Errorf("%s: response is not an object", errUnmarshalError)
+	}
+
+	// decode decodes the next value from dec into v with codec.
+	decode := func(v interface{}) error {
+		var raw json.RawMessage
+		if err := dec.Decode(&raw); err != nil {
+			return err
+		}
+		return codec.Unmarshal(raw, v)
+	}
+
+	success := true
+	var errs []ResponseInfo
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return errors.Wrap(err, errUnmarshalError)
+		}
+		switch tok {
+		case "success":
+			if err := decode(&success); err != nil {
+				return errors.Wrap(err, errUnmarshalError)
+			}
+			continue
+		case "errors":
+			if err := decode(&errs); err != nil {
+				return errors.Wrap(err, errUnmarshalError)
+			}
+			continue
+		}
+		if tok == "result_info" && info != nil {
+			if err := decode(info); err != nil {
+				return errors.Wrap(err, errUnmarshalError)
+			}
+			continue
+		}
+		if tok != "result" {
+			var skip json.RawMessage
+			if err := dec.Decode(&skip); err != nil {
+				return errors.Wrap(err, errUnmarshalError)
+			}
+			continue
+		}
+
+		tok, err = dec.Token()
+		if err != nil {
+			return errors.Wrap(err, errUnmarshalError)
+		}
+		if tok == nil {
+			continue
+		}
+		if tok != json.Delim('[') {
+			return errors.Errorf("%s: result is not an array", errUnmarshalError)
+		}
+		for dec.More() {
+			var item json.RawMessage
+			if err := dec.Decode(&item); err != nil {
+				return errors.Wrap(err, errUnmarshalError)
+			}
+			if err := fn(item); err != nil {
+				return err
+			}
+		}
+		if _, err := dec.Token(); err != nil {
+			return errors.Wrap(err, errUnmarshalError)
+		}
+	}
+
+	if !success {
+		return unsuccessfulError(errs)
+	}
+	return nil
+}
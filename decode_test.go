@@ -0,0 +1,54 @@
+package cloudflare
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeResultItems(t *testing.T) {
+	body := `{"success": true, "errors": [], "result": [{"id": "a"}, {"id": "b"}, {"id": "c"}], "messages": []}`
+
+	var ids []string
+	err := decodeResultItems(strings.NewReader(body), stdCodec{}, nil, func(item json.RawMessage) error {
+		var v struct {
+			ID string `json:"id"`
+		}
+		if err := json.Unmarshal(item, &v); err != nil {
+			return err
+		}
+		ids = append(ids, v.ID)
+		return nil
+	})
+	if assert.NoError(t, err) {
+		assert.Equal(t, []string{"a", "b", "c"}, ids)
+	}
+
+	stop := errors.New("stop")
+	err = decodeResultItems(strings.NewReader(body), stdCodec{}, nil, func(item json.RawMessage) error {
+		return stop
+	})
+	assert.Equal(t, stop, err)
+
+	err = decodeResultItems(strings.NewReader(`{"result": null}`), stdCodec{}, nil, func(item json.RawMessage) error {
+		t.Error("unexpected item")
+		return nil
+	})
+	assert.NoError(t, err)
+
+	err = decodeResultItems(strings.NewReader(`{"success": false, "errors": [{"code": 1000, "message": "Bad"}], "result": null}`), stdCodec{}, nil, func(item json.RawMessage) error {
+		t.Error("unexpected item")
+		return nil
+	})
+	if e, ok := AsAPIError(err); assert.True(t, ok) {
+		assert.Equal(t, 1000, e.ErrorCode())
+	}
+
+	err = decodeResultItems(strings.NewReader(`{"result": {"id": "a"}}`), stdCodec{}, nil, func(item json.RawMessage) error {
+		return nil
+	})
+	assert.Error(t, err)
+}
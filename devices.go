@@ -0,0 +1,174 @@
+package cloudflare
+
+import "time"
+
+// Device is a device registered with the WARP client in a Zero Trust
+// organization.
+type Device struct {
+	ID              string     `json:"id"`
+	Key             string     `json:"key,omitempty"`
+	Name            string     `json:"name"`
+	Model           string     `json:"model,omitempty"`
+	Manufacturer    string     `json:"manufacturer,omitempty"`
+	SerialNumber    string     `json:"serial_number,omitempty"`
+	DeviceType      string     `json:"device_type,omitempty"`
+	OSVersion       string     `json:"os_version,omitempty"`
+	Version         string     `json:"version,omitempty"`
+	IP              string     `json:"ip,omitempty"`
+	MacAddress      string     `json:"mac_address,omitempty"`
+	User            DeviceUser `json:"user"`
+	Created         *time.Time `json:"created,omitempty"`
+	Updated         *time.Time `json:"updated,omitempty"`
+	LastSeen        *time.Time `json:"last_seen,omitempty"`
+	RevokedAt       *time.Time `json:"revoked_at,omitempty"`
+	DeletedAt       *time.Time `json:"deleted_at,omitempty"`
+	GatewayDeviceID string     `json:"gateway_device_id,omitempty"`
+}
+
+// DeviceUser is the user a device is registered to.
+type DeviceUser struct {
+	ID    string `json:"id"`
+	Email string `json:"email"`
+	Name  string `json:"name,omitempty"`
+}
+
+// DeviceOverrideCodes holds the admin override code for a device, which lets
+// its user temporarily disconnect WARP even when the device settings forbid it.
+type DeviceOverrideCodes struct {
+	DisableForTime map[string]string `json:"disable_for_time"`
+}
+
+// Devices lists the registered devices of an account.
+//
+// API reference: https://developers.cloudflare.com/api/operations/devices-list-devices
+func (api *API) Devices(accountID string) ([]Device, error) {
+	var devices []Device
+	if _, err := api.makeRequestResult("GET", "/accounts/"+accountID+"/devices", nil, &devices); err != nil {
+		return nil, err
+	}
+	return devices, nil
+}
+
+// Device returns the details of a registered device.
+//
+// API reference: https://developers.cloudflare.com/api/operations/devices-device-details
+func (api *API) Device(accountID, deviceID string) (Device, error) {
+	var device Device
+	if _, err := api.makeRequestResult("GET", "/accounts/"+accountID+"/devices/"+deviceID, nil, &device); err != nil {
+		return Device{}, err
+	}
+	return device, nil
+}
+
+// RevokeDevices revokes the registrations of devices; a revoked device can no
+// longer connect with WARP until it is unrevoked.
+//
+// API reference: https://developers.cloudflare.com/api/operations/devices-revoke-devices
+func (api *API) RevokeDevices(accountID string, deviceIDs []string) error {
+	if _, err := api.makeRequestResult("POST", "/accounts/"+accountID+"/devices/revoke", deviceIDs, nil); err != nil {
+		return err
+	}
+	return nil
+}
+
+// UnrevokeDevices restores the registrations of revoked devices.
+//
+// API reference: https://developers.cloudflare.com/api/operations/devices-unrevoke-devices
+func (api *API) UnrevokeDevices(accountID string, deviceIDs []string) error {
+	if _, err := api.makeRequestResult("POST", "/accounts/"+accountID+"/devices/unrevoke", deviceIDs, nil); err != nil {
+		return err
+	}
+	return nil
+}
+
+// DeviceOverrideCodes returns the admin override codes of a device.
+//
+// API reference: https://developers.cloudflare.com/api/operations/devices-list-admin-override-code-for-device
+func (api *API) DeviceOverrideCodes(accountID, deviceID string) (DeviceOverrideCodes, error) {
+	var codes DeviceOverrideCodes
+	if _, err := api.makeRequestResult("GET", "/accounts/"+accountID+"/devices/"+deviceID+"/override_codes", nil, &codes); err != nil {
+		return DeviceOverrideCodes{}, err
+	}
+	return codes, nil
+}
+
+// Split tunnel modes.
+const (
+	SplitTunnelModeInclude = "include"
+	SplitTunnelModeExclude = "exclude"
+)
+
+// SplitTunnel is an entry of a split tunnel list. Exactly one of Address and
+// Host is set.
+type SplitTunnel struct {
+	Address     string `json:"address,omitempty"`
+	Host        string `json:"host,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+// FallbackDomain is a domain which WARP resolves with DNSServers (or the
+// device's local resolver if empty) rather than through Gateway.
+type FallbackDomain struct {
+	Suffix      string   `json:"suffix"`
+	Description string   `json:"description,omitempty"`
+	DNSServers  []string `json:"dns_server,omitempty"`
+}
+
+// devicePolicyURI returns the URI of a device settings policy, or of the
+// default policy if policyID is empty.
+func devicePolicyURI(accountID, policyID string) string {
+	uri := "/accounts/" + accountID + "/devices/policy"
+	if policyID != "" {
+		uri += "/" + policyID
+	}
+	return uri
+}
+
+// SplitTunnel returns the split tunnel list of a device settings policy.
+// mode is SplitTunnelModeInclude or SplitTunnelModeExclude; an empty
+// policyID selects the default policy.
+//
+// API reference: https://developers.cloudflare.com/api/operations/devices-get-split-tunnel-exclude-list
+func (api *API) SplitTunnel(accountID, policyID, mode string) ([]SplitTunnel, error) {
+	var tunnels []SplitTunnel
+	if _, err := api.makeRequestResult("GET", devicePolicyURI(accountID, policyID)+"/"+mode, nil, &tunnels); err != nil {
+		return nil, err
+	}
+	return tunnels, nil
+}
+
+// UpdateSplitTunnel replaces the split tunnel list of a device settings
+// policy.
+//
+// API reference: https://developers.cloudflare.com/api/operations/devices-set-split-tunnel-exclude-list
+func (api *API) UpdateSplitTunnel(accountID, policyID, mode string, tunnels []SplitTunnel) ([]SplitTunnel, error) {
+	var result []SplitTunnel
+	if _, err := api.makeRequestResult("PUT", devicePolicyURI(accountID, policyID)+"/"+mode, tunnels, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// FallbackDomains returns the local domain fallback list of a device settings
+// policy; an empty policyID selects the default policy.
+//
+// API reference: https://developers.cloudflare.com/api/operations/devices-get-local-domain-fallback-list
+func (api *API) FallbackDomains(accountID, policyID string) ([]FallbackDomain, error) {
+	var domains []FallbackDomain
+	if _, err := api.makeRequestResult("GET", devicePolicyURI(accountID, policyID)+"/fallback_domains", nil, &domains); err != nil {
+		return nil, err
+	}
+	return domains, nil
+}
+
+// UpdateFallbackDomains replaces the local domain fallback list of a device
+// settings policy.
+//
+// API reference: https://developers.cloudflare.com/api/operations/devices-set-local-domain-fallback-list
+func (api *API) UpdateFallbackDomains(accountID, policyID string, domains []FallbackDomain) ([]FallbackDomain, error) {
+	var result []FallbackDomain
+	if _, err := api.makeRequestResult("PUT", devicePolicyURI(accountID, policyID)+"/fallback_domains", domains, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
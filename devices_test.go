@@ -0,0 +1,148 @@
+package cloudflare
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDevices(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/accounts/acc/devices", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": [
+				{
+					"id": "d1",
+					"name": "laptop",
+					"device_type": "mac",
+					"user": {"id": "u1", "email": "user@example.com"},
+					"last_seen": "2016-06-15T12:00:00Z"
+				}
+			]
+		}`)
+	})
+	mux.HandleFunc("/accounts/acc/devices/d1", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"id": "d1", "name": "laptop", "serial_number": "C02X"}}`)
+	})
+
+	devices, err := client.Devices("acc")
+	if assert.NoError(t, err) && assert.Len(t, devices, 1) {
+		assert.Equal(t, "user@example.com", devices[0].User.Email)
+		assert.NotNil(t, devices[0].LastSeen)
+		assert.Nil(t, devices[0].RevokedAt)
+	}
+
+	device, err := client.Device("acc", "d1")
+	if assert.NoError(t, err) {
+		assert.Equal(t, "C02X", device.SerialNumber)
+	}
+}
+
+func TestRevokeDevices(t *testing.T) {
+	setup()
+	defer teardown()
+
+	for _, action := range []string{"revoke", "unrevoke"} {
+		mux.HandleFunc("/accounts/acc/devices/"+action, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "POST", r.Method)
+			var ids []string
+			if assert.NoError(t, json.NewDecoder(r.Body).Decode(&ids)) {
+				assert.Equal(t, []string{"d1", "d2"}, ids)
+			}
+			w.Header().Set("content-type", "application/json")
+			fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": null}`)
+		})
+	}
+
+	assert.NoError(t, client.RevokeDevices("acc", []string{"d1", "d2"}))
+	assert.NoError(t, client.UnrevokeDevices("acc", []string{"d1", "d2"}))
+}
+
+func TestDeviceOverrideCodes(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/accounts/acc/devices/d1/override_codes", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"disable_for_time": {"1": "9106681"}}}`)
+	})
+
+	codes, err := client.DeviceOverrideCodes("acc", "d1")
+	if assert.NoError(t, err) {
+		assert.Equal(t, "9106681", codes.DisableForTime["1"])
+	}
+}
+
+func TestSplitTunnel(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/accounts/acc/devices/policy/exclude", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": [{"address": "10.0.0.0/8", "description": "internal"}]}`)
+	})
+	mux.HandleFunc("/accounts/acc/devices/policy/p1/include", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PUT", r.Method)
+		var tunnels []SplitTunnel
+		if assert.NoError(t, json.NewDecoder(r.Body).Decode(&tunnels)) {
+			assert.Equal(t, []SplitTunnel{{Host: "*.corp.example.com"}}, tunnels)
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": [{"host": "*.corp.example.com"}]}`)
+	})
+
+	tunnels, err := client.SplitTunnel("acc", "", SplitTunnelModeExclude)
+	if assert.NoError(t, err) {
+		assert.Equal(t, []SplitTunnel{{Address: "10.0.0.0/8", Description: "internal"}}, tunnels)
+	}
+
+	tunnels, err = client.UpdateSplitTunnel("acc", "p1", SplitTunnelModeInclude, []SplitTunnel{{Host: "*.corp.example.com"}})
+	if assert.NoError(t, err) {
+		assert.Len(t, tunnels, 1)
+	}
+}
+
+func TestFallbackDomains(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/accounts/acc/devices/policy/fallback_domains", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		switch r.Method {
+		case "GET":
+			fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": [{"suffix": "local"}]}`)
+		case "PUT":
+			var domains []FallbackDomain
+			if assert.NoError(t, json.NewDecoder(r.Body).Decode(&domains)) {
+				assert.Equal(t, []string{"10.0.0.53"}, domains[1].DNSServers)
+			}
+			fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": [{"suffix": "local"}, {"suffix": "corp", "dns_server": ["10.0.0.53"]}]}`)
+		default:
+			t.Errorf("unexpected method %s", r.Method)
+		}
+	})
+
+	domains, err := client.FallbackDomains("acc", "")
+	if assert.NoError(t, err) {
+		assert.Equal(t, []FallbackDomain{{Suffix: "local"}}, domains)
+	}
+
+	domains, err = client.UpdateFallbackDomains("acc", "", append(domains, FallbackDomain{Suffix: "corp", DNSServers: []string{"10.0.0.53"}}))
+	if assert.NoError(t, err) {
+		assert.Len(t, domains, 2)
+	}
+}
@@ -0,0 +1,140 @@
+package cloudflare
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// DLP profile types.
+const (
+	DLPProfileTypeCustom     = "custom"
+	DLPProfileTypePredefined = "predefined"
+)
+
+// DLPProfile is a Data Loss Prevention profile: a set of entries which detect
+// sensitive data. Predefined profiles are provided by Cloudflare and only
+// their entries can be toggled; custom profiles hold regex entries.
+type DLPProfile struct {
+	ID                string     `json:"id,omitempty"`
+	Name              string     `json:"name,omitempty"`
+	Type              string     `json:"type,omitempty"`
+	Description       string     `json:"description,omitempty"`
+	AllowedMatchCount int        `json:"allowed_match_count"`
+	Entries           []DLPEntry `json:"entries,omitempty"`
+	CreatedAt         *time.Time `json:"created_at,omitempty"`
+	UpdatedAt         *time.Time `json:"updated_at,omitempty"`
+}
+
+// DLPEntry is an entry of a DLP profile. Pattern is only set for custom
+// entries.
+type DLPEntry struct {
+	ID        string      `json:"id,omitempty"`
+	Name      string      `json:"name,omitempty"`
+	ProfileID string      `json:"profile_id,omitempty"`
+	Enabled   bool        `json:"enabled"`
+	Pattern   *DLPPattern `json:"pattern,omitempty"`
+	Type      string      `json:"type,omitempty"`
+}
+
+// DLPPattern is the regular expression of a custom DLP entry, optionally with
+// a validation such as "luhn".
+type DLPPattern struct {
+	Regex      string `json:"regex"`
+	Validation string `json:"validation,omitempty"`
+}
+
+// DLPProfiles lists the DLP profiles of an account.
+//
+// API reference: https://developers.cloudflare.com/api/operations/dlp-profiles-list-all-profiles
+func (api *API) DLPProfiles(accountID string) ([]DLPProfile, error) {
+	var profiles []DLPProfile
+	if _, err := api.makeRequestResult("GET", "/accounts/"+accountID+"/dlp/profiles", nil, &profiles); err != nil {
+		return nil, err
+	}
+	return profiles, nil
+}
+
+// DLPProfile returns a DLP profile of either type.
+//
+// API reference: https://developers.cloudflare.com/api/operations/dlp-profiles-get-dlp-profile
+func (api *API) DLPProfile(accountID, profileID string) (DLPProfile, error) {
+	var profile DLPProfile
+	if _, err := api.makeRequestResult("GET", "/accounts/"+accountID+"/dlp/profiles/"+profileID, nil, &profile); err != nil {
+		return DLPProfile{}, err
+	}
+	return profile, nil
+}
+
+// CreateDLPProfiles creates custom DLP profiles.
+//
+// API reference: https://developers.cloudflare.com/api/operations/dlp-profiles-create-custom-profiles
+func (api *API) CreateDLPProfiles(accountID string, profiles []DLPProfile) ([]DLPProfile, error) {
+	for _, p := range profiles {
+		if p.Type != "" && p.Type != DLPProfileTypeCustom {
+			return nil, errors.Errorf("only custom DLP profiles can be created, not %q", p.Type)
+		}
+	}
+	params := struct {
+		Profiles []DLPProfile `json:"profiles"`
+	}{
+		Profiles: profiles,
+	}
+	var result []DLPProfile
+	if _, err := api.makeRequestResult("POST", "/accounts/"+accountID+"/dlp/profiles/custom", params, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// UpdateDLPProfile updates a DLP profile. For a predefined profile only the
+// Enabled field of its entries and the allowed match count are changed; the
+// entries are matched by ID.
+//
+// API reference: https://developers.cloudflare.com/api/operations/dlp-profiles-update-custom-profile
+func (api *API) UpdateDLPProfile(accountID string, profile DLPProfile) (DLPProfile, error) {
+	if profile.ID == "" {
+		return DLPProfile{}, errors.New("DLP profile ID must be set")
+	}
+	var uri string
+	var params interface{} = profile
+	switch profile.Type {
+	case DLPProfileTypeCustom:
+		uri = "/accounts/" + accountID + "/dlp/profiles/custom/" + profile.ID
+	case DLPProfileTypePredefined:
+		uri = "/accounts/" + accountID + "/dlp/profiles/predefined/" + profile.ID
+		type entry struct {
+			ID      string `json:"id"`
+			Enabled bool   `json:"enabled"`
+		}
+		p := struct {
+			AllowedMatchCount int     `json:"allowed_match_count"`
+			Entries           []entry `json:"entries"`
+		}{
+			AllowedMatchCount: profile.AllowedMatchCount,
+			Entries:           make([]entry, len(profile.Entries)),
+		}
+		for i, e := range profile.Entries {
+			p.Entries[i] = entry{ID: e.ID, Enabled: e.Enabled}
+		}
+		params = p
+	default:
+		return DLPProfile{}, errors.Errorf("unknown DLP profile type %q", profile.Type)
+	}
+
+	var result DLPProfile
+	if _, err := api.makeRequestResult("PUT", uri, params, &result); err != nil {
+		return DLPProfile{}, err
+	}
+	return result, nil
+}
+
+// DeleteDLPProfile deletes a custom DLP profile.
+//
+// API reference: https://developers.cloudflare.com/api/operations/dlp-profiles-delete-custom-profile
+func (api *API) DeleteDLPProfile(accountID, profileID string) error {
+	if _, err := api.makeRequestResult("DELETE", "/accounts/"+accountID+"/dlp/profiles/custom/"+profileID, nil, nil); err != nil {
+		return err
+	}
+	return nil
+}
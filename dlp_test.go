@@ -0,0 +1,127 @@
+package cloudflare
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDLPProfiles(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/accounts/acc/dlp/profiles", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": [
+				{
+					"id": "p1",
+					"name": "Credit Cards",
+					"type": "predefined",
+					"allowed_match_count": 0,
+					"entries": [{"id": "e1", "name": "Visa", "enabled": true, "profile_id": "p1", "type": "predefined"}]
+				},
+				{
+					"id": "p2",
+					"name": "Employee IDs",
+					"type": "custom",
+					"allowed_match_count": 1,
+					"entries": [{"id": "e2", "name": "ID", "enabled": true, "pattern": {"regex": "EMP-[0-9]{6}"}}]
+				}
+			]
+		}`)
+	})
+
+	profiles, err := client.DLPProfiles("acc")
+	if assert.NoError(t, err) && assert.Len(t, profiles, 2) {
+		assert.Equal(t, DLPProfileTypePredefined, profiles[0].Type)
+		assert.Nil(t, profiles[0].Entries[0].Pattern)
+		assert.Equal(t, &DLPPattern{Regex: "EMP-[0-9]{6}"}, profiles[1].Entries[0].Pattern)
+	}
+}
+
+func TestCreateDLPProfiles(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/accounts/acc/dlp/profiles/custom", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		var params struct {
+			Profiles []DLPProfile `json:"profiles"`
+		}
+		if assert.NoError(t, json.NewDecoder(r.Body).Decode(&params)) && assert.Len(t, params.Profiles, 1) {
+			assert.Equal(t, "EMP-[0-9]{6}", params.Profiles[0].Entries[0].Pattern.Regex)
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": [{"id": "p2", "name": "Employee IDs", "type": "custom"}]}`)
+	})
+
+	profiles, err := client.CreateDLPProfiles("acc", []DLPProfile{{
+		Name:    "Employee IDs",
+		Entries: []DLPEntry{{Name: "ID", Enabled: true, Pattern: &DLPPattern{Regex: "EMP-[0-9]{6}"}}},
+	}})
+	if assert.NoError(t, err) && assert.Len(t, profiles, 1) {
+		assert.Equal(t, "p2", profiles[0].ID)
+	}
+
+	_, err = client.CreateDLPProfiles("acc", []DLPProfile{{Type: DLPProfileTypePredefined}})
+	assert.Error(t, err)
+}
+
+func TestUpdateDLPProfile(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/accounts/acc/dlp/profiles/predefined/p1", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PUT", r.Method)
+		var params map[string]interface{}
+		if assert.NoError(t, json.NewDecoder(r.Body).Decode(&params)) {
+			assert.Equal(t, []interface{}{map[string]interface{}{"id": "e1", "enabled": false}}, params["entries"])
+			assert.NotContains(t, params, "name")
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"id": "p1", "type": "predefined", "entries": [{"id": "e1", "enabled": false}]}}`)
+	})
+	mux.HandleFunc("/accounts/acc/dlp/profiles/custom/p2", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		switch r.Method {
+		case "PUT":
+			var p DLPProfile
+			if assert.NoError(t, json.NewDecoder(r.Body).Decode(&p)) {
+				assert.Equal(t, "Staff IDs", p.Name)
+			}
+			fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"id": "p2", "name": "Staff IDs", "type": "custom"}}`)
+		case "DELETE":
+			fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": null}`)
+		default:
+			t.Errorf("unexpected method %s", r.Method)
+		}
+	})
+
+	p, err := client.UpdateDLPProfile("acc", DLPProfile{
+		ID:      "p1",
+		Name:    "Credit Cards",
+		Type:    DLPProfileTypePredefined,
+		Entries: []DLPEntry{{ID: "e1", Name: "Visa", Enabled: false}},
+	})
+	if assert.NoError(t, err) {
+		assert.False(t, p.Entries[0].Enabled)
+	}
+
+	p, err = client.UpdateDLPProfile("acc", DLPProfile{ID: "p2", Name: "Staff IDs", Type: DLPProfileTypeCustom})
+	if assert.NoError(t, err) {
+		assert.Equal(t, "Staff IDs", p.Name)
+	}
+
+	_, err = client.UpdateDLPProfile("acc", DLPProfile{ID: "p3"})
+	assert.Error(t, err)
+
+	assert.NoError(t, client.DeleteDLPProfile("acc", "p2"))
+}
@@ -7,32 +7,110 @@ import (
 	"github.com/pkg/errors"
 )
 
-// CreateDNSRecord creates a DNS record for the zone identifier.
+// dnsRecordDataTypes are the DNS record types whose value may be given in
+// Data rather than Content.
+var dnsRecordDataTypes = map[string]bool{
+	"CAA": true, "CERT": true, "DNSKEY": true, "DS": true, "HTTPS": true, "LOC": true,
+	"NAPTR": true, "SMIMEA": true, "SRV": true, "SSHFP": true, "SVCB": true, "TLSA": true, "URI": true,
+}
+
+// Validate checks that the DNS record is complete enough to be created: that
+// it has a type, a name and content (or data, for the structured types), a
+// valid TTL, and is only proxied if its type can be. It is called by
+// CreateDNSRecord, which returns its error wrapped in a *UserError without
+// calling the API.
+func (rr DNSRecord) Validate() error {
+	if rr.Type == "" {
+		return errors.New("DNS record type must not be empty")
+	}
+	if rr.Name == "" {
+		return errors.New("DNS record name must not be empty")
+	}
+	if rr.Content == "" && (rr.Data == nil || !dnsRecordDataTypes[rr.Type]) {
+		return errors.Errorf("%s record %q has no content", rr.Type, rr.Name)
+	}
+	if rr.TTL != 0 && rr.TTL != 1 && (rr.TTL < 60 || rr.TTL > 86400) {
+		return errors.Errorf("DNS record TTL must be 1 (automatic) or between 60 and 86400 seconds, not %d", rr.TTL)
+	}
+	if rr.Proxied {
+		switch rr.Type {
+		case "A", "AAAA", "CNAME":
+		default:
+			return errors.Errorf("%s records cannot be proxied", rr.Type)
+		}
+	}
+	return nil
+}
+
+// CreateDNSRecord creates a DNS record for the zone identifier. The record is
+// checked with Validate first.
 // API reference:
 //   https://api.cloudflare.com/#dns-records-for-a-zone-create-dns-record
 //   POST /zones/:zone_identifier/dns_records
 func (api *API) CreateDNSRecord(zoneID string, rr DNSRecord) (*DNSRecordResponse, error) {
+	if err := rr.Validate(); err != nil {
+		return nil, &UserError{Err: err}
+	}
 	uri := "/zones/" + zoneID + "/dns_records"
-	res, err := api.makeRequest("POST", uri, rr)
-	if err != nil {
-		return nil, errors.Wrap(err, errMakeRequestError)
+	recordResp := &DNSRecordResponse{}
+	create := func() error {
+		r, err := api.makeRequestResult("POST", uri, rr, &recordResp.Result)
+		recordResp.Response = r.Response
+		return err
 	}
-
-	var recordResp *DNSRecordResponse
-	err = json.Unmarshal(res, &recordResp)
-	if err != nil {
-		return nil, errors.Wrap(err, errUnmarshalError)
+	find := func() (bool, error) {
+		records, err := api.DNSRecords(zoneID, DNSRecord{Type: rr.Type, Name: rr.Name, Content: rr.Content})
+		if err != nil || len(records) == 0 {
+			return false, err
+		}
+		recordResp.Result = records[0]
+		recordResp.Response = Response{Success: true}
+		return true, nil
+	}
+	if err := api.createVerified(create, find); err != nil {
+		return nil, err
 	}
 
 	return recordResp, nil
 }
 
-// DNSRecords returns a slice of DNS records for the given zone identifier.
+// DNSRecords returns a slice of DNS records for the given zone identifier,
+// fetching every page of results.
 // API reference:
 //   https://api.cloudflare.com/#dns-records-for-a-zone-list-dns-records
 //   GET /zones/:zone_identifier/dns_records
 func (api *API) DNSRecords(zoneID string, rr DNSRecord) ([]DNSRecord, error) {
-	// Construct a query string
+	var records []DNSRecord
+	for page := 1; ; page++ {
+		result, info, err := api.DNSRecordsPage(zoneID, rr, PaginationOptions{Page: page})
+		if err != nil {
+			return []DNSRecord{}, err
+		}
+		records = append(records, result...)
+		if !info.HasMorePages() {
+			return records, nil
+		}
+	}
+}
+
+// DNSRecordsPage returns a page of the DNS records of a zone which match rr,
+// with the pagination metadata of the response.
+// API reference:
+//   https://api.cloudflare.com/#dns-records-for-a-zone-list-dns-records
+//   GET /zones/:zone_identifier/dns_records
+func (api *API) DNSRecordsPage(zoneID string, rr DNSRecord, opts PaginationOptions) ([]DNSRecord, ResultInfo, error) {
+	uri := dnsRecordsURI(zoneID, rr, opts)
+	var records []DNSRecord
+	r, err := api.makeRequestResult("GET", uri, nil, &records)
+	if err != nil {
+		return []DNSRecord{}, ResultInfo{}, err
+	}
+	return records, r.ResultInfo, nil
+}
+
+// dnsRecordsURI returns the URI listing a page of the DNS records of a zone
+// which match rr.
+func dnsRecordsURI(zoneID string, rr DNSRecord, opts PaginationOptions) string {
 	v := url.Values{}
 	if rr.Name != "" {
 		v.Set("name", rr.Name)
@@ -43,21 +121,43 @@ func (api *API) DNSRecords(zoneID string, rr DNSRecord) ([]DNSRecord, error) {
 	if rr.Content != "" {
 		v.Set("content", rr.Content)
 	}
+	opts.encode(v)
 	var query string
 	if len(v) > 0 {
 		query = "?" + v.Encode()
 	}
-	uri := "/zones/" + zoneID + "/dns_records" + query
-	res, err := api.makeRequest("GET", uri, nil)
-	if err != nil {
-		return []DNSRecord{}, errors.Wrap(err, errMakeRequestError)
-	}
-	var r DNSListResponse
-	err = json.Unmarshal(res, &r)
-	if err != nil {
-		return []DNSRecord{}, errors.Wrap(err, errUnmarshalError)
+	return "/zones/" + zoneID + "/dns_records" + query
+}
+
+// StreamDNSRecords is like DNSRecords, but calls fn with each DNS record as
+// each page of results is decoded rather than returning them all at once, so
+// zones with tens of thousands of records can be processed without holding
+// them in memory. An error returned by fn stops decoding and is returned.
+//
+// API reference: https://api.cloudflare.com/#dns-records-for-a-zone-list-dns-records
+func (api *API) StreamDNSRecords(zoneID string, rr DNSRecord, fn func(DNSRecord) error) error {
+	for page := 1; ; page++ {
+		body, err := api.makeRequestStream("GET", dnsRecordsURI(zoneID, rr, PaginationOptions{Page: page}), nil, nil)
+		if err != nil {
+			return errors.Wrap(err, errMakeRequestError)
+		}
+
+		var info ResultInfo
+		err = decodeResultItems(body, api.json(), &info, func(item json.RawMessage) error {
+			var record DNSRecord
+			if err := api.json().Unmarshal(item, &record); err != nil {
+				return errors.Wrap(err, errUnmarshalError)
+			}
+			return fn(record)
+		})
+		body.Close()
+		if err != nil {
+			return err
+		}
+		if !info.HasMorePages() {
+			return nil
+		}
 	}
-	return r.Result, nil
 }
 
 // DNSRecord returns a single DNS record for the given zone & record
@@ -67,16 +167,11 @@ func (api *API) DNSRecords(zoneID string, rr DNSRecord) ([]DNSRecord, error) {
 //   GET /zones/:zone_identifier/dns_records/:identifier
 func (api *API) DNSRecord(zoneID, recordID string) (DNSRecord, error) {
 	uri := "/zones/" + zoneID + "/dns_records/" + recordID
-	res, err := api.makeRequest("GET", uri, nil)
-	if err != nil {
-		return DNSRecord{}, errors.Wrap(err, errMakeRequestError)
+	var record DNSRecord
+	if _, err := api.makeRequestResult("GET", uri, nil, &record); err != nil {
+		return DNSRecord{}, err
 	}
-	var r DNSRecordResponse
-	err = json.Unmarshal(res, &r)
-	if err != nil {
-		return DNSRecord{}, errors.Wrap(err, errUnmarshalError)
-	}
-	return r.Result, nil
+	return record, nil
 }
 
 // UpdateDNSRecord updates a single DNS record for the given zone & record
@@ -92,14 +187,8 @@ func (api *API) UpdateDNSRecord(zoneID, recordID string, rr DNSRecord) error {
 	rr.Name = rec.Name
 	rr.Type = rec.Type
 	uri := "/zones/" + zoneID + "/dns_records/" + recordID
-	res, err := api.makeRequest("PUT", uri, rr)
-	if err != nil {
-		return errors.Wrap(err, errMakeRequestError)
-	}
-	var r DNSRecordResponse
-	err = json.Unmarshal(res, &r)
-	if err != nil {
-		return errors.Wrap(err, errUnmarshalError)
+	if _, err := api.makeRequestResult("PUT", uri, rr, nil); err != nil {
+		return err
 	}
 	return nil
 }
@@ -111,14 +200,8 @@ func (api *API) UpdateDNSRecord(zoneID, recordID string, rr DNSRecord) error {
 //   DELETE /zones/:zone_identifier/dns_records/:identifier
 func (api *API) DeleteDNSRecord(zoneID, recordID string) error {
 	uri := "/zones/" + zoneID + "/dns_records/" + recordID
-	res, err := api.makeRequest("DELETE", uri, nil)
-	if err != nil {
-		return errors.Wrap(err, errMakeRequestError)
-	}
-	var r DNSRecordResponse
-	err = json.Unmarshal(res, &r)
-	if err != nil {
-		return errors.Wrap(err, errUnmarshalError)
+	if _, err := api.makeRequestResult("DELETE", uri, nil, nil); err != nil {
+		return err
 	}
 	return nil
 }
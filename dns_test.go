@@ -0,0 +1,124 @@
+package cloudflare
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDNSRecords(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/z1/dns_records", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "A", r.URL.Query().Get("type"))
+		page := r.URL.Query().Get("page")
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": [{"id": "r%s", "type": "A"}],
+			"result_info": {"page": %s, "per_page": 1, "count": 1, "total_count": 2, "total_pages": 2}
+		}`, page, page)
+	})
+
+	records, info, err := client.DNSRecordsPage("z1", DNSRecord{Type: "A"}, PaginationOptions{Page: 1, PerPage: 1})
+	if assert.NoError(t, err) {
+		assert.Len(t, records, 1)
+		assert.Equal(t, ResultInfo{Page: 1, PerPage: 1, Count: 1, Total: 2, TotalPages: 2}, info)
+		assert.True(t, info.HasMorePages())
+	}
+
+	records, err = client.DNSRecords("z1", DNSRecord{Type: "A"})
+	if assert.NoError(t, err) && assert.Len(t, records, 2) {
+		assert.Equal(t, "r1", records[0].ID)
+		assert.Equal(t, "r2", records[1].ID)
+	}
+}
+
+func TestResultInfoHasMorePages(t *testing.T) {
+	assert.True(t, ResultInfo{Page: 1, TotalPages: 3}.HasMorePages())
+	assert.False(t, ResultInfo{Page: 3, TotalPages: 3}.HasMorePages())
+	assert.True(t, ResultInfo{Page: 1, PerPage: 20, Total: 21}.HasMorePages())
+	assert.False(t, ResultInfo{Page: 2, PerPage: 20, Total: 21}.HasMorePages())
+	assert.False(t, ResultInfo{}.HasMorePages())
+}
+
+func TestStreamDNSRecords(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/z1/dns_records", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "A", r.URL.Query().Get("type"))
+		page := r.URL.Query().Get("page")
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result_info": {"page": %s, "per_page": 2, "count": 2, "total_count": 4, "total_pages": 2},
+			"result": [{"id": "r%s-1", "type": "A"}, {"id": "r%s-2", "type": "A"}]
+		}`, page, page, page)
+	})
+
+	var ids []string
+	err := client.StreamDNSRecords("z1", DNSRecord{Type: "A"}, func(rr DNSRecord) error {
+		ids = append(ids, rr.ID)
+		return nil
+	})
+	if assert.NoError(t, err) {
+		assert.Equal(t, []string{"r1-1", "r1-2", "r2-1", "r2-2"}, ids)
+	}
+
+	stop := errors.New("stop")
+	ids = nil
+	err = client.StreamDNSRecords("z1", DNSRecord{Type: "A"}, func(rr DNSRecord) error {
+		ids = append(ids, rr.ID)
+		return stop
+	})
+	assert.Equal(t, stop, err)
+	assert.Equal(t, []string{"r1-1"}, ids)
+}
+
+func TestStreamPageRules(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/z1/pagerules", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": [{"id": "a", "status": "active"}, {"id": "b", "status": "paused"}]}`)
+	})
+
+	var rules []PageRule
+	err := client.StreamPageRules("z1", func(rule PageRule) error {
+		rules = append(rules, rule)
+		return nil
+	})
+	if assert.NoError(t, err) && assert.Len(t, rules, 2) {
+		assert.Equal(t, PageRuleStatusPaused, rules[1].Status)
+	}
+}
+
+func TestDNSRecordValidate(t *testing.T) {
+	assert.NoError(t, DNSRecord{Type: "A", Name: "www", Content: "192.0.2.1", Proxied: true, TTL: 1}.Validate())
+	assert.NoError(t, DNSRecord{Type: "SRV", Name: "_sip._tcp", Data: map[string]interface{}{"port": 5060}}.Validate())
+
+	assert.EqualError(t, DNSRecord{Name: "www", Content: "192.0.2.1"}.Validate(), "DNS record type must not be empty")
+	assert.EqualError(t, DNSRecord{Type: "A", Content: "192.0.2.1"}.Validate(), "DNS record name must not be empty")
+	assert.EqualError(t, DNSRecord{Type: "A", Name: "www"}.Validate(), `A record "www" has no content`)
+	assert.EqualError(t, DNSRecord{Type: "A", Name: "www", Content: "192.0.2.1", TTL: 30}.Validate(),
+		"DNS record TTL must be 1 (automatic) or between 60 and 86400 seconds, not 30")
+	assert.EqualError(t, DNSRecord{Type: "MX", Name: "www", Content: "mx.example.com", Proxied: true}.Validate(),
+		"MX records cannot be proxied")
+
+	setup()
+	defer teardown()
+	_, err := client.CreateDNSRecord("z1", DNSRecord{Type: "A", Name: "www"})
+	_, ok := err.(*UserError)
+	assert.True(t, ok)
+}
@@ -0,0 +1,69 @@
+package cloudflare
+
+import (
+	"bytes"
+	"io"
+	"mime/multipart"
+
+	"github.com/pkg/errors"
+)
+
+// DNSImportResult is the outcome of importing a BIND zone file.
+type DNSImportResult struct {
+	RecordsAdded       int `json:"recs_added"`
+	TotalRecordsParsed int `json:"total_records_parsed"`
+}
+
+// ExportDNSRecords writes the DNS records of a zone to w as a BIND zone file,
+// returning the number of bytes written. The file is streamed rather than
+// held in memory, so zones with very many records can be exported.
+//
+// API reference: https://api.cloudflare.com/#dns-records-for-a-zone-export-dns-records
+func (api *API) ExportDNSRecords(zoneID string, w io.Writer) (int64, error) {
+	body, err := api.makeRequestStream("GET", "/zones/"+zoneID+"/dns_records/export", nil, nil)
+	if err != nil {
+		return 0, errors.Wrap(err, errMakeRequestError)
+	}
+	defer body.Close()
+
+	n, err := io.Copy(w, body)
+	if err != nil {
+		return n, errors.Wrap(err, "could not read BIND export")
+	}
+	return n, nil
+}
+
+// ImportDNSRecords imports DNS records into a zone from a BIND zone file read
+// from r, which is streamed to the API. size is the length of the file if
+// known, which allows it to be uploaded without chunked encoding, or -1.
+//
+// API reference: https://api.cloudflare.com/#dns-records-for-a-zone-import-dns-records
+func (api *API) ImportDNSRecords(zoneID string, r io.Reader, size int64) (DNSImportResult, error) {
+	// Write the multipart framing around the file up front, so that the file
+	// itself can be streamed between them.
+	var head bytes.Buffer
+	mw := multipart.NewWriter(&head)
+	if _, err := mw.CreateFormFile("file", "bind.txt"); err != nil {
+		return DNSImportResult{}, errors.Wrap(err, "could not create multipart body")
+	}
+	n := head.Len()
+	if err := mw.Close(); err != nil {
+		return DNSImportResult{}, errors.Wrap(err, "could not create multipart body")
+	}
+	tail := append([]byte(nil), head.Bytes()[n:]...)
+	head.Truncate(n)
+
+	reqBody := sizedReader{
+		Reader: io.MultiReader(&head, r, bytes.NewReader(tail)),
+		size:   -1,
+	}
+	if size >= 0 {
+		reqBody.size = int64(head.Len()) + size + int64(len(tail))
+	}
+
+	var result DNSImportResult
+	if err := api.makeRequestBody("POST", "/zones/"+zoneID+"/dns_records/import", mw.FormDataContentType(), reqBody, &result); err != nil {
+		return DNSImportResult{}, err
+	}
+	return result, nil
+}
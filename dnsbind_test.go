@@ -0,0 +1,79 @@
+package cloudflare
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testBINDFile = "www.example.com.\t1\tIN\tA\t192.0.2.1\n"
+
+func TestExportDNSRecords(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/foo/dns_records/export", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		w.Header().Set("Content-Type", "text/plain")
+		fmt.Fprint(w, testBINDFile)
+	})
+
+	var buf bytes.Buffer
+	n, err := client.ExportDNSRecords("foo", &buf)
+	if assert.NoError(t, err) {
+		assert.Equal(t, int64(len(testBINDFile)), n)
+		assert.Equal(t, testBINDFile, buf.String())
+	}
+
+	_, err = client.ExportDNSRecords("bar", &buf)
+	assert.Error(t, err)
+}
+
+func TestImportDNSRecords(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var contentLength int64
+	mux.HandleFunc("/zones/foo/dns_records/import", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		contentLength = r.ContentLength
+		f, _, err := r.FormFile("file")
+		if assert.NoError(t, err) {
+			b, err := ioutil.ReadAll(f)
+			assert.NoError(t, err)
+			assert.Equal(t, testBINDFile, string(b))
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"recs_added": 1, "total_records_parsed": 1}}`)
+	})
+
+	res, err := client.ImportDNSRecords("foo", strings.NewReader(testBINDFile), int64(len(testBINDFile)))
+	if assert.NoError(t, err) {
+		assert.Equal(t, DNSImportResult{RecordsAdded: 1, TotalRecordsParsed: 1}, res)
+		assert.True(t, contentLength > int64(len(testBINDFile)))
+	}
+
+	_, err = client.ImportDNSRecords("foo", strings.NewReader(testBINDFile), -1)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(-1), contentLength)
+}
+
+func TestImportDNSRecordsUnsuccessful(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/foo/dns_records/import", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"success": false, "errors": [{"code": 81058, "message": "A record with those settings already exists."}], "messages": [], "result": null}`)
+	})
+
+	_, err := client.ImportDNSRecords("foo", strings.NewReader(testBINDFile), int64(len(testBINDFile)))
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "81058")
+	}
+}
@@ -0,0 +1,66 @@
+package cloudflare
+
+import "time"
+
+// DNSSEC statuses.
+const (
+	DNSSECStatusActive          = "active"
+	DNSSECStatusPending         = "pending"
+	DNSSECStatusDisabled        = "disabled"
+	DNSSECStatusPendingDisabled = "pending-disabled"
+	DNSSECStatusError           = "error"
+)
+
+// ZoneDNSSEC is the DNSSEC configuration of a zone. Once DNSSEC has been
+// enabled, the DS record (or its parts: KeyTag, Algorithm, DigestType and
+// Digest) must be added at the registrar to complete signing; the status is
+// pending until it has been.
+type ZoneDNSSEC struct {
+	Status          string    `json:"status"`
+	Flags           int       `json:"flags"`
+	Algorithm       string    `json:"algorithm"`
+	KeyType         string    `json:"key_type"`
+	DigestType      string    `json:"digest_type"`
+	DigestAlgorithm string    `json:"digest_algorithm"`
+	Digest          string    `json:"digest"`
+	DS              string    `json:"ds"`
+	KeyTag          int       `json:"key_tag"`
+	PublicKey       string    `json:"public_key"`
+	ModifiedOn      time.Time `json:"modified_on"`
+}
+
+// ZoneDNSSECStatus returns the DNSSEC configuration of a zone.
+//
+// API reference: https://api.cloudflare.com/#dnssec-dnssec-details
+func (api *API) ZoneDNSSECStatus(zoneID string) (ZoneDNSSEC, error) {
+	var result ZoneDNSSEC
+	if _, err := api.makeRequestResult("GET", "/zones/"+zoneID+"/dnssec", nil, &result); err != nil {
+		return ZoneDNSSEC{}, err
+	}
+	return result, nil
+}
+
+// EnableZoneDNSSEC enables DNSSEC for a zone, returning the DS record details
+// to add at the registrar.
+//
+// API reference: https://api.cloudflare.com/#dnssec-edit-dnssec-status
+func (api *API) EnableZoneDNSSEC(zoneID string) (ZoneDNSSEC, error) {
+	params := struct {
+		Status string `json:"status"`
+	}{DNSSECStatusActive}
+	var result ZoneDNSSEC
+	if _, err := api.makeRequestResult("PATCH", "/zones/"+zoneID+"/dnssec", params, &result); err != nil {
+		return ZoneDNSSEC{}, err
+	}
+	return result, nil
+}
+
+// DeleteZoneDNSSEC disables DNSSEC for a zone and deletes its keys. The DS
+// record should be removed at the registrar first, or the zone will fail to
+// resolve for validating resolvers.
+//
+// API reference: https://api.cloudflare.com/#dnssec-delete-dnssec-records
+func (api *API) DeleteZoneDNSSEC(zoneID string) error {
+	_, err := api.makeRequestResult("DELETE", "/zones/"+zoneID+"/dnssec", nil, nil)
+	return err
+}
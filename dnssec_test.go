@@ -0,0 +1,73 @@
+package cloudflare
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestZoneDNSSEC(t *testing.T) {
+	setup()
+	defer teardown()
+
+	status := "disabled"
+	mux.HandleFunc("/zones/z1/dnssec", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		switch r.Method {
+		case "DELETE":
+			status = "disabled"
+			fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": "z1"}`)
+			return
+		case "PATCH":
+			body, _ := ioutil.ReadAll(r.Body)
+			assert.Equal(t, `{"status":"active"}`, strings.TrimSpace(string(body)))
+			status = "pending"
+		default:
+			assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		}
+		if status == "disabled" {
+			fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"status": "disabled", "modified_on": null}}`)
+			return
+		}
+		fmt.Fprintf(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {
+				"status": %q,
+				"flags": 257,
+				"algorithm": "13",
+				"key_type": "ECDSAP256SHA256",
+				"digest_type": "2",
+				"digest_algorithm": "SHA256",
+				"digest": "48E939042E82C22542CB377B580DFDC52A361CEFDC72E7F9107E2B6BD9306A45",
+				"ds": "example.com. 3600 IN DS 16953 13 2 48E939042E82C22542CB377B580DFDC52A361CEFDC72E7F9107E2B6BD9306A45",
+				"key_tag": 42,
+				"public_key": "oXiGYrSTO+LSCJ3mohc8EP+CzF9KxBj8/ydXJ22pKuZP3VAC3/Md/k7xZfz470CoRyZJ6gV6vml07IC3d8xqhA==",
+				"modified_on": "2014-01-01T05:20:00Z"
+			}
+		}`, status)
+	})
+
+	dnssec, err := client.ZoneDNSSECStatus("z1")
+	if assert.NoError(t, err) {
+		assert.Equal(t, DNSSECStatusDisabled, dnssec.Status)
+		assert.True(t, dnssec.ModifiedOn.IsZero())
+	}
+
+	dnssec, err = client.EnableZoneDNSSEC("z1")
+	if assert.NoError(t, err) {
+		assert.Equal(t, DNSSECStatusPending, dnssec.Status)
+		assert.Equal(t, 42, dnssec.KeyTag)
+		assert.Equal(t, "13", dnssec.Algorithm)
+		assert.Equal(t, "2", dnssec.DigestType)
+		assert.Contains(t, dnssec.DS, " IN DS ")
+	}
+
+	assert.NoError(t, client.DeleteZoneDNSSEC("z1"))
+	assert.Equal(t, "disabled", status)
+}
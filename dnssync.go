@@ -0,0 +1,82 @@
+package cloudflare
+
+import "github.com/pkg/errors"
+
+// DNSSyncOptions controls the behaviour of SyncDNSRecords.
+type DNSSyncOptions struct {
+	// AllowDeletes permits records which are not in the desired set to be
+	// deleted. Without it, such records are left in place.
+	AllowDeletes bool
+	// MaxDeletes, if non-zero, aborts the sync before any changes are made if
+	// it would delete more than this many records.
+	MaxDeletes int
+	// Managed, if set, restricts the sync to existing records for which it
+	// returns true, such as those created by the caller. Other records are
+	// never updated or deleted, and a desired record which is not paired
+	// with a managed record but has the type and name of an unmanaged one is
+	// skipped rather than created alongside it.
+	Managed func(DNSRecord) bool
+	// DryRun computes the changes without making them.
+	DryRun bool
+}
+
+// SyncDNSRecords brings the DNS records of a zone in line with the desired
+// set, returning the changes which were made (or which would be made, for a
+// dry run).
+//
+// Records are matched on type and name. Where several records share a type and
+// name, records with identical content are matched first and the remainder are
+// updated in place where possible.
+func (api *API) SyncDNSRecords(zoneID string, desired []DNSRecord, opts DNSSyncOptions) ([]ZoneConfigChange, error) {
+	existing, err := api.DNSRecords(zoneID, DNSRecord{})
+	if err != nil {
+		return nil, errors.Wrap(err, "could not list DNS records")
+	}
+	// Only managed records are paired with desired ones, so that they are
+	// updated rather than left for deletion. A desired record which would be
+	// created with the type and name of an unmanaged record is skipped.
+	type key struct{ typ, name string }
+	unmanaged := make(map[key]bool)
+	if opts.Managed != nil {
+		var managed []DNSRecord
+		for _, rr := range existing {
+			if opts.Managed(rr) {
+				managed = append(managed, rr)
+			} else {
+				unmanaged[key{rr.Type, rr.Name}] = true
+			}
+		}
+		existing = managed
+	}
+
+	var changes []ZoneConfigChange
+	var deletes int
+	for _, c := range diffDNSRecords(existing, desired) {
+		if c.Action == ZoneConfigCreate {
+			rr := c.Value.(DNSRecord)
+			if unmanaged[key{rr.Type, rr.Name}] {
+				continue
+			}
+		}
+		if c.Action == ZoneConfigDelete {
+			if !opts.AllowDeletes {
+				continue
+			}
+			deletes++
+		}
+		changes = append(changes, c)
+	}
+	if opts.MaxDeletes > 0 && deletes > opts.MaxDeletes {
+		return nil, errors.Errorf("sync would delete %d records, more than the maximum of %d", deletes, opts.MaxDeletes)
+	}
+
+	if opts.DryRun {
+		return changes, nil
+	}
+	for i, c := range changes {
+		if err := api.applyZoneConfigChange(zoneID, c); err != nil {
+			return changes[:i], errors.Wrap(err, "failed to "+c.String())
+		}
+	}
+	return changes, nil
+}
@@ -0,0 +1,99 @@
+package cloudflare
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSyncDNSRecords(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var methods []string
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		methods = append(methods, r.Method+" "+r.URL.Path)
+		switch r.Method {
+		case "GET":
+			if strings.HasSuffix(r.URL.Path, "/dns_records") {
+				fmt.Fprint(w, `{
+					"success": true,
+					"errors": [],
+					"messages": [],
+					"result": [
+						{"id": "1", "type": "A", "name": "www.example.com", "content": "192.0.2.1"},
+						{"id": "2", "type": "A", "name": "old.example.com", "content": "192.0.2.2"},
+						{"id": "3", "type": "MX", "name": "example.com", "content": "mx.example.com"}
+					]
+				}`)
+				return
+			}
+			fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"id": "1", "type": "A", "name": "www.example.com"}}`)
+		default:
+			fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"id": "4"}}`)
+		}
+	}
+	mux.HandleFunc("/zones/foo/dns_records", handler)
+	mux.HandleFunc("/zones/foo/dns_records/", handler)
+
+	desired := []DNSRecord{
+		{Type: "A", Name: "www.example.com", Content: "192.0.2.10"},
+		{Type: "AAAA", Name: "www.example.com", Content: "2001:db8::1"},
+		// Matches the unmanaged MX record, so is neither created nor updated.
+		{Type: "MX", Name: "example.com", Content: "mx2.example.com"},
+	}
+	onlyA := func(rr DNSRecord) bool { return rr.Type == "A" }
+
+	// Without AllowDeletes, old.example.com is left alone.
+	changes, err := client.SyncDNSRecords("foo", desired, DNSSyncOptions{Managed: onlyA, DryRun: true})
+	if assert.NoError(t, err) && assert.Len(t, changes, 2) {
+		assert.Equal(t, ZoneConfigUpdate, changes[0].Action)
+		assert.Equal(t, "1", changes[0].ID)
+		assert.Equal(t, ZoneConfigCreate, changes[1].Action)
+	}
+	assert.Equal(t, []string{"GET /zones/foo/dns_records"}, methods)
+
+	_, err = client.SyncDNSRecords("foo", desired, DNSSyncOptions{Managed: onlyA, AllowDeletes: true, MaxDeletes: 0})
+	assert.NoError(t, err)
+	assert.Contains(t, methods, "DELETE /zones/foo/dns_records/2")
+	assert.NotContains(t, methods, "DELETE /zones/foo/dns_records/3")
+	assert.NotContains(t, methods, "PUT /zones/foo/dns_records/3")
+	assert.Contains(t, methods, "PUT /zones/foo/dns_records/1")
+	assert.Contains(t, methods, "POST /zones/foo/dns_records")
+
+	_, err = client.SyncDNSRecords("foo", nil, DNSSyncOptions{AllowDeletes: true, MaxDeletes: 2})
+	assert.Error(t, err)
+}
+
+func TestSyncDNSRecordsUnmanagedSameName(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/foo/dns_records", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": [
+				{"id": "1", "type": "A", "name": "www.example.com", "content": "192.0.2.1", "comment": "manual"},
+				{"id": "2", "type": "A", "name": "www.example.com", "content": "192.0.2.2"}
+			]
+		}`)
+	})
+
+	// The managed record is updated, rather than paired with the unmanaged
+	// one and deleted.
+	managed := func(rr DNSRecord) bool { return rr.ID != "1" }
+	changes, err := client.SyncDNSRecords("foo", []DNSRecord{
+		{Type: "A", Name: "www.example.com", Content: "192.0.2.3"},
+	}, DNSSyncOptions{Managed: managed, AllowDeletes: true, DryRun: true})
+	if assert.NoError(t, err) && assert.Len(t, changes, 1) {
+		assert.Equal(t, ZoneConfigUpdate, changes[0].Action)
+		assert.Equal(t, "2", changes[0].ID)
+	}
+}
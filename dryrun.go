@@ -0,0 +1,58 @@
+package cloudflare
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+)
+
+// dryRunResponse is the body of the synthetic responses to requests not sent
+// in dry-run mode.
+const dryRunResponse = `{"success":true,"errors":[],"messages":[],"result":null}`
+
+// DryRun makes the client log the requests it would make to change anything
+// (those with methods other than GET and HEAD) to logger, with their payloads,
+// instead of sending them. Each is answered with a successful response with
+// no result, so methods making them return zero values. Other requests are
+// made as usual, so that tooling can read the current state to preview, or
+// plan, its changes.
+func DryRun(logger Logger) Option {
+	return func(api *API) error {
+		api.dryRun = logger
+		return nil
+	}
+}
+
+// dryRunRequest logs a request which is not sent in dry-run mode, and returns
+// the response to it, or nil if the request should be sent.
+func (api *API) dryRunRequest(method, uri string, body io.Reader) *http.Response {
+	if api.dryRun == nil || method == "GET" || method == "HEAD" {
+		return nil
+	}
+
+	var payload string
+	switch b := body.(type) {
+	case nil:
+	case *bytes.Reader:
+		buf := getBuffer()
+		defer putBuffer(buf)
+		buf.ReadFrom(b)
+		payload = "\n" + strings.TrimSpace(buf.String())
+	default:
+		payload = "\n(streamed body)"
+	}
+	api.dryRun.Printf("cloudflare: dry run: %s %s%s", method, uri, payload)
+
+	return &http.Response{
+		Status:        "200 OK",
+		StatusCode:    http.StatusOK,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        http.Header{"Content-Type": {"application/json"}},
+		Body:          ioutil.NopCloser(strings.NewReader(dryRunResponse)),
+		ContentLength: int64(len(dryRunResponse)),
+	}
+}
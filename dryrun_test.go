@@ -0,0 +1,40 @@
+package cloudflare
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDryRun(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/z1/pagerules", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": [{"id": "r1", "status": "active"}]}`)
+	})
+	mux.HandleFunc("/zones/z1/pagerules/r1", func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected %s request in dry-run mode", r.Method)
+	})
+
+	var buf bytes.Buffer
+	assert.NoError(t, DryRun(log.New(&buf, "", 0))(client))
+
+	rules, err := client.ListPageRules("z1")
+	if assert.NoError(t, err) && assert.Len(t, rules, 1) {
+		_, err = client.ChangePageRule("z1", rules[0].ID, PageRule{Status: "disabled"})
+		assert.NoError(t, err)
+	}
+	assert.NoError(t, client.DeletePageRule("z1", "r1"))
+
+	out := buf.String()
+	assert.Contains(t, out, "cloudflare: dry run: PATCH /zones/z1/pagerules/r1\n{")
+	assert.Contains(t, out, `"status":"disabled"`)
+	assert.Contains(t, out, "cloudflare: dry run: DELETE /zones/z1/pagerules/r1\n")
+}
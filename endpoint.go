@@ -0,0 +1,122 @@
+package cloudflare
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// requestInfo is stored in the context of each request made by the client.
+type requestInfo struct {
+	uri     string
+	attempt int
+}
+
+type requestInfoKey struct{}
+
+func requestInfoFrom(req *http.Request) requestInfo {
+	info, _ := req.Context().Value(requestInfoKey{}).(requestInfo)
+	return info
+}
+
+// RequestAttempt returns the number of the attempt a request made by the
+// client is, starting from 1, for use by interceptors.
+func RequestAttempt(req *http.Request) int {
+	return requestInfoFrom(req).attempt
+}
+
+// RequestEndpoint returns the endpoint a request made by the client is for,
+// relative to the client's BaseURL and templated with Endpoint, along with
+// the identifiers in it. It is intended for grouping requests in metrics and
+// traces.
+func RequestEndpoint(req *http.Request) (string, map[string]string) {
+	uri := requestInfoFrom(req).uri
+	if i := strings.IndexByte(uri, '?'); i >= 0 {
+		uri = uri[:i]
+	}
+	return Endpoint(uri)
+}
+
+// Endpoint returns path with the identifiers in it replaced by placeholders,
+// so that requests to the same endpoint can be grouped, along with the
+// identifiers keyed by placeholder name. Identifiers are 32-character hex IDs,
+// UUIDs, and the segment after a route which names its resources, such as the
+// domain name in "/registrar/domains/example.com" or the script name in
+// "/workers/scripts/my-worker"; other segments are kept, so an unrecognised
+// identifier still shows up in the endpoint.
+//
+// Zone and account identifiers are named zone_id and account_id; others are
+// named id, with a numeric suffix if there are several. For example
+// "/zones/023e…c353/dns_records/372e…0b59" becomes
+// "/zones/:zone_id/dns_records/:id".
+func Endpoint(path string) (string, map[string]string) {
+	segments := strings.Split(path, "/")
+	ids := make(map[string]string)
+	n := 0
+	for i, s := range segments {
+		if !isID(s) && !isUUID(s) && !(i > 0 && namedRoutes[segments[i-1]] && s != "") {
+			continue
+		}
+		var name string
+		switch {
+		case i > 0 && segments[i-1] == "zones":
+			name = "zone_id"
+		case i > 0 && segments[i-1] == "accounts":
+			name = "account_id"
+		default:
+			n++
+			name = "id"
+			if n > 1 {
+				name += strconv.Itoa(n)
+			}
+		}
+		ids[name] = s
+		segments[i] = ":" + name
+	}
+	return strings.Join(segments, "/"), ids
+}
+
+// namedRoutes are the route segments followed by a resource identifier which
+// is not a hex ID or UUID: registrar domain names, Workers script names and
+// deployment IDs, and Origin CA certificate IDs.
+var namedRoutes = map[string]bool{
+	"domains":      true,
+	"scripts":      true,
+	"deployments":  true,
+	"certificates": true,
+}
+
+// isID reports whether s looks like a Cloudflare identifier: 32 hexadecimal
+// characters.
+func isID(s string) bool {
+	if len(s) != 32 {
+		return false
+	}
+	for _, c := range s {
+		if !('0' <= c && c <= '9' || 'a' <= c && c <= 'f') {
+			return false
+		}
+	}
+	return true
+}
+
+// isUUID reports whether s is a UUID in its dash-separated form, as used for
+// e.g. device and DLP profile identifiers.
+func isUUID(s string) bool {
+	if len(s) != 36 {
+		return false
+	}
+	for i, c := range s {
+		switch i {
+		case 8, 13, 18, 23:
+			if c != '-' {
+				return false
+			}
+		default:
+			if !('0' <= c && c <= '9' || 'a' <= c && c <= 'f' || 'A' <= c && c <= 'F') {
+				return false
+			}
+		}
+	}
+	return true
+}
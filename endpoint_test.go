@@ -0,0 +1,49 @@
+package cloudflare
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEndpoint(t *testing.T) {
+	const (
+		zoneID   = "023e105f4ecef8ad9ca31a8372d0c353"
+		recordID = "372e67954025e0ba6aaa6d586b9e0b59"
+	)
+
+	endpoint, ids := Endpoint("/zones/" + zoneID + "/dns_records/" + recordID)
+	assert.Equal(t, "/zones/:zone_id/dns_records/:id", endpoint)
+	assert.Equal(t, map[string]string{"zone_id": zoneID, "id": recordID}, ids)
+
+	endpoint, ids = Endpoint("/accounts/" + zoneID + "/workers/scripts/my-worker")
+	assert.Equal(t, "/accounts/:account_id/workers/scripts/:id", endpoint)
+	assert.Equal(t, map[string]string{"account_id": zoneID, "id": "my-worker"}, ids)
+
+	endpoint, ids = Endpoint("/accounts/" + zoneID + "/workers/scripts/my-worker/deployments/d1")
+	assert.Equal(t, "/accounts/:account_id/workers/scripts/:id/deployments/:id2", endpoint)
+	assert.Equal(t, map[string]string{"account_id": zoneID, "id": "my-worker", "id2": "d1"}, ids)
+
+	endpoint, _ = Endpoint("/accounts/" + zoneID + "/workers/scripts/my-worker/deployments")
+	assert.Equal(t, "/accounts/:account_id/workers/scripts/:id/deployments", endpoint)
+
+	endpoint, _ = Endpoint("/accounts/" + zoneID + "/registrar/domains/example.com/transfer")
+	assert.Equal(t, "/accounts/:account_id/registrar/domains/:id/transfer", endpoint)
+
+	endpoint, _ = Endpoint("/certificates/328578533902268680212849205732770752308931942346")
+	assert.Equal(t, "/certificates/:id", endpoint)
+
+	endpoint, ids = Endpoint("/accounts/" + zoneID + "/devices/f174e90a-fafe-4643-bbbc-4a0ed4fc8415")
+	assert.Equal(t, "/accounts/:account_id/devices/:id", endpoint)
+	assert.Equal(t, "f174e90a-fafe-4643-bbbc-4a0ed4fc8415", ids["id"])
+
+	endpoint, _ = Endpoint("/accounts/" + zoneID + "/devices/revoke")
+	assert.Equal(t, "/accounts/:account_id/devices/revoke", endpoint)
+
+	endpoint, _ = Endpoint("/user/firewall/access_rules/rules/" + zoneID + "/" + recordID)
+	assert.Equal(t, "/user/firewall/access_rules/rules/:id/:id2", endpoint)
+
+	endpoint, ids = Endpoint("/zones/example.com")
+	assert.Equal(t, "/zones/example.com", endpoint)
+	assert.Empty(t, ids)
+}
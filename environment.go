@@ -0,0 +1,57 @@
+package cloudflare
+
+import (
+	"crypto/tls"
+	"net/http"
+
+	"github.com/pkg/errors"
+)
+
+// Environment is a Cloudflare API environment: the public API or one of the
+// separately operated compliance environments.
+type Environment struct {
+	Name    string
+	BaseURL string
+	// Header holds headers the environment requires on every request. They
+	// are sent before any headers set with the Headers option.
+	Header http.Header
+}
+
+// The known API environments.
+var (
+	EnvironmentDefault = Environment{
+		Name:    "default",
+		BaseURL: apiURL,
+	}
+	// EnvironmentFedRAMPHigh is the FedRAMP High authorised environment. Its
+	// accounts and credentials are separate from those of the public API.
+	EnvironmentFedRAMPHigh = Environment{
+		Name:    "fedramp-high",
+		BaseURL: "https://api.fed.cloudflare.com/client/v4",
+	}
+)
+
+// UsingEnvironment points the client at an API environment, setting BaseURL
+// and any headers the environment requires.
+func UsingEnvironment(env Environment) Option {
+	return func(api *API) error {
+		if env.BaseURL == "" {
+			return errors.Errorf("environment %q has no base URL", env.Name)
+		}
+		api.BaseURL = env.BaseURL
+		api.environment = env
+		return nil
+	}
+}
+
+// ClientCertificate presents cert when connecting to the API, for
+// environments which authenticate clients with mutual TLS. It configures the
+// client created when none is supplied, so cannot be combined with HTTPClient.
+func ClientCertificate(cert tls.Certificate) Option {
+	return func(api *API) error {
+		api.transport.set = true
+		api.transport.tuned = true
+		api.transport.certificates = append(api.transport.certificates, cert)
+		return nil
+	}
+}
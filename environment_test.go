@@ -0,0 +1,63 @@
+package cloudflare
+
+import (
+	"crypto/tls"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUsingEnvironment(t *testing.T) {
+	api, err := New("deadbeef", "cloudflare@example.org")
+	if assert.NoError(t, err) {
+		assert.Equal(t, apiURL, api.BaseURL)
+	}
+
+	api, err = New("deadbeef", "cloudflare@example.org", UsingEnvironment(EnvironmentFedRAMPHigh))
+	if assert.NoError(t, err) {
+		assert.Equal(t, "https://api.fed.cloudflare.com/client/v4", api.BaseURL)
+	}
+
+	_, err = New("deadbeef", "cloudflare@example.org", UsingEnvironment(Environment{Name: "empty"}))
+	assert.Error(t, err)
+}
+
+func TestEnvironmentHeaders(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/z1", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "eu", r.Header.Get("X-Region"))
+		assert.Equal(t, "overridden", r.Header.Get("X-Tenant"))
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"id": "z1"}}`)
+	})
+
+	env := Environment{
+		Name:    "regional",
+		BaseURL: server.URL,
+		Header:  http.Header{"X-Region": {"eu"}, "X-Tenant": {"default"}},
+	}
+	api, err := New("deadbeef", "cloudflare@example.org",
+		UsingEnvironment(env),
+		Headers(http.Header{"X-Tenant": {"overridden"}}),
+	)
+	if assert.NoError(t, err) {
+		_, err = api.ZoneDetails("z1")
+		assert.NoError(t, err)
+	}
+}
+
+func TestClientCertificate(t *testing.T) {
+	cert := tls.Certificate{Certificate: [][]byte{[]byte("cert")}}
+	api, err := New("deadbeef", "cloudflare@example.org", ClientCertificate(cert))
+	if assert.NoError(t, err) {
+		tr := api.httpClient.Transport.(*http.Transport)
+		assert.Equal(t, []tls.Certificate{cert}, tr.TLSClientConfig.Certificates)
+	}
+
+	_, err = New("deadbeef", "cloudflare@example.org", HTTPClient(http.DefaultClient), ClientCertificate(cert))
+	assert.Error(t, err)
+}
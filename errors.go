@@ -1,13 +1,28 @@
 package cloudflare
 
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
 // Error messages
 const (
-	errEmptyCredentials = "invalid credentials: key & email must not be empty"
-	errMakeRequestError = "error from makeRequest"
-	errUnmarshalError   = "error unmarshalling the JSON response"
+	errEmptyCredentials    = "invalid credentials: key & email must not be empty"
+	errEmptyAPIToken       = "invalid credentials: API token must not be empty"
+	errEmptyUserServiceKey = "invalid credentials: user service key must not be empty"
+	errMakeRequestError    = "error from makeRequest"
+	errUnmarshalError      = "error unmarshalling the JSON response"
 )
 
-var _ Error = &UserError{}
+var (
+	_ Error = &UserError{}
+	_ Error = &APIError{}
+	_ Error = &UnknownOutcomeError{}
+)
 
 // Error represents an error returned from this library.
 type Error interface {
@@ -45,3 +60,223 @@ func (e *UserError) Parse() bool {
 func (e *UserError) Error() string {
 	return e.Err.Error()
 }
+
+// errorCodeRateLimited is the API error code for requests rejected by the rate
+// limit.
+const errorCodeRateLimited = 971
+
+// APIError is an unsuccessful response from the API. Errors returned by the
+// client's methods wrap it; use AsAPIError to retrieve it.
+type APIError struct {
+	StatusCode int
+	// Errors are the errors from the response body, if it could be parsed.
+	Errors []ResponseInfo
+
+	body         string
+	unavailable  bool
+	unsuccessful bool
+}
+
+// ErrAPIUnavailable is matched by the errors returned when the API is
+// unavailable, such as during maintenance, and Cloudflare responded with an
+// HTML error page instead of JSON. Use IsAPIUnavailable (or errors.Is) to
+// detect it.
+var ErrAPIUnavailable = errors.New("the API is unavailable")
+
+// IsAPIUnavailable reports whether err was returned because the API was
+// unavailable; see ErrAPIUnavailable. Such requests may be retried with the
+// Retry option.
+func IsAPIUnavailable(err error) bool {
+	e, ok := AsAPIError(err)
+	return ok && e.unavailable
+}
+
+// AsAPIError returns the *APIError which err wraps, if any.
+func AsAPIError(err error) (*APIError, bool) {
+	e, ok := errors.Cause(err).(*APIError)
+	return e, ok
+}
+
+// statusError returns the error for an unsuccessful HTTP response, decoding
+// its body with codec.
+func statusError(codec Codec, statusCode int, body []byte) error {
+	e := &APIError{StatusCode: statusCode}
+	var r Response
+	if err := codec.Unmarshal(body, &r); err == nil && len(r.Errors) > 0 {
+		e.Errors = r.Errors
+	} else {
+		e.body = string(body)
+	}
+	return e
+}
+
+// unsuccessfulError returns the error for a response with a successful HTTP
+// status whose envelope nevertheless reported failure.
+func unsuccessfulError(errs []ResponseInfo) error {
+	return &APIError{StatusCode: http.StatusOK, Errors: errs, unsuccessful: true}
+}
+
+// unavailableError returns an error if resp is an HTML page served by
+// Cloudflare in place of the API, which it does when the API is down or under
+// maintenance (typically with status 502, 503 or 520 to 524). Otherwise it
+// returns nil.
+func unavailableError(resp *http.Response, body []byte) error {
+	if resp.StatusCode != http.StatusOK && resp.StatusCode < 500 {
+		return nil
+	}
+	html := strings.HasPrefix(resp.Header.Get("Content-Type"), "text/html") ||
+		bytes.HasPrefix(bytes.TrimSpace(body), []byte("<"))
+	if !html {
+		return nil
+	}
+	return &APIError{StatusCode: resp.StatusCode, body: string(body), unavailable: true}
+}
+
+// Error describes the API's errors, or the HTTP status if the response had
+// none.
+func (e *APIError) Error() string {
+	if len(e.Errors) > 0 {
+		msgs := make([]string, len(e.Errors))
+		for i, info := range e.Errors {
+			msgs[i] = info.String()
+		}
+		return fmt.Sprintf("HTTP status %d: %s", e.StatusCode, strings.Join(msgs, ", "))
+	}
+
+	switch {
+	case e.unavailable:
+		return fmt.Sprintf("HTTP status %d: %s", e.StatusCode, ErrAPIUnavailable)
+	case e.unsuccessful:
+		return fmt.Sprintf("HTTP status %d: response was unsuccessful", e.StatusCode)
+	case e.StatusCode == http.StatusUnauthorized:
+		return fmt.Sprintf("HTTP status %d: invalid credentials", e.StatusCode)
+	case e.StatusCode == http.StatusForbidden:
+		return fmt.Sprintf("HTTP status %d: insufficient permissions", e.StatusCode)
+	case e.IsServiceError():
+		return fmt.Sprintf("HTTP status %d: service failure", e.StatusCode)
+	}
+	return fmt.Sprintf("HTTP status %d: content %q", e.StatusCode, e.body)
+}
+
+// Is reports whether target is ErrAPIUnavailable and the API was unavailable.
+func (e *APIError) Is(target error) bool {
+	return target == ErrAPIUnavailable && e.unavailable
+}
+
+// ErrorCode returns the code of the first of the API's errors, or zero if
+// there were none.
+func (e *APIError) ErrorCode() int {
+	if len(e.Errors) == 0 {
+		return 0
+	}
+	return e.Errors[0].Code
+}
+
+// ErrorCodes returns the codes of all of the API's errors, including those in
+// their error chains.
+func (e *APIError) ErrorCodes() []int {
+	var codes []int
+	var add func([]ResponseInfo)
+	add = func(infos []ResponseInfo) {
+		for _, info := range infos {
+			codes = append(codes, info.Code)
+			add(info.ErrorChain)
+		}
+	}
+	add(e.Errors)
+	return codes
+}
+
+// HasErrorCode reports whether any of the API's errors, including those in
+// their error chains, has the given code.
+func (e *APIError) HasErrorCode(code int) bool {
+	for _, c := range e.ErrorCodes() {
+		if c == code {
+			return true
+		}
+	}
+	return false
+}
+
+// IsRateLimited reports whether the request was rejected by the rate limit.
+func (e *APIError) IsRateLimited() bool {
+	return e.StatusCode == http.StatusTooManyRequests || e.HasErrorCode(errorCodeRateLimited)
+}
+
+// IsNotFound reports whether the requested resource does not exist.
+func (e *APIError) IsNotFound() bool {
+	return e.StatusCode == http.StatusNotFound
+}
+
+// IsUnauthorized reports whether the credentials were invalid.
+func (e *APIError) IsUnauthorized() bool {
+	return e.StatusCode == http.StatusUnauthorized
+}
+
+// IsForbidden reports whether the credentials lack permission for the request.
+func (e *APIError) IsForbidden() bool {
+	return e.StatusCode == http.StatusForbidden
+}
+
+// IsServiceError reports whether the API failed, rather than rejecting the
+// request.
+func (e *APIError) IsServiceError() bool {
+	return e.StatusCode >= 500 && e.StatusCode <= 599
+}
+
+// User reports whether the request was rejected, other than by the rate
+// limit.
+func (e *APIError) User() bool {
+	return e.StatusCode >= 400 && e.StatusCode <= 499 && !e.IsRateLimited()
+}
+
+// Parse is always false for an APIError.
+func (e *APIError) Parse() bool {
+	return false
+}
+
+// Network is always false for an APIError.
+func (e *APIError) Network() bool {
+	return false
+}
+
+// UnknownOutcomeError is returned when a request which is not safe to repeat,
+// such as one creating an object, failed after it may have reached the API, so
+// that whether the API acted on it is unknown. Such requests are not retried,
+// since that could create duplicates; see VerifyCreates. Errors returned by
+// the client's methods wrap it; use IsUnknownOutcome to detect it.
+type UnknownOutcomeError struct {
+	Method string
+	URI    string
+	Err    error
+}
+
+// IsUnknownOutcome reports whether err wraps an *UnknownOutcomeError.
+func IsUnknownOutcome(err error) bool {
+	_, ok := errors.Cause(err).(*UnknownOutcomeError)
+	return ok
+}
+
+func (e *UnknownOutcomeError) Error() string {
+	return fmt.Sprintf("outcome of %s %s is unknown: %s", e.Method, e.URI, e.Err)
+}
+
+// Unwrap returns the error with which the request failed.
+func (e *UnknownOutcomeError) Unwrap() error {
+	return e.Err
+}
+
+// User is always false for an UnknownOutcomeError.
+func (e *UnknownOutcomeError) User() bool {
+	return false
+}
+
+// Parse is always false for an UnknownOutcomeError.
+func (e *UnknownOutcomeError) Parse() bool {
+	return false
+}
+
+// Network is always true for an UnknownOutcomeError.
+func (e *UnknownOutcomeError) Network() bool {
+	return true
+}
@@ -0,0 +1,135 @@
+package cloudflare
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAPIError(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/missing", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{
+			"success": false,
+			"errors": [{"code": 1001, "message": "Invalid zone identifier", "error_chain": [{"code": 7003, "message": "Could not route"}]}],
+			"messages": [],
+			"result": null
+		}`)
+	})
+	mux.HandleFunc("/zones/busy", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusTooManyRequests)
+		fmt.Fprint(w, `<html>slow down</html>`)
+	})
+
+	_, err := client.ZoneDetails("missing")
+	e, ok := AsAPIError(err)
+	if assert.True(t, ok) {
+		assert.True(t, e.IsNotFound())
+		assert.False(t, e.IsRateLimited())
+		assert.True(t, e.User())
+		assert.Equal(t, 1001, e.ErrorCode())
+		assert.Equal(t, []int{1001, 7003}, e.ErrorCodes())
+		assert.True(t, e.HasErrorCode(7003))
+		assert.Equal(t, "HTTP status 404: Invalid zone identifier (1001): Could not route (7003)", e.Error())
+	}
+
+	_, err = client.ZoneDetails("busy")
+	e, ok = AsAPIError(err)
+	if assert.True(t, ok) {
+		assert.True(t, e.IsRateLimited())
+		assert.False(t, e.User())
+		assert.Equal(t, 0, e.ErrorCode())
+		assert.Equal(t, `HTTP status 429: content "<html>slow down</html>"`, e.Error())
+	}
+
+	_, ok = AsAPIError(fmt.Errorf("other"))
+	assert.False(t, ok)
+}
+
+func TestAPIErrorStatus(t *testing.T) {
+	assert.Equal(t, "HTTP status 401: invalid credentials", statusError(stdCodec{}, 401, nil).Error())
+	assert.Equal(t, "HTTP status 403: insufficient permissions", statusError(stdCodec{}, 403, nil).Error())
+	assert.Equal(t, "HTTP status 502: service failure", statusError(stdCodec{}, 502, []byte("bad gateway")).Error())
+	assert.True(t, statusError(stdCodec{}, 500, nil).(*APIError).IsServiceError())
+	assert.True(t, statusError(stdCodec{}, 400, []byte(`{"errors": [{"code": 971, "message": "Please wait"}]}`)).(*APIError).IsRateLimited())
+}
+
+func TestAPIUnavailable(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var attempts int
+	mux.HandleFunc("/zones/maintenance", func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.Header().Set("content-type", "text/html; charset=UTF-8")
+		if attempts == 1 {
+			w.WriteHeader(521)
+		}
+		fmt.Fprint(w, "<!DOCTYPE html><html><title>Down for maintenance</title></html>")
+	})
+
+	_, err := client.ZoneDetails("maintenance")
+	assert.True(t, IsAPIUnavailable(err))
+	assert.Equal(t, "HTTP status 521: the API is unavailable", errors.Cause(err).Error())
+	assert.True(t, errors.Cause(err).(*APIError).Is(ErrAPIUnavailable))
+
+	// An HTML page served with status 200 is not mistaken for a response.
+	_, err = client.ZoneDetails("maintenance")
+	assert.True(t, IsAPIUnavailable(err))
+	assert.Equal(t, 200, errors.Cause(err).(*APIError).StatusCode)
+
+	attempts = 0
+	mux.HandleFunc("/zones/recovering", func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts == 1 {
+			w.Header().Set("content-type", "text/html")
+			w.WriteHeader(http.StatusOK)
+			fmt.Fprint(w, "<html>maintenance</html>")
+			return
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"id": "z1"}}`)
+	})
+	client.retryPolicy = RetryPolicy{MaxAttempts: 2, BaseDelay: time.Millisecond, MaxDelay: time.Millisecond}
+	z, err := client.ZoneDetails("recovering")
+	assert.NoError(t, err)
+	assert.Equal(t, "z1", z.ID)
+	assert.Equal(t, 2, attempts)
+
+	// Errors from the API itself are unaffected.
+	assert.False(t, IsAPIUnavailable(statusError(stdCodec{}, 503, []byte("{}"))))
+	assert.False(t, IsAPIUnavailable(fmt.Errorf("other")))
+}
+
+func TestUnsuccessfulResponse(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/z1/pagerules/r1", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": false, "errors": [{"code": 1002, "message": "Page rule not found"}], "messages": [], "result": null}`)
+	})
+	mux.HandleFunc("/zones/z1/pagerules/r2", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": false, "errors": [], "messages": [], "result": null}`)
+	})
+
+	err := client.DeletePageRule("z1", "r1")
+	if e, ok := AsAPIError(err); assert.True(t, ok) {
+		assert.Equal(t, http.StatusOK, e.StatusCode)
+		assert.Equal(t, 1002, e.ErrorCode())
+	}
+
+	_, err = client.PageRule("z1", "r2")
+	if e, ok := AsAPIError(err); assert.True(t, ok) {
+		assert.Equal(t, "HTTP status 200: response was unsuccessful", e.Error())
+	}
+}
@@ -0,0 +1,40 @@
+package cloudflare
+
+import "net/http"
+
+// etagEntry is a cached response body and the ETag which validates it.
+type etagEntry struct {
+	etag string
+	body []byte
+}
+
+// cachedResponse returns the cached response for a request, or nil if there is
+// none or conditional requests are disabled.
+func (api *API) cachedResponse(method, uri string) *etagEntry {
+	if !api.conditionalRequests || method != "GET" {
+		return nil
+	}
+
+	api.cache.mu.Lock()
+	defer api.cache.mu.Unlock()
+	return api.cache.etags[uri]
+}
+
+// cacheResponse remembers a successful response for a request if the API
+// returned an ETag for it.
+func (api *API) cacheResponse(method, uri string, resp *http.Response, body []byte) {
+	if !api.conditionalRequests || method != "GET" {
+		return
+	}
+	etag := resp.Header.Get("ETag")
+	if etag == "" {
+		return
+	}
+
+	api.cache.mu.Lock()
+	defer api.cache.mu.Unlock()
+	if api.cache.etags == nil {
+		api.cache.etags = make(map[string]*etagEntry)
+	}
+	api.cache.etags[uri] = &etagEntry{etag: etag, body: body}
+}
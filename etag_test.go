@@ -0,0 +1,49 @@
+package cloudflare
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConditionalRequests(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var requests int
+	mux.HandleFunc("/zones/foo", func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		assert.Empty(t, r.Header.Get("If-None-Match"))
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("ETag", `"v1"`)
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"id": "foo", "status": "pending"}}`)
+	})
+
+	// Without the option, a 304 is never requested.
+	for i := 0; i < 2; i++ {
+		z, err := client.ZoneDetails("foo")
+		if assert.NoError(t, err) {
+			assert.Equal(t, "pending", z.Status)
+		}
+	}
+
+	api, err := New("deadbeef", "cloudflare@example.org", ConditionalRequests())
+	if !assert.NoError(t, err) {
+		return
+	}
+	api.BaseURL = server.URL
+	for i := 0; i < 2; i++ {
+		z, err := api.ZoneDetails("foo")
+		if assert.NoError(t, err) {
+			assert.Equal(t, "pending", z.Status)
+		}
+	}
+	assert.Equal(t, 4, requests)
+	assert.Empty(t, api.headers.Get("If-None-Match"))
+}
@@ -0,0 +1,209 @@
+package cloudflare
+
+import (
+	"net/url"
+	"time"
+)
+
+// Filter is a Firewall Rules expression which may be shared between rules.
+type Filter struct {
+	ID          string `json:"id,omitempty"`
+	Expression  string `json:"expression"`
+	Paused      bool   `json:"paused"`
+	Description string `json:"description,omitempty"`
+	Ref         string `json:"ref,omitempty"`
+}
+
+// FirewallRule is a rule which applies an action to requests matching a
+// Filter.
+//
+// Valid actions are "block", "challenge", "js_challenge", "allow" and "log".
+type FirewallRule struct {
+	ID          string    `json:"id,omitempty"`
+	Paused      bool      `json:"paused"`
+	Description string    `json:"description,omitempty"`
+	Action      string    `json:"action"`
+	Priority    int       `json:"priority,omitempty"`
+	Filter      Filter    `json:"filter"`
+	Ref         string    `json:"ref,omitempty"`
+	CreatedOn   time.Time `json:"created_on,omitempty"`
+	ModifiedOn  time.Time `json:"modified_on,omitempty"`
+}
+
+// FirewallRules returns all Firewall Rules for a zone.
+//
+// API reference: https://api.cloudflare.com/#firewall-rules-list-of-firewall-rules
+func (api *API) FirewallRules(zoneID string) ([]FirewallRule, error) {
+	var rules []FirewallRule
+	if err := api.paginateInto("/zones/"+zoneID+"/firewall/rules", nil, &rules); err != nil {
+		return nil, err
+	}
+	return rules, nil
+}
+
+// CreateFirewallRules creates Firewall Rules in bulk. Each rule's Filter may
+// either reference an existing filter by ID or define a new one.
+//
+// API reference: https://api.cloudflare.com/#firewall-rules-create-firewall-rules
+func (api *API) CreateFirewallRules(zoneID string, rules []FirewallRule) ([]FirewallRule, error) {
+	var result []FirewallRule
+	if _, err := api.makeRequestResult("POST", "/zones/"+zoneID+"/firewall/rules", rules, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// UpdateFirewallRules updates Firewall Rules in bulk. Each rule must have its
+// ID and the ID of its Filter set.
+//
+// API reference: https://api.cloudflare.com/#firewall-rules-update-firewall-rules
+func (api *API) UpdateFirewallRules(zoneID string, rules []FirewallRule) ([]FirewallRule, error) {
+	var result []FirewallRule
+	if _, err := api.makeRequestResult("PUT", "/zones/"+zoneID+"/firewall/rules", rules, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// DeleteFirewallRules deletes Firewall Rules in bulk. The rules' filters are
+// not deleted.
+//
+// API reference: https://api.cloudflare.com/#firewall-rules-delete-firewall-rules
+func (api *API) DeleteFirewallRules(zoneID string, ruleIDs []string) error {
+	v := url.Values{}
+	for _, id := range ruleIDs {
+		v.Add("id", id)
+	}
+	if _, err := api.makeRequestResult("DELETE", "/zones/"+zoneID+"/firewall/rules?"+v.Encode(), nil, nil); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Filters returns all Filters for a zone.
+//
+// API reference: https://api.cloudflare.com/#filters-list-filters
+func (api *API) Filters(zoneID string) ([]Filter, error) {
+	var filters []Filter
+	if err := api.paginateInto("/zones/"+zoneID+"/filters", nil, &filters); err != nil {
+		return nil, err
+	}
+	return filters, nil
+}
+
+// UpdateFilters updates Filters in bulk. Each filter must have its ID set.
+//
+// API reference: https://api.cloudflare.com/#filters-update-filters
+func (api *API) UpdateFilters(zoneID string, filters []Filter) ([]Filter, error) {
+	var result []Filter
+	if _, err := api.makeRequestResult("PUT", "/zones/"+zoneID+"/filters", filters, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// DeleteFilters deletes Filters in bulk. Filters which are still referenced by
+// a Firewall Rule cannot be deleted.
+//
+// API reference: https://api.cloudflare.com/#filters-delete-filters
+func (api *API) DeleteFilters(zoneID string, filterIDs []string) error {
+	v := url.Values{}
+	for _, id := range filterIDs {
+		v.Add("id", id)
+	}
+	if _, err := api.makeRequestResult("DELETE", "/zones/"+zoneID+"/filters?"+v.Encode(), nil, nil); err != nil {
+		return err
+	}
+	return nil
+}
+
+// AccessRule is an IP Access Rule, which applies an action to requests from an
+// IP address, IP range, ASN or country.
+//
+// Valid modes are "block", "challenge", "js_challenge" and "whitelist".
+//
+// Scope is set on rules returned by the API. The rules of a zone include those
+// inherited from its account or user, which can only be changed there.
+type AccessRule struct {
+	ID            string                  `json:"id,omitempty"`
+	Notes         string                  `json:"notes,omitempty"`
+	Mode          string                  `json:"mode"`
+	Configuration AccessRuleConfiguration `json:"configuration"`
+	Scope         *AccessRuleScope        `json:"scope,omitempty"`
+	CreatedOn     time.Time               `json:"created_on,omitempty"`
+	ModifiedOn    time.Time               `json:"modified_on,omitempty"`
+}
+
+// AccessRuleScope is the owner of an IP Access Rule. Type is one of "zone",
+// "organization" or "user".
+type AccessRuleScope struct {
+	ID    string `json:"id,omitempty"`
+	Email string `json:"email,omitempty"`
+	Name  string `json:"name,omitempty"`
+	Type  string `json:"type"`
+}
+
+// inherited reports whether the rule belongs to an account or user rather
+// than to the zone it was listed for.
+func (r AccessRule) inherited() bool {
+	return r.Scope != nil && r.Scope.Type != "" && r.Scope.Type != "zone"
+}
+
+// AccessRuleConfiguration is the target of an IP Access Rule. Target is one of
+// "ip", "ip_range", "asn" or "country".
+type AccessRuleConfiguration struct {
+	Target string `json:"target"`
+	Value  string `json:"value"`
+}
+
+// ZoneAccessRules returns all IP Access Rules for a zone, including those
+// inherited from its account or user (see AccessRule.Scope).
+//
+// API reference: https://api.cloudflare.com/#firewall-access-rule-for-a-zone-list-access-rules
+func (api *API) ZoneAccessRules(zoneID string) ([]AccessRule, error) {
+	var rules []AccessRule
+	if err := api.paginateInto("/zones/"+zoneID+"/firewall/access_rules/rules", nil, &rules); err != nil {
+		return nil, err
+	}
+	return rules, nil
+}
+
+// CreateZoneAccessRule creates an IP Access Rule for a zone.
+//
+// API reference: https://api.cloudflare.com/#firewall-access-rule-for-a-zone-create-access-rule
+func (api *API) CreateZoneAccessRule(zoneID string, rule AccessRule) (AccessRule, error) {
+	var result AccessRule
+	if _, err := api.makeRequestResult("POST", "/zones/"+zoneID+"/firewall/access_rules/rules", rule, &result); err != nil {
+		return AccessRule{}, err
+	}
+	return result, nil
+}
+
+// UpdateZoneAccessRule changes the mode and notes of an IP Access Rule. The
+// configuration of a rule cannot be changed.
+//
+// API reference: https://api.cloudflare.com/#firewall-access-rule-for-a-zone-update-access-rule
+func (api *API) UpdateZoneAccessRule(zoneID, ruleID string, rule AccessRule) (AccessRule, error) {
+	params := struct {
+		Mode  string `json:"mode"`
+		Notes string `json:"notes"`
+	}{
+		Mode:  rule.Mode,
+		Notes: rule.Notes,
+	}
+	var result AccessRule
+	if _, err := api.makeRequestResult("PATCH", "/zones/"+zoneID+"/firewall/access_rules/rules/"+ruleID, params, &result); err != nil {
+		return AccessRule{}, err
+	}
+	return result, nil
+}
+
+// DeleteZoneAccessRule deletes an IP Access Rule from a zone.
+//
+// API reference: https://api.cloudflare.com/#firewall-access-rule-for-a-zone-delete-access-rule
+func (api *API) DeleteZoneAccessRule(zoneID, ruleID string) error {
+	if _, err := api.makeRequestResult("DELETE", "/zones/"+zoneID+"/firewall/access_rules/rules/"+ruleID, nil, nil); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,90 @@
+package cloudflare
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFirewallRules(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		switch r.URL.Query().Get("page") {
+		case "1":
+			fmt.Fprint(w, `{
+				"success": true,
+				"errors": [],
+				"messages": [],
+				"result": [
+					{
+						"id": "372e67954025e0ba6aaa6d586b9e0b60",
+						"paused": false,
+						"description": "Block bad bots",
+						"action": "block",
+						"priority": 50,
+						"filter": {
+							"id": "372e67954025e0ba6aaa6d586b9e0b61",
+							"expression": "(cf.client.bot)",
+							"paused": false
+						}
+					}
+				],
+				"result_info": {"page": 1, "per_page": 1, "count": 1, "total_count": 2}
+			}`)
+		case "2":
+			fmt.Fprint(w, `{
+				"success": true,
+				"errors": [],
+				"messages": [],
+				"result": [
+					{
+						"id": "372e67954025e0ba6aaa6d586b9e0b62",
+						"paused": true,
+						"description": "Challenge admin",
+						"action": "challenge",
+						"filter": {
+							"id": "372e67954025e0ba6aaa6d586b9e0b63",
+							"expression": "(http.request.uri.path contains \"/admin\")",
+							"paused": false
+						}
+					}
+				],
+				"result_info": {"page": 2, "per_page": 1, "count": 1, "total_count": 2}
+			}`)
+		default:
+			t.Errorf("unexpected page %q", r.URL.Query().Get("page"))
+		}
+	}
+
+	mux.HandleFunc("/zones/foo/firewall/rules", handler)
+
+	rules, err := client.FirewallRules("foo")
+	if assert.NoError(t, err) && assert.Len(t, rules, 2) {
+		assert.Equal(t, "block", rules[0].Action)
+		assert.Equal(t, 50, rules[0].Priority)
+		assert.Equal(t, "(cf.client.bot)", rules[0].Filter.Expression)
+		assert.True(t, rules[1].Paused)
+	}
+}
+
+func TestDeleteFirewallRules(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "DELETE", r.Method, "Expected method 'DELETE', got %s", r.Method)
+		assert.Equal(t, []string{"a", "b"}, r.URL.Query()["id"])
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": [{"id": "a"}, {"id": "b"}]}`)
+	}
+
+	mux.HandleFunc("/zones/foo/firewall/rules", handler)
+
+	assert.NoError(t, client.DeleteFirewallRules("foo", []string{"a", "b"}))
+}
@@ -0,0 +1,218 @@
+package cloudflare
+
+import "github.com/pkg/errors"
+
+// FirewallSyncOptions controls the behaviour of SyncFirewallRules and
+// SyncZoneAccessRules.
+type FirewallSyncOptions struct {
+	// AllowDeletes permits rules which are not in the desired set to be
+	// deleted. Without it, such rules are left in place.
+	AllowDeletes bool
+	// DryRun computes the changes without making them.
+	DryRun bool
+}
+
+// SyncFirewallRules brings the Firewall Rules of a zone in line with the
+// desired set, returning the changes which were made (or which would be made,
+// for a dry run).
+//
+// Rules are matched on their Ref if set, and otherwise on their Description;
+// every desired rule must have one or the other. Each desired rule should
+// define its filter inline with an expression. Changes are made in bulk: one
+// request each to delete, update and create rules (and their filters).
+func (api *API) SyncFirewallRules(zoneID string, desired []FirewallRule, opts FirewallSyncOptions) ([]ZoneConfigChange, error) {
+	existing, err := api.FirewallRules(zoneID)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not list Firewall Rules")
+	}
+
+	all, err := diffFirewallRules(existing, desired)
+	if err != nil {
+		return nil, err
+	}
+
+	// The changes are ordered deletes, updates and creates, as they are made.
+	var changes []ZoneConfigChange
+	var deleteRules, deleteFilters []string
+	var updateRules, createRules []FirewallRule
+	var updateFilters []Filter
+	for _, c := range all {
+		rule := c.Value.(FirewallRule)
+		switch c.Action {
+		case ZoneConfigDelete:
+			if !opts.AllowDeletes {
+				continue
+			}
+			deleteRules = append(deleteRules, rule.ID)
+			deleteFilters = append(deleteFilters, rule.Filter.ID)
+		case ZoneConfigUpdate:
+			updateRules = append(updateRules, rule)
+			updateFilters = append(updateFilters, rule.Filter)
+		case ZoneConfigCreate:
+			createRules = append(createRules, rule)
+		}
+		changes = append(changes, c)
+	}
+
+	if opts.DryRun {
+		return changes, nil
+	}
+
+	// On failure, the changes which were already made are returned. Rules
+	// whose filters could not be deleted have been deleted nevertheless.
+	deleted, updated := len(deleteRules), len(deleteRules)+len(updateRules)
+	if len(deleteRules) > 0 {
+		if err := api.DeleteFirewallRules(zoneID, deleteRules); err != nil {
+			return changes[:0], errors.Wrap(err, "failed to delete Firewall Rules")
+		}
+		if err := api.DeleteFilters(zoneID, deleteFilters); err != nil {
+			return changes[:deleted], errors.Wrap(err, "failed to delete filters")
+		}
+	}
+	if len(updateRules) > 0 {
+		if _, err := api.UpdateFilters(zoneID, updateFilters); err != nil {
+			return changes[:deleted], errors.Wrap(err, "failed to update filters")
+		}
+		if _, err := api.UpdateFirewallRules(zoneID, updateRules); err != nil {
+			return changes[:deleted], errors.Wrap(err, "failed to update Firewall Rules")
+		}
+	}
+	if len(createRules) > 0 {
+		if _, err := api.CreateFirewallRules(zoneID, createRules); err != nil {
+			return changes[:updated], errors.Wrap(err, "failed to create Firewall Rules")
+		}
+	}
+
+	return changes, nil
+}
+
+// SyncZoneAccessRules brings the IP Access Rules of a zone in line with the
+// desired set, returning the changes which were made (or which would be made,
+// for a dry run). Rules are matched on their configuration (target and value).
+func (api *API) SyncZoneAccessRules(zoneID string, desired []AccessRule, opts FirewallSyncOptions) ([]ZoneConfigChange, error) {
+	existing, err := api.ZoneAccessRules(zoneID)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not list IP Access Rules")
+	}
+
+	var changes []ZoneConfigChange
+	for _, c := range diffAccessRules(existing, desired) {
+		if c.Action == ZoneConfigDelete && !opts.AllowDeletes {
+			continue
+		}
+		changes = append(changes, c)
+	}
+
+	if opts.DryRun {
+		return changes, nil
+	}
+	for i, c := range changes {
+		if err := api.applyZoneConfigChange(zoneID, c); err != nil {
+			return changes[:i], errors.Wrap(err, "failed to "+c.String())
+		}
+	}
+	return changes, nil
+}
+
+// firewallRuleKey returns the key on which Firewall Rules are matched.
+func firewallRuleKey(rule FirewallRule) string {
+	if rule.Ref != "" {
+		return "ref:" + rule.Ref
+	}
+	return "description:" + rule.Description
+}
+
+// diffFirewallRules returns the changes needed to turn the existing Firewall
+// Rules into the desired ones. Updated rules carry the IDs of the existing rule
+// and filter.
+func diffFirewallRules(existing, desired []FirewallRule) ([]ZoneConfigChange, error) {
+	current := make(map[string]FirewallRule)
+	for _, rule := range existing {
+		if _, ok := current[firewallRuleKey(rule)]; !ok {
+			current[firewallRuleKey(rule)] = rule
+		}
+	}
+
+	var deletes, updates, creates []ZoneConfigChange
+	seen := make(map[string]bool)
+	for _, want := range desired {
+		if want.Ref == "" && want.Description == "" {
+			return nil, errors.Errorf("Firewall Rule with expression %q must have a ref or description", want.Filter.Expression)
+		}
+		key := firewallRuleKey(want)
+		seen[key] = true
+		have, ok := current[key]
+		if !ok {
+			want.ID = ""
+			want.Filter.ID = ""
+			creates = append(creates, ZoneConfigChange{Action: ZoneConfigCreate, Resource: ZoneConfigFirewallRule, Value: want})
+			continue
+		}
+		want.ID = have.ID
+		want.Filter.ID = have.Filter.ID
+		if want.Priority == 0 {
+			want.Priority = have.Priority
+		}
+		if want.Action != have.Action || want.Paused != have.Paused || want.Priority != have.Priority ||
+			want.Description != have.Description || want.Filter.Expression != have.Filter.Expression ||
+			want.Filter.Paused != have.Filter.Paused {
+			updates = append(updates, ZoneConfigChange{Action: ZoneConfigUpdate, Resource: ZoneConfigFirewallRule, ID: have.ID, Value: want})
+		}
+	}
+
+	for _, have := range existing {
+		key := firewallRuleKey(have)
+		if !seen[key] || current[key].ID != have.ID {
+			deletes = append(deletes, ZoneConfigChange{Action: ZoneConfigDelete, Resource: ZoneConfigFirewallRule, ID: have.ID, Value: have})
+		}
+	}
+
+	return append(append(deletes, updates...), creates...), nil
+}
+
+// diffAccessRules returns the changes needed to turn the existing IP Access
+// Rules into the desired ones. Existing rules inherited from an account or
+// user are ignored, as they cannot be changed through the zone.
+func diffAccessRules(existing, desired []AccessRule) []ZoneConfigChange {
+	existing = zoneAccessRules(existing)
+	current := make(map[AccessRuleConfiguration]AccessRule)
+	for _, rule := range existing {
+		current[rule.Configuration] = rule
+	}
+
+	var deletes, updates, creates []ZoneConfigChange
+	seen := make(map[AccessRuleConfiguration]bool)
+	for _, want := range desired {
+		seen[want.Configuration] = true
+		have, ok := current[want.Configuration]
+		if !ok {
+			want.ID = ""
+			creates = append(creates, ZoneConfigChange{Action: ZoneConfigCreate, Resource: ZoneConfigAccessRule, Value: want})
+			continue
+		}
+		if want.Mode != have.Mode || want.Notes != have.Notes {
+			want.ID = have.ID
+			updates = append(updates, ZoneConfigChange{Action: ZoneConfigUpdate, Resource: ZoneConfigAccessRule, ID: have.ID, Value: want})
+		}
+	}
+
+	for _, have := range existing {
+		if !seen[have.Configuration] {
+			deletes = append(deletes, ZoneConfigChange{Action: ZoneConfigDelete, Resource: ZoneConfigAccessRule, ID: have.ID, Value: have})
+		}
+	}
+
+	return append(append(deletes, updates...), creates...)
+}
+
+// zoneAccessRules returns the rules which belong to the zone itself, leaving
+// out those inherited from its account or user.
+func zoneAccessRules(rules []AccessRule) []AccessRule {
+	var own []AccessRule
+	for _, rule := range rules {
+		if !rule.inherited() {
+			own = append(own, rule)
+		}
+	}
+	return own
+}
@@ -0,0 +1,145 @@
+package cloudflare
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSyncFirewallRules(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var requests []string
+	mux.HandleFunc("/zones/foo/firewall/rules", func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" /firewall/rules")
+		w.Header().Set("content-type", "application/json")
+		switch r.Method {
+		case "GET":
+			fmt.Fprint(w, `{
+				"success": true,
+				"errors": [],
+				"messages": [],
+				"result": [
+					{"id": "r1", "description": "bots", "action": "challenge", "filter": {"id": "f1", "expression": "(cf.client.bot)"}},
+					{"id": "r2", "ref": "legacy", "action": "block", "filter": {"id": "f2", "expression": "(ip.src eq 192.0.2.1)"}}
+				]
+			}`)
+		case "PUT":
+			var rules []FirewallRule
+			if assert.NoError(t, json.NewDecoder(r.Body).Decode(&rules)) && assert.Len(t, rules, 1) {
+				assert.Equal(t, "r1", rules[0].ID)
+				assert.Equal(t, "f1", rules[0].Filter.ID)
+				assert.Equal(t, "block", rules[0].Action)
+			}
+			fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": []}`)
+		case "POST":
+			var rules []FirewallRule
+			if assert.NoError(t, json.NewDecoder(r.Body).Decode(&rules)) && assert.Len(t, rules, 1) {
+				assert.Equal(t, "admin", rules[0].Ref)
+				assert.Empty(t, rules[0].Filter.ID)
+			}
+			fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": []}`)
+		case "DELETE":
+			assert.Equal(t, []string{"r2"}, r.URL.Query()["id"])
+			fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": []}`)
+		}
+	})
+	mux.HandleFunc("/zones/foo/filters", func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" /filters")
+		if r.Method == "DELETE" {
+			assert.Equal(t, []string{"f2"}, r.URL.Query()["id"])
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": []}`)
+	})
+
+	desired := []FirewallRule{
+		{Description: "bots", Action: "block", Filter: Filter{Expression: "(cf.client.bot)"}},
+		{Ref: "admin", Description: "Protect admin", Action: "challenge", Filter: Filter{Expression: `(http.request.uri.path contains "/admin")`}},
+	}
+
+	changes, err := client.SyncFirewallRules("foo", desired, FirewallSyncOptions{DryRun: true})
+	if assert.NoError(t, err) && assert.Len(t, changes, 2) {
+		assert.Equal(t, "update firewall_rule bots (r1)", changes[0].String())
+		assert.Equal(t, "create firewall_rule admin", changes[1].String())
+	}
+	assert.Equal(t, []string{"GET /firewall/rules"}, requests)
+
+	requests = nil
+	changes, err = client.SyncFirewallRules("foo", desired, FirewallSyncOptions{AllowDeletes: true})
+	assert.NoError(t, err)
+	assert.Len(t, changes, 3)
+	assert.Equal(t, []string{
+		"GET /firewall/rules",
+		"DELETE /firewall/rules",
+		"DELETE /filters",
+		"PUT /filters",
+		"PUT /firewall/rules",
+		"POST /firewall/rules",
+	}, requests)
+
+	_, err = client.SyncFirewallRules("foo", []FirewallRule{{Action: "block"}}, FirewallSyncOptions{DryRun: true})
+	assert.Error(t, err)
+}
+
+func TestSyncFirewallRulesPartialFailure(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/foo/firewall/rules", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		switch r.Method {
+		case "GET":
+			fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": [
+				{"id": "r1", "description": "bots", "action": "challenge", "filter": {"id": "f1", "expression": "(cf.client.bot)"}}
+			]}`)
+		case "POST":
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, `{"success": false, "errors": [{"code": 10000, "message": "bad expression"}], "messages": [], "result": null}`)
+		default:
+			fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": []}`)
+		}
+	})
+	mux.HandleFunc("/zones/foo/filters", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": []}`)
+	})
+
+	// The update was made before the create failed.
+	changes, err := client.SyncFirewallRules("foo", []FirewallRule{
+		{Description: "bots", Action: "block", Filter: Filter{Expression: "(cf.client.bot)"}},
+		{Description: "new", Action: "block", Filter: Filter{Expression: "(bad"}},
+	}, FirewallSyncOptions{})
+	assert.Error(t, err)
+	if assert.Len(t, changes, 1) {
+		assert.Equal(t, "update firewall_rule bots (r1)", changes[0].String())
+	}
+}
+
+func TestSyncZoneAccessRulesInherited(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/foo/firewall/access_rules/rules", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": [
+			{"id": "a1", "mode": "block", "configuration": {"target": "ip", "value": "192.0.2.1"}, "scope": {"id": "foo", "type": "zone"}},
+			{"id": "a2", "mode": "block", "configuration": {"target": "ip", "value": "192.0.2.2"}, "scope": {"id": "u1", "email": "user@example.com", "type": "user"}},
+			{"id": "a3", "mode": "challenge", "configuration": {"target": "country", "value": "XX"}, "scope": {"id": "o1", "name": "Org", "type": "organization"}}
+		], "result_info": {"page": 1, "per_page": 50, "count": 3, "total_count": 3, "total_pages": 1}}`)
+	})
+
+	// Inherited rules are neither deleted nor updated.
+	changes, err := client.SyncZoneAccessRules("foo", []AccessRule{
+		{Mode: "whitelist", Configuration: AccessRuleConfiguration{Target: "country", Value: "XX"}},
+	}, FirewallSyncOptions{AllowDeletes: true, DryRun: true})
+	if assert.NoError(t, err) && assert.Len(t, changes, 2) {
+		assert.Equal(t, ZoneConfigDelete, changes[0].Action)
+		assert.Equal(t, "a1", changes[0].ID)
+		assert.Equal(t, ZoneConfigCreate, changes[1].Action)
+	}
+}
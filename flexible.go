@@ -0,0 +1,56 @@
+package cloudflare
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// FlexibleInt is an integer which the API encodes either as a JSON number or
+// as a string holding one, depending on the endpoint and request. It decodes
+// from either form, and from null as zero, and always encodes as a number.
+type FlexibleInt int
+
+// MaybeInt is the former name of FlexibleInt.
+//
+// Deprecated: use FlexibleInt.
+type MaybeInt = FlexibleInt
+
+// MarshalJSON encodes the integer as a JSON number.
+func (f FlexibleInt) MarshalJSON() ([]byte, error) {
+	return []byte(strconv.Itoa(int(f))), nil
+}
+
+// UnmarshalJSON decodes a JSON number, a string holding one, or null.
+func (f *FlexibleInt) UnmarshalJSON(data []byte) error {
+	s, err := unquoteFlexible(data)
+	if err != nil {
+		return err
+	}
+	if s == "" || s == "null" {
+		*f = 0
+		return nil
+	}
+	v, err := strconv.Atoi(s)
+	if err != nil {
+		return errors.Errorf("cannot decode %s as an integer", data)
+	}
+	*f = FlexibleInt(v)
+	return nil
+}
+
+// unquoteFlexible returns the contents of a JSON string, or any other JSON
+// value unchanged, with surrounding space removed.
+func unquoteFlexible(data []byte) (string, error) {
+	data = []byte(strings.TrimSpace(string(data)))
+	if len(data) > 0 && data[0] == '"' {
+		var s string
+		if err := json.Unmarshal(data, &s); err != nil {
+			return "", errors.Wrap(err, errUnmarshalError)
+		}
+		return strings.TrimSpace(s), nil
+	}
+	return string(data), nil
+}
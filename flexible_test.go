@@ -0,0 +1,42 @@
+package cloudflare
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFlexibleInt(t *testing.T) {
+	for data, want := range map[string]FlexibleInt{
+		`3`:     3,
+		`"3"`:   3,
+		`" 3 "`: 3,
+		`null`:  0,
+		`""`:    0,
+		`-1`:    -1,
+	} {
+		var v FlexibleInt
+		if assert.NoError(t, json.Unmarshal([]byte(data), &v), data) {
+			assert.Equal(t, want, v, data)
+		}
+	}
+
+	var v FlexibleInt
+	assert.Error(t, json.Unmarshal([]byte(`"three"`), &v))
+	assert.Error(t, json.Unmarshal([]byte(`1.5`), &v))
+
+	b, err := json.Marshal(PageRule{Priority: 2})
+	if assert.NoError(t, err) {
+		var r PageRule
+		assert.NoError(t, json.Unmarshal(b, &r))
+		assert.Equal(t, FlexibleInt(2), r.Priority)
+		assert.Contains(t, string(b), `"priority":2`)
+	}
+
+	// A zero priority is left out, so that updates keep the existing one.
+	b, err = json.Marshal(testPageRule)
+	if assert.NoError(t, err) {
+		assert.NotContains(t, string(b), `"priority"`)
+	}
+}
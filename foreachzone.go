@@ -0,0 +1,114 @@
+package cloudflare
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// ForEachZoneOptions controls the behaviour of ForEachZone.
+type ForEachZoneOptions struct {
+	// Names limits the zones to those with the given names. All zones are
+	// used if it is empty.
+	Names []string
+	// Filter, if set, is called for each zone and zones for which it returns
+	// false are skipped.
+	Filter func(Zone) bool
+	// Concurrency is the maximum number of zones processed at once. It
+	// defaults to 1.
+	Concurrency int
+	// Interval is the minimum time between starting the callback for one zone
+	// and the next, shared across all workers, to keep bulk changes within
+	// the API's rate limits.
+	Interval time.Duration
+}
+
+// ZoneError records the failure of a ForEachZone callback for a zone.
+type ZoneError struct {
+	Zone Zone
+	Err  error
+}
+
+// ForEachZoneError is returned by ForEachZone when the callback failed for one
+// or more zones. The failures are ordered by zone name.
+type ForEachZoneError struct {
+	Errors []ZoneError
+	// Total is the number of zones the callback was run for.
+	Total int
+}
+
+func (e *ForEachZoneError) Error() string {
+	msgs := make([]string, len(e.Errors))
+	for i, ze := range e.Errors {
+		msgs[i] = ze.Zone.Name + ": " + ze.Err.Error()
+	}
+	return fmt.Sprintf("%d of %d zones failed: %s", len(e.Errors), e.Total, strings.Join(msgs, "; "))
+}
+
+// ForEachZone lists zones and calls fn for each of them, with bounded
+// concurrency and rate. Every zone is processed even if fn fails for some; if
+// any do fail, a *ForEachZoneError describing all of the failures is returned.
+func (api *API) ForEachZone(opts ForEachZoneOptions, fn func(Zone) error) error {
+	zones, err := api.ListZones(opts.Names...)
+	if err != nil {
+		return errors.Wrap(err, "could not list zones")
+	}
+	if opts.Filter != nil {
+		var filtered []Zone
+		for _, z := range zones {
+			if opts.Filter(z) {
+				filtered = append(filtered, z)
+			}
+		}
+		zones = filtered
+	}
+
+	concurrency := opts.Concurrency
+	if concurrency < 1 {
+		concurrency = 1
+	}
+	var tick <-chan time.Time
+	if opts.Interval > 0 {
+		ticker := time.NewTicker(opts.Interval)
+		defer ticker.Stop()
+		tick = ticker.C
+	}
+
+	work := make(chan Zone)
+	var mu sync.Mutex
+	var failures []ZoneError
+	var wg sync.WaitGroup
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for z := range work {
+				if err := fn(z); err != nil {
+					mu.Lock()
+					failures = append(failures, ZoneError{Zone: z, Err: err})
+					mu.Unlock()
+				}
+			}
+		}()
+	}
+	for i, z := range zones {
+		if tick != nil && i > 0 {
+			<-tick
+		}
+		work <- z
+	}
+	close(work)
+	wg.Wait()
+
+	if len(failures) == 0 {
+		return nil
+	}
+	sort.Slice(failures, func(i, j int) bool {
+		return failures[i].Zone.Name < failures[j].Zone.Name
+	})
+	return &ForEachZoneError{Errors: failures, Total: len(zones)}
+}
@@ -0,0 +1,69 @@
+package cloudflare
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestForEachZone(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		w.Header().Set("content-type", "application/json")
+		switch r.URL.Query().Get("page") {
+		case "1":
+			fmt.Fprint(w, `{
+				"success": true, "errors": [], "messages": [],
+				"result": [{"id": "1", "name": "a.example"}, {"id": "2", "name": "b.example"}],
+				"result_info": {"page": 1, "per_page": 2, "total_count": 3}
+			}`)
+		case "2":
+			fmt.Fprint(w, `{
+				"success": true, "errors": [], "messages": [],
+				"result": [{"id": "3", "name": "c.example", "paused": true}],
+				"result_info": {"page": 2, "per_page": 2, "total_count": 3}
+			}`)
+		default:
+			t.Errorf("unexpected page %q", r.URL.Query().Get("page"))
+		}
+	})
+
+	var mu sync.Mutex
+	var seen []string
+	err := client.ForEachZone(ForEachZoneOptions{Concurrency: 2}, func(z Zone) error {
+		mu.Lock()
+		seen = append(seen, z.ID)
+		mu.Unlock()
+		if z.ID != "1" {
+			return errors.New("boom")
+		}
+		return nil
+	})
+	assert.ElementsMatch(t, []string{"1", "2", "3"}, seen)
+	if assert.IsType(t, &ForEachZoneError{}, err) {
+		fe := err.(*ForEachZoneError)
+		assert.Equal(t, 3, fe.Total)
+		if assert.Len(t, fe.Errors, 2) {
+			assert.Equal(t, "b.example", fe.Errors[0].Zone.Name)
+			assert.Equal(t, "c.example", fe.Errors[1].Zone.Name)
+		}
+		assert.Equal(t, "2 of 3 zones failed: b.example: boom; c.example: boom", err.Error())
+	}
+
+	seen = nil
+	err = client.ForEachZone(ForEachZoneOptions{
+		Filter: func(z Zone) bool { return !z.Paused },
+	}, func(z Zone) error {
+		seen = append(seen, z.ID)
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"1", "2"}, seen)
+}
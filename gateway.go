@@ -0,0 +1,49 @@
+package cloudflare
+
+import "time"
+
+// GatewayCategory is a Gateway content category, used in rule expressions
+// such as `any(dns.content_category[*] in {7})`.
+type GatewayCategory struct {
+	ID            int               `json:"id"`
+	Name          string            `json:"name"`
+	Description   string            `json:"description"`
+	Class         string            `json:"class"`
+	Beta          bool              `json:"beta"`
+	Subcategories []GatewayCategory `json:"subcategories,omitempty"`
+}
+
+// GatewayAppType is an application or application type which can be matched
+// by Gateway rules. Applications have the ID of their type as
+// ApplicationTypeID; application types have it as zero.
+type GatewayAppType struct {
+	ID                int        `json:"id"`
+	Name              string     `json:"name"`
+	Description       string     `json:"description,omitempty"`
+	ApplicationTypeID int        `json:"application_type_id,omitempty"`
+	CreatedAt         *time.Time `json:"created_at,omitempty"`
+}
+
+// GatewayCategories lists the Gateway content categories and their
+// subcategories.
+//
+// API reference: https://developers.cloudflare.com/api/operations/zero-trust-gateway-categories-list-categories
+func (api *API) GatewayCategories(accountID string) ([]GatewayCategory, error) {
+	var categories []GatewayCategory
+	if _, err := api.makeRequestResult("GET", "/accounts/"+accountID+"/gateway/categories", nil, &categories); err != nil {
+		return nil, err
+	}
+	return categories, nil
+}
+
+// GatewayAppTypes lists the applications and application types which Gateway
+// can identify.
+//
+// API reference: https://developers.cloudflare.com/api/operations/zero-trust-gateway-application-and-application-type-mappings-list-application-and-application-type-mappings
+func (api *API) GatewayAppTypes(accountID string) ([]GatewayAppType, error) {
+	var appTypes []GatewayAppType
+	if _, err := api.makeRequestResult("GET", "/accounts/"+accountID+"/gateway/app_types", nil, &appTypes); err != nil {
+		return nil, err
+	}
+	return appTypes, nil
+}
@@ -0,0 +1,67 @@
+package cloudflare
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGatewayCategories(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/accounts/acc/gateway/categories", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": [
+				{
+					"id": 1,
+					"name": "Ads",
+					"description": "Sites that serve ads",
+					"class": "free",
+					"beta": false,
+					"subcategories": [{"id": 66, "name": "Advertisements", "class": "free"}]
+				}
+			]
+		}`)
+	})
+
+	categories, err := client.GatewayCategories("acc")
+	if assert.NoError(t, err) && assert.Len(t, categories, 1) {
+		assert.Equal(t, 1, categories[0].ID)
+		assert.Equal(t, "Ads", categories[0].Name)
+		assert.Equal(t, []GatewayCategory{{ID: 66, Name: "Advertisements", Class: "free"}}, categories[0].Subcategories)
+	}
+}
+
+func TestGatewayAppTypes(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/accounts/acc/gateway/app_types", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": [
+				{"id": 16, "name": "File Sharing", "description": "Applications used to share files"},
+				{"id": 511, "name": "Dropbox", "application_type_id": 16}
+			]
+		}`)
+	})
+
+	appTypes, err := client.GatewayAppTypes("acc")
+	if assert.NoError(t, err) && assert.Len(t, appTypes, 2) {
+		assert.Equal(t, "File Sharing", appTypes[0].Name)
+		assert.Equal(t, 0, appTypes[0].ApplicationTypeID)
+		assert.Equal(t, 16, appTypes[1].ApplicationTypeID)
+	}
+}
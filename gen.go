@@ -0,0 +1,341 @@
+//go:build ignore
+// +build ignore
+
+// This program generates interface.go, containing the Client interface
+// implemented by *API, and cloudflarefake/fake.go, containing a fake
+// implementation of Client for use in unit tests. It is invoked by running
+// go generate in the package directory.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	interfaceFile = "interface.go"
+	fakeFile      = "cloudflarefake/fake.go"
+	pkgPath       = "github.com/cloudflare/cloudflare-go"
+)
+
+// excluded holds the methods of *API left out of the Client interface. With
+// returns a derived *API, and Account and Zone clients wrapping one, which a
+// fake could not provide.
+var excluded = map[string]bool{
+	"With":    true,
+	"Account": true,
+	"Zone":    true,
+}
+
+// qfset holds the positions of the qualified copies of method signatures
+// created for the fake package.
+var qfset = token.NewFileSet()
+
+// method is an exported method with a *API receiver.
+type method struct {
+	name    string
+	typ     *ast.FuncType
+	imports map[string]string // package name -> import path, for the file declaring the method
+}
+
+func main() {
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, ".", func(fi os.FileInfo) bool {
+		name := fi.Name()
+		return !strings.HasSuffix(name, "_test.go") && name != interfaceFile && name != "gen.go"
+	}, 0)
+	if err != nil {
+		log.Fatal(err)
+	}
+	pkg, ok := pkgs["cloudflare"]
+	if !ok {
+		log.Fatal("package cloudflare not found")
+	}
+
+	// Collect the exported package-level type names so that they can be
+	// qualified in the fake package.
+	types := make(map[string]bool)
+	var methods []method
+	for _, f := range pkg.Files {
+		imports := make(map[string]string)
+		for _, imp := range f.Imports {
+			path, _ := strconv.Unquote(imp.Path.Value)
+			name := filepath.Base(path)
+			if imp.Name != nil {
+				name = imp.Name.Name
+			}
+			imports[name] = path
+		}
+		for _, decl := range f.Decls {
+			switch d := decl.(type) {
+			case *ast.GenDecl:
+				if d.Tok != token.TYPE {
+					continue
+				}
+				for _, spec := range d.Specs {
+					ts := spec.(*ast.TypeSpec)
+					if ts.Name.IsExported() {
+						types[ts.Name.Name] = true
+					}
+				}
+			case *ast.FuncDecl:
+				if d.Recv == nil || !d.Name.IsExported() || !isAPIReceiver(d.Recv) || excluded[d.Name.Name] {
+					continue
+				}
+				methods = append(methods, method{name: d.Name.Name, typ: d.Type, imports: imports})
+			}
+		}
+	}
+	sort.Slice(methods, func(i, j int) bool { return methods[i].name < methods[j].name })
+
+	write(interfaceFile, genInterface(fset, methods))
+	write(fakeFile, genFake(fset, methods, types))
+}
+
+func isAPIReceiver(recv *ast.FieldList) bool {
+	if len(recv.List) != 1 {
+		return false
+	}
+	star, ok := recv.List[0].Type.(*ast.StarExpr)
+	if !ok {
+		return false
+	}
+	ident, ok := star.X.(*ast.Ident)
+	return ok && ident.Name == "API"
+}
+
+func write(filename string, src []byte) {
+	out, err := format.Source(src)
+	if err != nil {
+		log.Fatalf("formatting %s: %v\n%s", filename, err, src)
+	}
+	if err := ioutil.WriteFile(filename, out, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// usedImports returns the import paths referenced by the given methods'
+// signatures.
+func usedImports(methods []method) []string {
+	seen := make(map[string]bool)
+	for _, m := range methods {
+		ast.Inspect(m.typ, func(n ast.Node) bool {
+			if sel, ok := n.(*ast.SelectorExpr); ok {
+				if x, ok := sel.X.(*ast.Ident); ok {
+					if path, ok := m.imports[x.Name]; ok {
+						seen[path] = true
+					}
+				}
+			}
+			return true
+		})
+	}
+	var paths []string
+	for path := range seen {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	return paths
+}
+
+func genInterface(fset *token.FileSet, methods []method) []byte {
+	var buf bytes.Buffer
+	fmt.Fprintln(&buf, "// Code generated by gen.go; DO NOT EDIT.")
+	fmt.Fprintln(&buf)
+	fmt.Fprintln(&buf, "package cloudflare")
+	fmt.Fprintln(&buf)
+	if imports := usedImports(methods); len(imports) > 0 {
+		fmt.Fprintln(&buf, "import (")
+		for _, path := range imports {
+			fmt.Fprintf(&buf, "\t%q\n", path)
+		}
+		fmt.Fprintln(&buf, ")")
+		fmt.Fprintln(&buf)
+	}
+	fmt.Fprintln(&buf, "// Client is the set of methods implemented by *API. Code which accepts a")
+	fmt.Fprintln(&buf, "// Client rather than an *API can be unit tested with a fake implementation,")
+	fmt.Fprintln(&buf, "// such as the one provided by the cloudflarefake package.")
+	fmt.Fprintln(&buf, "type Client interface {")
+	for _, m := range methods {
+		fmt.Fprintf(&buf, "\t%s%s\n", m.name, strings.TrimPrefix(node(fset, m.typ), "func"))
+	}
+	fmt.Fprintln(&buf, "}")
+	fmt.Fprintln(&buf)
+	fmt.Fprintln(&buf, "var _ Client = &API{}")
+	return buf.Bytes()
+}
+
+func genFake(fset *token.FileSet, methods []method, types map[string]bool) []byte {
+	var buf bytes.Buffer
+	fmt.Fprintln(&buf, "// Code generated by gen.go; DO NOT EDIT.")
+	fmt.Fprintln(&buf)
+	fmt.Fprintln(&buf, "// Package cloudflarefake provides a fake implementation of cloudflare.Client")
+	fmt.Fprintln(&buf, "// for use in unit tests.")
+	fmt.Fprintln(&buf, "package cloudflarefake")
+	fmt.Fprintln(&buf)
+	fmt.Fprintln(&buf, "import (")
+	fmt.Fprintln(&buf, "\t\"fmt\"")
+	for _, path := range usedImports(methods) {
+		if path != "fmt" {
+			fmt.Fprintf(&buf, "\t%q\n", path)
+		}
+	}
+	fmt.Fprintln(&buf)
+	fmt.Fprintf(&buf, "\t%q\n", pkgPath)
+	fmt.Fprintln(&buf, ")")
+	fmt.Fprintln(&buf)
+	fmt.Fprintln(&buf, "// Fake implements cloudflare.Client. Each method calls the function in the")
+	fmt.Fprintln(&buf, "// correspondingly named Func field if it is set, and otherwise returns zero")
+	fmt.Fprintln(&buf, "// values along with a not-implemented error (if the method returns an error).")
+	fmt.Fprintln(&buf, "type Fake struct {")
+	for _, m := range methods {
+		fmt.Fprintf(&buf, "\t%sFunc %s\n", m.name, node(qfset, qualify(fset, m.typ, types)))
+	}
+	fmt.Fprintln(&buf, "}")
+	fmt.Fprintln(&buf)
+	fmt.Fprintln(&buf, "var _ cloudflare.Client = &Fake{}")
+
+	for _, m := range methods {
+		typ := qualify(fset, m.typ, types)
+		args := nameParams(typ)
+		sig := strings.TrimPrefix(node(qfset, typ), "func")
+		fmt.Fprintln(&buf)
+		fmt.Fprintf(&buf, "// %s calls f.%sFunc.\n", m.name, m.name)
+		fmt.Fprintf(&buf, "func (f *Fake) %s%s {\n", m.name, sig)
+		fmt.Fprintf(&buf, "\tif f.%sFunc != nil {\n", m.name)
+		call := fmt.Sprintf("f.%sFunc(%s)", m.name, strings.Join(args, ", "))
+		if typ.Results == nil || len(typ.Results.List) == 0 {
+			fmt.Fprintf(&buf, "\t\t%s\n\t\treturn\n\t}\n}\n", call)
+			continue
+		}
+		fmt.Fprintf(&buf, "\t\treturn %s\n\t}\n", call)
+		var zeros []string
+		for _, r := range typ.Results.List {
+			n := len(r.Names)
+			if n == 0 {
+				n = 1
+			}
+			for i := 0; i < n; i++ {
+				zeros = append(zeros, zero(qfset, r.Type, m.name))
+			}
+		}
+		fmt.Fprintf(&buf, "\treturn %s\n}\n", strings.Join(zeros, ", "))
+	}
+	return buf.Bytes()
+}
+
+// nameParams ensures every parameter of typ is named so that it can be passed
+// through to the Func field, returning the corresponding call arguments.
+func nameParams(typ *ast.FuncType) (args []string) {
+	i := 0
+	for _, field := range typ.Params.List {
+		if len(field.Names) == 0 {
+			field.Names = []*ast.Ident{ast.NewIdent(fmt.Sprintf("p%d", i))}
+		}
+		for _, name := range field.Names {
+			if name.Name == "_" {
+				name.Name = fmt.Sprintf("p%d", i)
+			}
+			arg := name.Name
+			if _, ok := field.Type.(*ast.Ellipsis); ok {
+				arg += "..."
+			}
+			args = append(args, arg)
+			i++
+		}
+	}
+	return args
+}
+
+// zero returns an expression for the zero value of the type expression t. The
+// error type is given a not-implemented error naming the method.
+func zero(fset *token.FileSet, t ast.Expr, name string) string {
+	switch t := t.(type) {
+	case *ast.Ident:
+		switch t.Name {
+		case "error":
+			return fmt.Sprintf("fmt.Errorf(\"cloudflarefake: %s not implemented\")", name)
+		case "string":
+			return `""`
+		case "bool":
+			return "false"
+		case "int", "int8", "int16", "int32", "int64",
+			"uint", "uint8", "uint16", "uint32", "uint64", "uintptr",
+			"float32", "float64", "byte", "rune":
+			return "0"
+		}
+	case *ast.StarExpr, *ast.ArrayType, *ast.MapType, *ast.ChanType, *ast.FuncType, *ast.InterfaceType:
+		if at, ok := t.(*ast.ArrayType); ok && at.Len != nil {
+			break
+		}
+		return "nil"
+	}
+	return node(fset, t) + "{}"
+}
+
+// qualify returns a copy of typ in which references to exported types of the
+// cloudflare package are qualified with the package name. The copy has its
+// own positions, so it must be printed using qfset.
+func qualify(fset *token.FileSet, typ *ast.FuncType, types map[string]bool) *ast.FuncType {
+	expr, err := parser.ParseExprFrom(qfset, "", node(fset, typ), 0)
+	if err != nil {
+		log.Fatal(err)
+	}
+	cp := expr.(*ast.FuncType)
+	var rewrite func(e ast.Expr) ast.Expr
+	rewrite = func(e ast.Expr) ast.Expr {
+		switch e := e.(type) {
+		case *ast.Ident:
+			if types[e.Name] {
+				return &ast.SelectorExpr{X: ast.NewIdent("cloudflare"), Sel: ast.NewIdent(e.Name)}
+			}
+		case *ast.StarExpr:
+			e.X = rewrite(e.X)
+		case *ast.ArrayType:
+			e.Elt = rewrite(e.Elt)
+		case *ast.Ellipsis:
+			e.Elt = rewrite(e.Elt)
+		case *ast.MapType:
+			e.Key = rewrite(e.Key)
+			e.Value = rewrite(e.Value)
+		case *ast.ChanType:
+			e.Value = rewrite(e.Value)
+		case *ast.FuncType:
+			rewriteFields(e.Params, rewrite)
+			rewriteFields(e.Results, rewrite)
+		}
+		return e
+	}
+	rewriteFields(cp.Params, rewrite)
+	rewriteFields(cp.Results, rewrite)
+	return cp
+}
+
+func rewriteFields(fl *ast.FieldList, rewrite func(ast.Expr) ast.Expr) {
+	if fl == nil {
+		return
+	}
+	for _, f := range fl.List {
+		f.Type = rewrite(f.Type)
+	}
+}
+
+func node(fset *token.FileSet, n ast.Node) string {
+	var buf bytes.Buffer
+	if err := printer.Fprint(&buf, fset, n); err != nil {
+		log.Fatal(err)
+	}
+	return buf.String()
+}
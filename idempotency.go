@@ -0,0 +1,88 @@
+package cloudflare
+
+import (
+	"net"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// VerifyCreates makes methods which create objects, when it is unknown whether
+// the API acted on their request (see UnknownOutcomeError), read back the
+// zone's objects and return a matching one if it was created. Otherwise the
+// request is repeated according to the client's retry policy. Objects are
+// matched as follows:
+//
+//   - CreatePageRule: a page rule with the same targets and actions.
+//   - CreateDNSRecord: a record with the same type, name and content. Names
+//     must be fully qualified to match.
+//
+// A matching object which existed before the call is indistinguishable from
+// one it created, so this should only be used where duplicates are not wanted
+// anyway.
+func VerifyCreates() Option {
+	return func(api *API) error {
+		api.verifyCreates = true
+		return nil
+	}
+}
+
+// idempotent reports whether a request with the given method may be repeated
+// without changing its effect.
+func idempotent(method string) bool {
+	return method != "POST"
+}
+
+// unknownOutcome returns the error for a request which failed to complete
+// with err, which is an *UnknownOutcomeError if the request was not
+// idempotent and may have been sent.
+func unknownOutcome(method, uri string, err error) error {
+	if idempotent(method) {
+		return err
+	}
+	// Failing to connect means nothing was sent.
+	cause := err
+	if u, ok := cause.(*url.Error); ok {
+		cause = u.Err
+	}
+	if opErr, ok := cause.(*net.OpError); ok && opErr.Op == "dial" {
+		return err
+	}
+	return &UnknownOutcomeError{Method: method, URI: uri, Err: err}
+}
+
+// createVerified makes a request creating an object with create. If its
+// outcome is unknown and the client verifies creates, find is used to look for
+// the object, and the request is repeated if it was not found.
+func (api *API) createVerified(create func() error, find func() (bool, error)) error {
+	for attempt := 1; ; attempt++ {
+		err := create()
+		if err == nil || !api.verifyCreates || !IsUnknownOutcome(err) {
+			return err
+		}
+		found, findErr := find()
+		if findErr != nil {
+			return err
+		}
+		if found {
+			return nil
+		}
+		delay, retry := api.retryPolicy.retryDelay(attempt, nil, err)
+		if !retry {
+			return err
+		}
+		time.Sleep(delay)
+	}
+}
+
+// retrySafe reports whether a failed request may be repeated, which requests
+// which are not idempotent only may if they cannot have been acted on.
+func retrySafe(method string, resp *http.Response, err error) bool {
+	if idempotent(method) {
+		return true
+	}
+	if err != nil {
+		return !IsUnknownOutcome(err) && !IsAPIUnavailable(err)
+	}
+	return resp.StatusCode == http.StatusTooManyRequests
+}
@@ -0,0 +1,124 @@
+package cloudflare
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// dropConnection closes the connection of a request without responding, as
+// when the network fails after a request was sent.
+func dropConnection(t *testing.T, w http.ResponseWriter) {
+	conn, _, err := w.(http.Hijacker).Hijack()
+	if assert.NoError(t, err) {
+		conn.Close()
+	}
+}
+
+// pageRuleServer handles page rule requests, dropping the connection of the
+// first create after applying it if applied is true, or before if not. The
+// number of creates is counted in creates.
+func pageRuleServer(t *testing.T, applied bool) (creates *int32) {
+	creates = new(int32)
+	var mu sync.Mutex
+	var rules []string
+	const rule = `{"id": "r1", "targets": [{"target": "url", "constraint": {"operator": "matches", "value": "*example.com/*"}}], "actions": [{"id": "always_online", "value": "on"}], "status": "active", "priority": 1}`
+	mux.HandleFunc("/zones/z1/pagerules", func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		if r.Method == "GET" {
+			w.Header().Set("content-type", "application/json")
+			fmt.Fprintf(w, `{"success": true, "errors": [], "messages": [], "result": [%s]}`, strings.Join(rules, ","))
+			return
+		}
+		if atomic.AddInt32(creates, 1) == 1 {
+			if applied {
+				rules = append(rules, rule)
+			}
+			dropConnection(t, w)
+			return
+		}
+		rules = append(rules, rule)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{"success": true, "errors": [], "messages": [], "result": %s}`, rule)
+	})
+	return creates
+}
+
+var testPageRule = PageRule{
+	Targets: []PageRuleTarget{{Target: "url", Constraint: struct {
+		Operator string `json:"operator"`
+		Value    string `json:"value"`
+	}{Operator: "matches", Value: "*example.com/*"}}},
+	Actions: []PageRuleAction{{ID: "always_online", Value: "on"}},
+	Status:  "active",
+}
+
+func TestUnknownOutcome(t *testing.T) {
+	setup()
+	defer teardown()
+
+	creates := pageRuleServer(t, true)
+	client.retryPolicy = RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond, MaxDelay: time.Millisecond}
+
+	// The create is not retried, since it may have been applied.
+	_, err := client.CreatePageRule("z1", testPageRule)
+	assert.True(t, IsUnknownOutcome(err), "%v", err)
+	assert.Equal(t, int32(1), atomic.LoadInt32(creates))
+}
+
+func TestVerifyCreates(t *testing.T) {
+	setup()
+	defer teardown()
+
+	creates := pageRuleServer(t, true)
+	client.retryPolicy = RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond, MaxDelay: time.Millisecond}
+	assert.NoError(t, VerifyCreates()(client))
+
+	rule, err := client.CreatePageRule("z1", testPageRule)
+	if assert.NoError(t, err) {
+		assert.Equal(t, "r1", rule.ID)
+	}
+	assert.Equal(t, int32(1), atomic.LoadInt32(creates))
+}
+
+func TestVerifyCreatesNotApplied(t *testing.T) {
+	setup()
+	defer teardown()
+
+	creates := pageRuleServer(t, false)
+	client.retryPolicy = RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond, MaxDelay: time.Millisecond}
+	assert.NoError(t, VerifyCreates()(client))
+
+	rule, err := client.CreatePageRule("z1", testPageRule)
+	if assert.NoError(t, err) {
+		assert.Equal(t, "r1", rule.ID)
+	}
+	assert.Equal(t, int32(2), atomic.LoadInt32(creates))
+}
+
+func TestUnknownOutcomeTruncatedResponse(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var creates int32
+	mux.HandleFunc("/zones/z1/pagerules", func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&creates, 1)
+		w.Header().Set("content-type", "application/json")
+		w.Header().Set("content-length", "1000")
+		fmt.Fprint(w, `{"success": true, "errors": [], `)
+	})
+	client.retryPolicy = RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond, MaxDelay: time.Millisecond}
+
+	// The create is not retried, since the response was lost after the
+	// request was sent.
+	_, err := client.CreatePageRule("z1", testPageRule)
+	assert.True(t, IsUnknownOutcome(err), "%v", err)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&creates))
+}
@@ -0,0 +1,143 @@
+package cloudflare
+
+import (
+	"bytes"
+	"encoding/json"
+	"mime/multipart"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Image is an image stored in Cloudflare Images.
+type Image struct {
+	ID                string                 `json:"id"`
+	Filename          string                 `json:"filename"`
+	Metadata          map[string]interface{} `json:"meta,omitempty"`
+	RequireSignedURLs bool                   `json:"requireSignedURLs"`
+	Variants          []string               `json:"variants"`
+	Uploaded          time.Time              `json:"uploaded"`
+}
+
+// ImageDirectUploadOptions are the options for a direct creator upload URL.
+type ImageDirectUploadOptions struct {
+	// ID is the custom ID of the image to be uploaded. If empty, an ID is
+	// generated.
+	ID string
+	// Metadata is stored with the uploaded image.
+	Metadata map[string]interface{}
+	// RequireSignedURLs requires the image to be served with signed URLs.
+	RequireSignedURLs bool
+	// Expiry is when the upload URL stops being valid. If zero, the API's
+	// default of 30 minutes is used.
+	Expiry time.Time
+}
+
+// ImageDirectUpload is a one-time URL to which an end user can upload an image
+// without credentials.
+type ImageDirectUpload struct {
+	ID        string `json:"id"`
+	UploadURL string `json:"uploadURL"`
+}
+
+// ImagesListOptions controls the pagination of ListImages.
+type ImagesListOptions struct {
+	// ContinuationToken is the token returned by the previous call, or empty
+	// for the first page.
+	ContinuationToken string
+	// PerPage is the number of images per page. If zero, the API's default is
+	// used.
+	PerPage int
+	// SortOrder is "asc" or "desc".
+	SortOrder string
+}
+
+// ImagesBatchToken is a token for the Images batch API, which allows uploads and
+// deletes without the rate limits of the regular API.
+type ImagesBatchToken struct {
+	Token     string    `json:"token"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+// CreateImageDirectUpload creates a one-time URL to which an image can be
+// uploaded directly, so that user-generated content need not pass through the
+// application's servers.
+//
+// API reference: https://developers.cloudflare.com/api/operations/cloudflare-images-create-authenticated-direct-upload-url-v-2
+func (api *API) CreateImageDirectUpload(accountID string, opts ImageDirectUploadOptions) (ImageDirectUpload, error) {
+	var buf bytes.Buffer
+	mw := multipart.NewWriter(&buf)
+	fields := map[string]string{
+		"requireSignedURLs": strconv.FormatBool(opts.RequireSignedURLs),
+	}
+	if opts.ID != "" {
+		fields["id"] = opts.ID
+	}
+	if opts.Metadata != nil {
+		b, err := json.Marshal(opts.Metadata)
+		if err != nil {
+			return ImageDirectUpload{}, errors.Wrap(err, "error marshalling metadata to JSON")
+		}
+		fields["metadata"] = string(b)
+	}
+	if !opts.Expiry.IsZero() {
+		fields["expiry"] = opts.Expiry.UTC().Format(time.RFC3339)
+	}
+	for k, v := range fields {
+		if err := mw.WriteField(k, v); err != nil {
+			return ImageDirectUpload{}, errors.Wrap(err, "could not create multipart body")
+		}
+	}
+	if err := mw.Close(); err != nil {
+		return ImageDirectUpload{}, errors.Wrap(err, "could not create multipart body")
+	}
+
+	var result ImageDirectUpload
+	if err := api.makeRequestBody("POST", "/accounts/"+accountID+"/images/v2/direct_upload", mw.FormDataContentType(), &buf, &result); err != nil {
+		return ImageDirectUpload{}, err
+	}
+	return result, nil
+}
+
+// ListImages returns a page of the images of an account, and the
+// continuation token for the next page, which is empty after the last page.
+//
+// API reference: https://developers.cloudflare.com/api/operations/cloudflare-images-list-images-v2
+func (api *API) ListImages(accountID string, opts ImagesListOptions) ([]Image, string, error) {
+	v := url.Values{}
+	if opts.ContinuationToken != "" {
+		v.Set("continuation_token", opts.ContinuationToken)
+	}
+	if opts.PerPage > 0 {
+		v.Set("per_page", strconv.Itoa(opts.PerPage))
+	}
+	if opts.SortOrder != "" {
+		v.Set("sort_order", opts.SortOrder)
+	}
+	uri := "/accounts/" + accountID + "/images/v2"
+	if len(v) > 0 {
+		uri += "?" + v.Encode()
+	}
+
+	var result struct {
+		Images            []Image `json:"images"`
+		ContinuationToken string  `json:"continuation_token"`
+	}
+	if _, err := api.makeRequestResult("GET", uri, nil, &result); err != nil {
+		return nil, "", err
+	}
+	return result.Images, result.ContinuationToken, nil
+}
+
+// ImagesBatchToken creates a token for the Images batch API.
+//
+// API reference: https://developers.cloudflare.com/images/upload-images/images-batch/
+func (api *API) ImagesBatchToken(accountID string) (ImagesBatchToken, error) {
+	var token ImagesBatchToken
+	if _, err := api.makeRequestResult("GET", "/accounts/"+accountID+"/images/v1/batch_token", nil, &token); err != nil {
+		return ImagesBatchToken{}, err
+	}
+	return token, nil
+}
@@ -0,0 +1,68 @@
+package cloudflare
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateImageDirectUpload(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/accounts/acc/images/v2/direct_upload", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "true", r.FormValue("requireSignedURLs"))
+		assert.Equal(t, "avatar-1", r.FormValue("id"))
+		assert.Equal(t, "2030-01-01T00:00:00Z", r.FormValue("expiry"))
+		var meta map[string]interface{}
+		if assert.NoError(t, json.Unmarshal([]byte(r.FormValue("metadata")), &meta)) {
+			assert.Equal(t, map[string]interface{}{"user": "u1"}, meta)
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"id": "avatar-1", "uploadURL": "https://upload.imagedelivery.net/abc"}}`)
+	})
+
+	up, err := client.CreateImageDirectUpload("acc", ImageDirectUploadOptions{
+		ID:                "avatar-1",
+		Metadata:          map[string]interface{}{"user": "u1"},
+		RequireSignedURLs: true,
+		Expiry:            time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC),
+	})
+	if assert.NoError(t, err) {
+		assert.Equal(t, ImageDirectUpload{ID: "avatar-1", UploadURL: "https://upload.imagedelivery.net/abc"}, up)
+	}
+}
+
+func TestListImages(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/accounts/acc/images/v2", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		w.Header().Set("content-type", "application/json")
+		switch r.URL.Query().Get("continuation_token") {
+		case "":
+			assert.Equal(t, "1", r.URL.Query().Get("per_page"))
+			fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"images": [{"id": "i1", "filename": "a.png", "variants": ["https://imagedelivery.net/x/i1/public"]}], "continuation_token": "next"}}`)
+		case "next":
+			fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"images": [{"id": "i2", "filename": "b.png"}], "continuation_token": null}}`)
+		}
+	})
+
+	images, token, err := client.ListImages("acc", ImagesListOptions{PerPage: 1})
+	if assert.NoError(t, err) && assert.Len(t, images, 1) {
+		assert.Equal(t, "i1", images[0].ID)
+		assert.Equal(t, "next", token)
+	}
+
+	images, token, err = client.ListImages("acc", ImagesListOptions{PerPage: 1, ContinuationToken: token})
+	if assert.NoError(t, err) && assert.Len(t, images, 1) {
+		assert.Equal(t, "i2", images[0].ID)
+		assert.Empty(t, token)
+	}
+}
@@ -0,0 +1,123 @@
+package cloudflare
+
+import (
+	"io"
+	"net/http"
+	"time"
+)
+
+// maxInterceptedBodySize is the most of a response body passed to
+// interceptors, so that streamed downloads are not held in memory.
+const maxInterceptedBodySize = 1 << 20
+
+// Interceptor observes, and may modify, every HTTP request the client makes,
+// including retries. Interceptors are called in the order they were added.
+type Interceptor interface {
+	// BeforeRequest is called before a request is sent, after its headers have
+	// been set. It may modify the request; returning an error aborts it. The
+	// request body, if any, can be read without consuming it through
+	// req.GetBody.
+	BeforeRequest(req *http.Request) error
+	// AfterResponse is called once the response to a request has been read,
+	// or with Err set if the request failed, including when it was aborted
+	// by this or an earlier interceptor's BeforeRequest. It is called exactly
+	// once for every call to BeforeRequest.
+	AfterResponse(res *InterceptedResponse)
+}
+
+// InterceptedResponse describes the outcome of a request for an Interceptor.
+type InterceptedResponse struct {
+	Request *http.Request
+	// Attempt counts the attempts made at the request, starting from 1; it
+	// is greater than 1 when the request is being retried.
+	Attempt    int
+	StatusCode int
+	Header     http.Header
+	// Body is the response body as read by the client, up to 1MB, with
+	// Truncated set if there was more. It is nil if Err is set.
+	Body      []byte
+	Truncated bool
+	// Latency is the time until the response headers were received.
+	Latency time.Duration
+	Err     error
+}
+
+// Intercept adds interceptors which are called for every request, e.g. to log,
+// audit or measure API calls, or to modify requests.
+func Intercept(interceptors ...Interceptor) Option {
+	return func(api *API) error {
+		api.interceptors = append(api.interceptors, interceptors...)
+		return nil
+	}
+}
+
+// interceptRequest calls the interceptors before a request is sent. If one
+// fails, those which were called, including the failing one, are called with
+// the error as the request's outcome, so that they can finish whatever their
+// BeforeRequest started (e.g. a trace span).
+func (api *API) interceptRequest(req *http.Request) error {
+	for n, i := range api.interceptors {
+		if err := i.BeforeRequest(req); err != nil {
+			res := &InterceptedResponse{Request: req, Attempt: RequestAttempt(req), Err: err}
+			for _, i := range api.interceptors[:n+1] {
+				i.AfterResponse(res)
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+// interceptResponse calls the interceptors with the outcome of a request. If
+// the request succeeded, the response body is replaced so that the
+// interceptors are called with it once it has been read and closed.
+func (api *API) interceptResponse(req *http.Request, resp *http.Response, latency time.Duration, err error) {
+	if len(api.interceptors) == 0 {
+		return
+	}
+	res := &InterceptedResponse{Request: req, Attempt: RequestAttempt(req), Latency: latency, Err: err}
+	if err != nil {
+		api.afterResponse(res)
+		return
+	}
+	res.StatusCode = resp.StatusCode
+	res.Header = resp.Header
+	resp.Body = &interceptedBody{ReadCloser: resp.Body, api: api, res: res}
+}
+
+func (api *API) afterResponse(res *InterceptedResponse) {
+	for _, i := range api.interceptors {
+		i.AfterResponse(res)
+	}
+}
+
+// interceptedBody records a response body as it is read, calling the
+// interceptors when it is closed.
+type interceptedBody struct {
+	io.ReadCloser
+	api    *API
+	res    *InterceptedResponse
+	closed bool
+}
+
+func (b *interceptedBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	if n > 0 {
+		if room := maxInterceptedBodySize - len(b.res.Body); room >= n {
+			b.res.Body = append(b.res.Body, p[:n]...)
+		} else {
+			b.res.Body = append(b.res.Body, p[:room]...)
+			b.res.Truncated = true
+		}
+	}
+	return n, err
+}
+
+func (b *interceptedBody) Close() error {
+	err := b.ReadCloser.Close()
+	if !b.closed {
+		b.closed = true
+		b.api.afterResponse(b.res)
+	}
+	return err
+}
@@ -0,0 +1,106 @@
+package cloudflare
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type recordingInterceptor struct {
+	requests  []string
+	responses []*InterceptedResponse
+	err       error
+}
+
+func (i *recordingInterceptor) BeforeRequest(req *http.Request) error {
+	var body []byte
+	if req.GetBody != nil {
+		r, _ := req.GetBody()
+		body, _ = ioutil.ReadAll(r)
+	}
+	i.requests = append(i.requests, fmt.Sprintf("%s %s %s", req.Method, req.URL.Path, body))
+	req.Header.Set("X-Audit", "yes")
+	return i.err
+}
+
+func (i *recordingInterceptor) AfterResponse(res *InterceptedResponse) {
+	i.responses = append(i.responses, res)
+}
+
+func TestInterceptor(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/z1/purge_cache", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "yes", r.Header.Get("X-Audit"))
+		body, _ := ioutil.ReadAll(r.Body)
+		assert.JSONEq(t, `{"purge_everything": true}`, string(body))
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"id": "z1"}}`)
+	})
+
+	rec := &recordingInterceptor{}
+	assert.NoError(t, Intercept(rec)(client))
+
+	_, err := client.PurgeEverything("z1")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"DELETE /zones/z1/purge_cache {\"purge_everything\":true}\n"}, rec.requests)
+	if assert.Len(t, rec.responses, 1) {
+		res := rec.responses[0]
+		assert.Equal(t, http.StatusOK, res.StatusCode)
+		assert.Equal(t, "application/json", res.Header.Get("content-type"))
+		assert.Contains(t, string(res.Body), `"id": "z1"`)
+		assert.False(t, res.Truncated)
+		assert.NoError(t, res.Err)
+		assert.Equal(t, "DELETE", res.Request.Method)
+		assert.Equal(t, 1, res.Attempt)
+	}
+
+	rec.err = errors.New("denied")
+	_, err = client.PurgeEverything("z1")
+	assert.Error(t, err)
+	if assert.Len(t, rec.responses, 2) {
+		assert.EqualError(t, rec.responses[1].Err, "denied")
+	}
+}
+
+func TestInterceptorRequestError(t *testing.T) {
+	setup()
+	rec := &recordingInterceptor{}
+	assert.NoError(t, Intercept(rec)(client))
+	teardown()
+
+	_, err := client.ZoneDetails("z1")
+	assert.Error(t, err)
+	if assert.Len(t, rec.responses, 1) {
+		assert.Error(t, rec.responses[0].Err)
+		assert.Nil(t, rec.responses[0].Body)
+	}
+}
+
+func TestInterceptorBeforeRequestError(t *testing.T) {
+	setup()
+	defer teardown()
+
+	// Any request sent would fail with a 404.
+	first, failing, last := &recordingInterceptor{}, &recordingInterceptor{err: errors.New("denied")}, &recordingInterceptor{}
+	assert.NoError(t, Intercept(first, failing, last)(client))
+
+	_, err := client.ZoneDetails("z1")
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "denied")
+	}
+	// The interceptors which were called see the request fail; the last one
+	// never saw it.
+	for _, rec := range []*recordingInterceptor{first, failing} {
+		if assert.Len(t, rec.responses, 1) {
+			assert.EqualError(t, rec.responses[0].Err, "denied")
+		}
+	}
+	assert.Empty(t, last.requests)
+	assert.Empty(t, last.responses)
+}
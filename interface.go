@@ -0,0 +1,233 @@
+// Code generated by gen.go; DO NOT EDIT.
+
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// Client is the set of methods implemented by *API. Code which accepts a
+// Client rather than an *API can be unit tested with a fake implementation,
+// such as the one provided by the cloudflarefake package.
+type Client interface {
+	AccessAuditLogs(accountID string, opts AccessAuditLogFilterOptions) ([]AccessAuditLogRecord, error)
+	AccountBillingProfile(accountID string) (BillingProfile, error)
+	AccountIDByName(name string) (string, error)
+	AccountSubscriptions(accountID string) ([]Subscription, error)
+	Accounts(name string) ([]Account, error)
+	AckQueueMessages(accountID, queueID string, acks []string, retries []QueueRetry) (QueueAckResult, error)
+	AddAddressMapMembership(accountID, addressMapID string, membership AddressMapMembership) error
+	AddIPToAddressMap(accountID, addressMapID, ip string) error
+	AddressMap(accountID, addressMapID string) (AddressMap, error)
+	AddressMaps(accountID string) ([]AddressMap, error)
+	AdvertisePrefix(accountID, prefixID string) (PrefixAdvertisementStatus, error)
+	ApplyZoneConfig(zoneID string, config ZoneConfig) (ZoneConfigPlan, error)
+	ApplyZoneConfigPlan(plan ZoneConfigPlan) error
+	AvailableZonePlans(zoneID string) ([]ZonePlan, error)
+	AvailableZoneRatePlans(zoneID string) ([]AvailableRatePlan, error)
+	CancelRegistrarDomainTransfer(accountID, domainName string) ([]RegistrarDomain, error)
+	ChangePageRule(zoneID, ruleID string, rule PageRule) (PageRule, error)
+	ConnectZoneRailgun(zoneID, railgunID string) (ZoneRailgun, error)
+	CopyPageRules(srcZoneID, dstZoneID string, opts PageRuleCopyOptions) ([]ZoneConfigChange, error)
+	CreateAccountSubscription(accountID string, sub Subscription) (Subscription, error)
+	CreateAddressMap(accountID string, addressMap AddressMap) (AddressMap, error)
+	CreateDLPProfiles(accountID string, profiles []DLPProfile) ([]DLPProfile, error)
+	CreateDNSRecord(zoneID string, rr DNSRecord) (*DNSRecordResponse, error)
+	CreateFirewallRules(zoneID string, rules []FirewallRule) ([]FirewallRule, error)
+	CreateImageDirectUpload(accountID string, opts ImageDirectUploadOptions) (ImageDirectUpload, error)
+	CreateKeyless()
+	CreateOriginCertificate(request OriginCACertificateRequest) (OriginCACertificate, error)
+	CreatePageRule(zoneID string, rule PageRule) (PageRule, error)
+	CreateRailgun(name string) (Railgun, error)
+	CreateRateLimit(zoneID string, limit ZoneRateLimit) (ZoneRateLimit, error)
+	CreateSSL(zoneID string, options ZoneCustomSSLOptions) (ZoneCustomSSL, error)
+	CreateStreamLiveInput(accountID string, input StreamLiveInput) (StreamLiveInput, error)
+	CreateStreamLiveInputOutput(accountID, inputID string, output StreamLiveInputOutput) (StreamLiveInputOutput, error)
+	CreateUserAgentRule(zoneID string, rule UserAgentRule) (UserAgentRule, error)
+	CreateVirtualDNS(v *VirtualDNS) (*VirtualDNS, error)
+	CreateWorkerDeployment(accountID, scriptName string, deployment WorkerDeployment) (WorkerDeployment, error)
+	CreateZone(name string, jumpstart bool, org Organization) (Zone, error)
+	CreateZoneAccessRule(zoneID string, rule AccessRule) (AccessRule, error)
+	CreateZoneHold(zoneID string, includeSubdomains bool) (ZoneHold, error)
+	CreateZoneLockdown(zoneID string, rule ZoneLockdown) (ZoneLockdown, error)
+	CreateZoneWithParams(params ZoneCreateParams) (Zone, error)
+	CustomErrorRules(zoneID string) ([]CustomErrorRule, error)
+	CustomPage(options CustomPageOptions, pageID string) (CustomPage, error)
+	CustomPages(options CustomPageOptions) ([]CustomPage, error)
+	DLPProfile(accountID, profileID string) (DLPProfile, error)
+	DLPProfiles(accountID string) ([]DLPProfile, error)
+	DNSRecord(zoneID, recordID string) (DNSRecord, error)
+	DNSRecords(zoneID string, rr DNSRecord) ([]DNSRecord, error)
+	DNSRecordsPage(zoneID string, rr DNSRecord, opts PaginationOptions) ([]DNSRecord, ResultInfo, error)
+	DeleteAccountSubscription(accountID, subscriptionID string) error
+	DeleteAddressMap(accountID, addressMapID string) error
+	DeleteDLPProfile(accountID, profileID string) error
+	DeleteDNSRecord(zoneID, recordID string) error
+	DeleteDNSRecords(zoneID string, ids []string) map[string]error
+	DeleteFilters(zoneID string, filterIDs []string) error
+	DeleteFirewallRules(zoneID string, ruleIDs []string) error
+	DeleteKeyless()
+	DeletePageRule(zoneID, ruleID string) error
+	DeletePageRules(zoneID string, ids []string) map[string]error
+	DeleteRailgun(railgunID string) error
+	DeleteRateLimit(zoneID, limitID string) error
+	DeleteSSL(zoneID, certificateID string) error
+	DeleteStreamLiveInput(accountID, inputID string) error
+	DeleteStreamLiveInputOutput(accountID, inputID, outputID string) error
+	DeleteStreamWebhook(accountID string) error
+	DeleteUserAgentRule(zoneID, ruleID string) error
+	DeleteVirtualDNS(virtualDNSID string) error
+	DeleteZone(zoneID string) (ZoneID, error)
+	DeleteZoneAccessRule(zoneID, ruleID string) error
+	DeleteZoneDNSSEC(zoneID string) error
+	DeleteZoneHold(zoneID string, holdAfter time.Time) (ZoneHold, error)
+	DeleteZoneLockdown(zoneID, ruleID string) error
+	Device(accountID, deviceID string) (Device, error)
+	DeviceOverrideCodes(accountID, deviceID string) (DeviceOverrideCodes, error)
+	Devices(accountID string) ([]Device, error)
+	DisableRailgun(railgunID string) (Railgun, error)
+	DisconnectZoneRailgun(zoneID, railgunID string) (ZoneRailgun, error)
+	EditZone(zoneID string, zoneOpts ZoneOptions) (Zone, error)
+	EditZoneSettings(zoneID string, settings []ZoneSetting) ([]ZoneSetting, error)
+	EnableRailgun(railgunID string) (Railgun, error)
+	EnableStreamLiveInputOutput(accountID, inputID, outputID string, enabled bool) (StreamLiveInputOutput, error)
+	EnableZoneDNSSEC(zoneID string) (ZoneDNSSEC, error)
+	ExportDNSRecords(zoneID string, w io.Writer) (int64, error)
+	ExportPageRules(zoneID string) (PageRuleExport, error)
+	ExportZone(zoneID string) (ZoneExport, error)
+	FallbackDomains(accountID, policyID string) ([]FallbackDomain, error)
+	Filters(zoneID string) ([]Filter, error)
+	FindPageRuleByTarget(zoneID, urlPattern string) (PageRule, error)
+	FirewallRules(zoneID string) ([]FirewallRule, error)
+	ForEachZone(opts ForEachZoneOptions, fn func(Zone) error) error
+	ForgetZoneIDs(zoneNames ...string)
+	GatewayAppTypes(accountID string) ([]GatewayAppType, error)
+	GatewayCategories(accountID string) ([]GatewayCategory, error)
+	GetZoneHold(zoneID string) (ZoneHold, error)
+	GetZoneSettings(zoneID string) ([]ZoneSetting, error)
+	IPs() (IPRanges, error)
+	ImagesBatchToken(accountID string) (ImagesBatchToken, error)
+	ImportDNSRecords(zoneID string, r io.Reader, size int64) (DNSImportResult, error)
+	ImportPageRules(zoneID string, doc PageRuleExport, replace bool) ([]ZoneConfigChange, error)
+	ImportZone(zoneID string, export ZoneExport) ([]ZoneImportResult, error)
+	Keyless()
+	ListAllRateLimits(zoneID string) ([]ZoneRateLimit, error)
+	ListAvailablePageRuleSettings(zoneID string) ([]PageRuleSetting, error)
+	ListImages(accountID string, opts ImagesListOptions) ([]Image, string, error)
+	ListKeyless()
+	ListPageRules(zoneID string) ([]PageRule, error)
+	ListPageRulesWithParams(zoneID string, params PageRuleListParams) ([]PageRule, error)
+	ListRailguns(options RailgunListOptions) ([]Railgun, error)
+	ListSSL(zoneID string) ([]ZoneCustomSSL, error)
+	ListUserAgentRules(zoneID string) ([]UserAgentRule, error)
+	ListVirtualDNS() ([]*VirtualDNS, error)
+	ListWAFPackages(zoneID string) ([]WAFPackage, error)
+	ListWAFRules(zoneID, packageID string) ([]WAFRule, error)
+	ListZones(z ...string) ([]Zone, error)
+	ListZonesPage(opts PaginationOptions) ([]Zone, ResultInfo, error)
+	ListZonesWithParams(params ZoneListParams) ([]Zone, error)
+	OriginCertificate(certificateID string) (OriginCACertificate, error)
+	OriginCertificates(zoneID string) ([]OriginCACertificate, error)
+	PageRule(zoneID, ruleID string) (PageRule, error)
+	Paginate(path string, query url.Values, fn func(item json.RawMessage) error) error
+	PauseAllPageRules(zoneID string) ([]string, error)
+	PlanZoneConfig(zoneID string, config ZoneConfig) (ZoneConfigPlan, error)
+	PrefixAdvertisementStatus(accountID, prefixID string) (PrefixAdvertisementStatus, error)
+	PullQueueMessages(accountID, queueID string, opts QueuePullOptions) ([]QueueMessage, error)
+	PurgeCache(zoneID string, pcr PurgeCacheRequest) (PurgeCacheResponse, error)
+	PurgeEverything(zoneID string) (PurgeCacheResponse, error)
+	RailgunDetails(railgunID string) (Railgun, error)
+	RailgunZones(railgunID string) ([]Zone, error)
+	Raw(ctx context.Context, method, path string, body interface{}, header http.Header) ([]byte, error)
+	RegistrarDomain(accountID, domainName string) (RegistrarDomain, error)
+	RegistrarDomains(accountID string) ([]RegistrarDomain, error)
+	RemoveAddressMapMembership(accountID, addressMapID string, membership AddressMapMembership) error
+	RemoveIPFromAddressMap(accountID, addressMapID, ip string) error
+	ReorderPageRules(zoneID string, orderedIDs []string) error
+	ReprioritizeSSL(zoneID string, p []ZoneCustomSSLPriority) ([]ZoneCustomSSL, error)
+	ResumeAllPageRules(zoneID string) ([]string, error)
+	RevokeDevices(accountID string, deviceIDs []string) error
+	RevokeOriginCertificate(certificateID string) (string, error)
+	SSLDetails(zoneID, certificateID string) (ZoneCustomSSL, error)
+	SetCustomErrorRules(zoneID string, rules []CustomErrorRule) ([]CustomErrorRule, error)
+	SetPageRuleStatus(zoneID, ruleID string, status PageRuleStatus) (PageRule, error)
+	SetPageRulesStatus(zoneID string, ids []string, status PageRuleStatus) map[string]error
+	SetStreamWebhook(accountID, notificationURL string) (StreamWebhook, error)
+	SetZoneDevelopmentMode(zoneID string, on bool) (ZoneDevelopmentMode, error)
+	SplitTunnel(accountID, policyID, mode string) ([]SplitTunnel, error)
+	StreamDNSRecords(zoneID string, rr DNSRecord, fn func(DNSRecord) error) error
+	StreamLiveInput(accountID, inputID string) (StreamLiveInput, error)
+	StreamLiveInputOutputs(accountID, inputID string) ([]StreamLiveInputOutput, error)
+	StreamLiveInputs(accountID string) ([]StreamLiveInput, error)
+	StreamPageRules(zoneID string, fn func(PageRule) error) error
+	StreamWebhook(accountID string) (StreamWebhook, error)
+	StreamZoneAnalyticsByColocation(zoneID string, options ZoneAnalyticsOptions, fn func(ZoneAnalyticsColocation) error) error
+	SyncDNSRecords(zoneID string, desired []DNSRecord, opts DNSSyncOptions) ([]ZoneConfigChange, error)
+	SyncFirewallRules(zoneID string, desired []FirewallRule, opts FirewallSyncOptions) ([]ZoneConfigChange, error)
+	SyncPageRules(zoneID string, desired []PageRule, opts PageRuleSyncOptions) ([]ZoneConfigChange, error)
+	SyncZoneAccessRules(zoneID string, desired []AccessRule, opts FirewallSyncOptions) ([]ZoneConfigChange, error)
+	TestRailgunConnection(zoneID, railgunID string) (RailgunDiagnosis, error)
+	TransferRegistrarDomain(accountID, domainName, authCode string) ([]RegistrarDomain, error)
+	UnrevokeDevices(accountID string, deviceIDs []string) error
+	UpdateAccountSubscription(accountID, subscriptionID string, sub Subscription) (Subscription, error)
+	UpdateAddressMap(accountID, addressMapID string, addressMap AddressMap) (AddressMap, error)
+	UpdateCustomPage(options CustomPageOptions, pageID string, params CustomPageParameters) (CustomPage, error)
+	UpdateDLPProfile(accountID string, profile DLPProfile) (DLPProfile, error)
+	UpdateDNSRecord(zoneID, recordID string, rr DNSRecord) error
+	UpdateFallbackDomains(accountID, policyID string, domains []FallbackDomain) ([]FallbackDomain, error)
+	UpdateFilters(zoneID string, filters []Filter) ([]Filter, error)
+	UpdateFirewallRules(zoneID string, rules []FirewallRule) ([]FirewallRule, error)
+	UpdateKeyless()
+	UpdatePageRule(zoneID, ruleID string, rule PageRule) (PageRule, error)
+	UpdatePrefixAdvertisementStatus(accountID, prefixID string, advertised bool) (PrefixAdvertisementStatus, error)
+	UpdateRateLimit(zoneID, limitID string, limit ZoneRateLimit) (ZoneRateLimit, error)
+	UpdateSSL(zoneID, certificateID string, options ZoneCustomSSLOptions) (ZoneCustomSSL, error)
+	UpdateSplitTunnel(accountID, policyID, mode string, tunnels []SplitTunnel) ([]SplitTunnel, error)
+	UpdateStreamLiveInput(accountID, inputID string, input StreamLiveInput) (StreamLiveInput, error)
+	UpdateUser() (User, error)
+	UpdateUserAgentRule(zoneID, ruleID string, rule UserAgentRule) (UserAgentRule, error)
+	UpdateVirtualDNS(virtualDNSID string, vv VirtualDNS) error
+	UpdateWorkerScriptSettings(accountID, scriptName string, settings WorkerScriptSettings) (WorkerScriptSettings, error)
+	UpdateZoneAccessRule(zoneID, ruleID string, rule AccessRule) (AccessRule, error)
+	UpdateZoneLockdown(zoneID, ruleID string, rule ZoneLockdown) (ZoneLockdown, error)
+	UpdateZoneRulesetPhaseEntrypoint(zoneID, phase string, rs Ruleset) (Ruleset, error)
+	UpdateZoneSettings(zoneID string, settings ZoneSettings) (ZoneSettings, error)
+	UpdateZoneSubscription(zoneID string, sub Subscription) (Subscription, error)
+	UserDetails() (User, error)
+	VerifyAPIToken() (APITokenVerification, error)
+	VirtualDNS(virtualDNSID string) (*VirtualDNS, error)
+	WaitForPageRuleStatus(ctx context.Context, zoneID, ruleID string, status PageRuleStatus) (PageRule, error)
+	WaitForZoneActive(ctx context.Context, zoneID string) (Zone, error)
+	WithdrawPrefix(accountID, prefixID string) (PrefixAdvertisementStatus, error)
+	WorkerDeployment(accountID, scriptName, deploymentID string) (WorkerDeployment, error)
+	WorkerDeployments(accountID, scriptName string) ([]WorkerDeployment, error)
+	WorkerScriptSettings(accountID, scriptName string) (WorkerScriptSettings, error)
+	WorkerVersions(accountID, scriptName string) ([]WorkerVersion, error)
+	ZoneAccessRules(zoneID string) ([]AccessRule, error)
+	ZoneActivationCheck(zoneID string) (Response, error)
+	ZoneAnalyticsByColocation(zoneID string, options ZoneAnalyticsOptions) ([]ZoneAnalyticsColocation, error)
+	ZoneAnalyticsDashboard(zoneID string, options ZoneAnalyticsOptions) (ZoneAnalyticsData, error)
+	ZoneAnalyticsDashboardRaw(zoneID string, options ZoneAnalyticsOptions) (json.RawMessage, error)
+	ZoneDNSSECStatus(zoneID string) (ZoneDNSSEC, error)
+	ZoneDetails(zoneID string) (Zone, error)
+	ZoneDevelopmentMode(zoneID string) (ZoneDevelopmentMode, error)
+	ZoneIDByName(zoneName string) (string, error)
+	ZoneInventory(opts ZoneInventoryOptions) ([]AccountZones, error)
+	ZoneLockdowns(zoneID string) ([]ZoneLockdown, error)
+	ZonePlanDetails(zoneID, planID string) (ZonePlan, error)
+	ZoneRailgunDetails(zoneID, railgunID string) (ZoneRailgun, error)
+	ZoneRailguns(zoneID string) ([]ZoneRailgun, error)
+	ZoneRulesetPhaseEntrypoint(zoneID, phase string) (Ruleset, error)
+	ZoneSetPaused(zoneID string, paused bool) (Zone, error)
+	ZoneSetPlan(zoneID string, plan ZonePlan) (Zone, error)
+	ZoneSetVanityNS(zoneID string, ns []string) (Zone, error)
+	ZoneSettings(zoneID string) (ZoneSettings, error)
+	ZoneSubscription(zoneID string) (Subscription, error)
+}
+
+var _ Client = &API{}
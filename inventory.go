@@ -0,0 +1,61 @@
+package cloudflare
+
+import (
+	"encoding/json"
+	"net/url"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// ZoneInventoryOptions controls the behaviour of ZoneInventory.
+type ZoneInventoryOptions struct {
+	// Interval is the minimum time between API requests, to keep inventories
+	// of many accounts within the API's rate limits.
+	Interval time.Duration
+}
+
+// AccountZones is an account and the zones which belong to it.
+type AccountZones struct {
+	Account Account
+	Zones   []Zone
+}
+
+// ZoneInventory lists every account visible to the credentials and the zones
+// in each of them.
+func (api *API) ZoneInventory(opts ZoneInventoryOptions) ([]AccountZones, error) {
+	var last time.Time
+	throttle := func() {
+		if opts.Interval <= 0 {
+			return
+		}
+		if wait := opts.Interval - time.Since(last); wait > 0 {
+			time.Sleep(wait)
+		}
+		last = time.Now()
+	}
+
+	throttle()
+	accounts, err := api.Accounts("")
+	if err != nil {
+		return nil, errors.Wrap(err, "could not list accounts")
+	}
+
+	inventory := make([]AccountZones, 0, len(accounts))
+	for _, account := range accounts {
+		zones := []Zone{}
+		v := url.Values{}
+		v.Set("account.id", account.ID)
+		throttle()
+		err := api.paginate("/zones", v, func(result json.RawMessage) error {
+			throttle()
+			return api.appendJSONArray(&zones, result)
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "could not list zones of account "+account.ID)
+		}
+		inventory = append(inventory, AccountZones{Account: account, Zones: zones})
+	}
+
+	return inventory, nil
+}
@@ -0,0 +1,60 @@
+package cloudflare
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestZoneInventory(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/accounts", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"success": true, "errors": [], "messages": [],
+			"result": [{"id": "a1", "name": "One"}, {"id": "a2", "name": "Two"}],
+			"result_info": {"page": 1, "per_page": 20, "total_count": 2}
+		}`)
+	})
+	mux.HandleFunc("/zones", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		q := r.URL.Query()
+		switch q.Get("account.id") + "/" + q.Get("page") {
+		case "a1/1":
+			fmt.Fprint(w, `{
+				"success": true, "errors": [], "messages": [],
+				"result": [{"id": "z1", "name": "one.example"}],
+				"result_info": {"page": 1, "per_page": 1, "total_count": 2}
+			}`)
+		case "a1/2":
+			fmt.Fprint(w, `{
+				"success": true, "errors": [], "messages": [],
+				"result": [{"id": "z2", "name": "two.example"}],
+				"result_info": {"page": 2, "per_page": 1, "total_count": 2}
+			}`)
+		case "a2/1":
+			fmt.Fprint(w, `{
+				"success": true, "errors": [], "messages": [],
+				"result": [],
+				"result_info": {"page": 1, "per_page": 1, "total_count": 0}
+			}`)
+		default:
+			t.Errorf("unexpected query %s", r.URL.RawQuery)
+		}
+	})
+
+	inventory, err := client.ZoneInventory(ZoneInventoryOptions{})
+	if assert.NoError(t, err) && assert.Len(t, inventory, 2) {
+		assert.Equal(t, "One", inventory[0].Account.Name)
+		if assert.Len(t, inventory[0].Zones, 2) {
+			assert.Equal(t, "z1", inventory[0].Zones[0].ID)
+			assert.Equal(t, "z2", inventory[0].Zones[1].ID)
+		}
+		assert.Equal(t, "a2", inventory[1].Account.ID)
+		assert.Empty(t, inventory[1].Zones)
+	}
+}
@@ -27,10 +27,31 @@ func IPs() (IPRanges, error) {
 	if err != nil {
 		return IPRanges{}, errors.Wrap(err, "Response body could not be read")
 	}
-	var r IPsResponse
+	var r rawResponse
 	err = json.Unmarshal(body, &r)
 	if err != nil {
 		return IPRanges{}, errors.Wrap(err, errUnmarshalError)
 	}
-	return r.Result, nil
+	if !r.Success {
+		return IPRanges{}, unsuccessfulError(r.Errors)
+	}
+	var ranges IPRanges
+	if err := json.Unmarshal(r.Result, &ranges); err != nil {
+		return IPRanges{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return ranges, nil
+}
+
+// IPs returns the IPv4 and IPv6 CIDR ranges from which Cloudflare connects to
+// origins, as published by the API, for building origin allowlists. Unlike
+// the package-level IPs, it uses the client's BaseURL, HTTP client and
+// options.
+//
+// API reference: https://api.cloudflare.com/#cloudflare-ips
+func (api *API) IPs() (IPRanges, error) {
+	var ranges IPRanges
+	if _, err := api.makeRequestResult("GET", "/ips", nil, &ranges); err != nil {
+		return IPRanges{}, err
+	}
+	return ranges, nil
 }
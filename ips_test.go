@@ -0,0 +1,36 @@
+package cloudflare
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAPIIPs(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/ips", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {
+				"ipv4_cidrs": ["173.245.48.0/20", "103.21.244.0/22"],
+				"ipv6_cidrs": ["2400:cb00::/32"]
+			}
+		}`)
+	})
+
+	ranges, err := client.IPs()
+	if assert.NoError(t, err) {
+		assert.Equal(t, IPRanges{
+			IPv4CIDRs: []string{"173.245.48.0/20", "103.21.244.0/22"},
+			IPv6CIDRs: []string{"2400:cb00::/32"},
+		}, ranges)
+	}
+}
@@ -0,0 +1,66 @@
+package cloudflare
+
+import "time"
+
+// ZoneLockdown is a Zone Lockdown rule, which only allows requests to the
+// given URLs from the IP addresses and ranges in its configurations.
+type ZoneLockdown struct {
+	ID             string               `json:"id,omitempty"`
+	Description    string               `json:"description,omitempty"`
+	URLs           []string             `json:"urls"`
+	Configurations []ZoneLockdownConfig `json:"configurations"`
+	Paused         bool                 `json:"paused"`
+	Priority       int                  `json:"priority,omitempty"`
+	CreatedOn      time.Time            `json:"created_on,omitempty"`
+	ModifiedOn     time.Time            `json:"modified_on,omitempty"`
+}
+
+// ZoneLockdownConfig is an address allowed by a Zone Lockdown rule. Target is
+// "ip" or "ip_range".
+type ZoneLockdownConfig struct {
+	Target string `json:"target"`
+	Value  string `json:"value"`
+}
+
+// ZoneLockdowns returns all Zone Lockdown rules for a zone.
+//
+// API reference: https://api.cloudflare.com/#zone-lockdown-list-lockdown-rules
+func (api *API) ZoneLockdowns(zoneID string) ([]ZoneLockdown, error) {
+	var rules []ZoneLockdown
+	if err := api.paginateInto("/zones/"+zoneID+"/firewall/lockdowns", nil, &rules); err != nil {
+		return nil, err
+	}
+	return rules, nil
+}
+
+// CreateZoneLockdown creates a Zone Lockdown rule.
+//
+// API reference: https://api.cloudflare.com/#zone-lockdown-create-lockdown-rule
+func (api *API) CreateZoneLockdown(zoneID string, rule ZoneLockdown) (ZoneLockdown, error) {
+	var result ZoneLockdown
+	if _, err := api.makeRequestResult("POST", "/zones/"+zoneID+"/firewall/lockdowns", rule, &result); err != nil {
+		return ZoneLockdown{}, err
+	}
+	return result, nil
+}
+
+// UpdateZoneLockdown replaces a Zone Lockdown rule.
+//
+// API reference: https://api.cloudflare.com/#zone-lockdown-update-lockdown-rule
+func (api *API) UpdateZoneLockdown(zoneID, ruleID string, rule ZoneLockdown) (ZoneLockdown, error) {
+	var result ZoneLockdown
+	if _, err := api.makeRequestResult("PUT", "/zones/"+zoneID+"/firewall/lockdowns/"+ruleID, rule, &result); err != nil {
+		return ZoneLockdown{}, err
+	}
+	return result, nil
+}
+
+// DeleteZoneLockdown deletes a Zone Lockdown rule.
+//
+// API reference: https://api.cloudflare.com/#zone-lockdown-delete-lockdown-rule
+func (api *API) DeleteZoneLockdown(zoneID, ruleID string) error {
+	if _, err := api.makeRequestResult("DELETE", "/zones/"+zoneID+"/firewall/lockdowns/"+ruleID, nil, nil); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,75 @@
+package cloudflare
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestZoneLockdowns(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/foo/firewall/lockdowns", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": [
+				{
+					"id": "372e67954025e0ba6aaa6d586b9e0b59",
+					"description": "Restrict access to the admin pages",
+					"urls": ["example.com/admin/*"],
+					"configurations": [{"target": "ip", "value": "192.0.2.1"}],
+					"paused": false,
+					"priority": 5
+				}
+			]
+		}`)
+	})
+
+	rules, err := client.ZoneLockdowns("foo")
+	if assert.NoError(t, err) && assert.Len(t, rules, 1) {
+		assert.Equal(t, "372e67954025e0ba6aaa6d586b9e0b59", rules[0].ID)
+		assert.Equal(t, []string{"example.com/admin/*"}, rules[0].URLs)
+		assert.Equal(t, []ZoneLockdownConfig{{Target: "ip", Value: "192.0.2.1"}}, rules[0].Configurations)
+		assert.Equal(t, 5, rules[0].Priority)
+	}
+}
+
+func TestCreateZoneLockdown(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/foo/firewall/lockdowns", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method, "Expected method 'POST', got %s", r.Method)
+		var rule ZoneLockdown
+		if assert.NoError(t, json.NewDecoder(r.Body).Decode(&rule)) {
+			assert.Equal(t, []string{"example.com/admin/*"}, rule.URLs)
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {
+				"id": "372e67954025e0ba6aaa6d586b9e0b59",
+				"urls": ["example.com/admin/*"],
+				"configurations": [{"target": "ip", "value": "192.0.2.1"}]
+			}
+		}`)
+	})
+
+	rule, err := client.CreateZoneLockdown("foo", ZoneLockdown{
+		URLs:           []string{"example.com/admin/*"},
+		Configurations: []ZoneLockdownConfig{{Target: "ip", Value: "192.0.2.1"}},
+	})
+	if assert.NoError(t, err) {
+		assert.Equal(t, "372e67954025e0ba6aaa6d586b9e0b59", rule.ID)
+	}
+}
@@ -0,0 +1,30 @@
+package cloudflare
+
+// MessageHandler is called with the messages of an API response, such as
+// notices of upcoming deprecations. uri is the requested path and query.
+type MessageHandler func(method, uri string, messages []ResponseInfo)
+
+// OnMessages calls fn with the messages of every successful response which
+// has any, which would otherwise be discarded. fn may be called concurrently
+// if the client is used from several goroutines.
+func OnMessages(fn MessageHandler) Option {
+	return func(api *API) error {
+		api.onMessages = fn
+		return nil
+	}
+}
+
+// reportMessages passes the messages of a response body to the client's
+// message handler, if it has one.
+func (api *API) reportMessages(method, uri string, body []byte) {
+	if api.onMessages == nil {
+		return
+	}
+	var r struct {
+		Messages []ResponseInfo `json:"messages"`
+	}
+	if err := api.json().Unmarshal(body, &r); err != nil || len(r.Messages) == 0 {
+		return
+	}
+	api.onMessages(method, uri, r.Messages)
+}
@@ -0,0 +1,45 @@
+package cloudflare
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOnMessages(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/023e105f4ecef8ad9ca31a8372d0c353/pagerules", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [{"code": 10000, "message": "This endpoint is deprecated and will be removed on 2027-01-01"}],
+			"result": []
+		}`)
+	})
+	mux.HandleFunc("/zones/023e105f4ecef8ad9ca31a8372d0c353/dns_records", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": []}`)
+	})
+
+	var got []string
+	err := OnMessages(func(method, uri string, messages []ResponseInfo) {
+		for _, m := range messages {
+			got = append(got, method+" "+uri+": "+m.String())
+		}
+	})(client)
+	assert.NoError(t, err)
+
+	_, err = client.ListPageRules("023e105f4ecef8ad9ca31a8372d0c353")
+	assert.NoError(t, err)
+	_, err = client.DNSRecords("023e105f4ecef8ad9ca31a8372d0c353", DNSRecord{})
+	assert.NoError(t, err)
+
+	assert.Equal(t, []string{
+		"GET /zones/023e105f4ecef8ad9ca31a8372d0c353/pagerules: This endpoint is deprecated and will be removed on 2027-01-01 (10000)",
+	}, got)
+}
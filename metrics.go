@@ -0,0 +1,34 @@
+package cloudflare
+
+import (
+	"net/http"
+	"time"
+)
+
+// Metrics receives an observation of every HTTP request made by the client,
+// for export as request rates, error rates and latency histograms. endpoint
+// is templated with Endpoint, e.g. "/zones/:zone_id/dns_records", so that
+// requests for different resources are grouped together. status is zero if the request failed without
+// a response. Implementations must be safe for concurrent use.
+type Metrics interface {
+	ObserveRequest(endpoint, method string, status int, duration time.Duration)
+}
+
+// ObserveMetrics reports every request made by the client, including retries,
+// to m.
+func ObserveMetrics(m Metrics) Option {
+	return Intercept(metricsInterceptor{m})
+}
+
+type metricsInterceptor struct {
+	metrics Metrics
+}
+
+func (metricsInterceptor) BeforeRequest(*http.Request) error {
+	return nil
+}
+
+func (i metricsInterceptor) AfterResponse(res *InterceptedResponse) {
+	endpoint, _ := RequestEndpoint(res.Request)
+	i.metrics.ObserveRequest(endpoint, res.Request.Method, res.StatusCode, res.Latency)
+}
@@ -0,0 +1,49 @@
+package cloudflare
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type recordingMetrics struct {
+	mu           sync.Mutex
+	observations []string
+}
+
+func (m *recordingMetrics) ObserveRequest(endpoint, method string, status int, duration time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.observations = append(m.observations, fmt.Sprintf("%s %s %d", method, endpoint, status))
+}
+
+func TestObserveMetrics(t *testing.T) {
+	setup()
+	defer teardown()
+
+	const zoneID = "023e105f4ecef8ad9ca31a8372d0c353"
+	mux.HandleFunc("/zones/"+zoneID+"/dns_records", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": []}`)
+	})
+	mux.HandleFunc("/zones/"+zoneID, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	})
+
+	m := &recordingMetrics{}
+	assert.NoError(t, ObserveMetrics(m)(client))
+
+	_, err := client.DNSRecords(zoneID, DNSRecord{Type: "A"})
+	assert.NoError(t, err)
+	_, err = client.ZoneDetails(zoneID)
+	assert.Error(t, err)
+
+	assert.Equal(t, []string{
+		"GET /zones/:zone_id/dns_records 200",
+		"GET /zones/:zone_id 403",
+	}, m.observations)
+}
@@ -0,0 +1,212 @@
+package cloudflare
+
+import (
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTransportOptions(t *testing.T) {
+	api, err := New("deadbeef", "cloudflare@example.org")
+	if assert.NoError(t, err) {
+		tr := api.httpClient.Transport.(*http.Transport)
+		assert.Equal(t, defaultMaxIdleConnsPerHost, tr.MaxIdleConnsPerHost)
+		assert.Equal(t, defaultIdleConnTimeout, tr.IdleConnTimeout)
+		assert.Equal(t, defaultTLSHandshakeTimeout, tr.TLSHandshakeTimeout)
+		assert.True(t, tr.ForceAttemptHTTP2)
+	}
+
+	api, err = New("deadbeef", "cloudflare@example.org",
+		MaxIdleConnsPerHost(8),
+		IdleConnTimeout(30*time.Second),
+		TLSHandshakeTimeout(5*time.Second),
+		ForceHTTP2(false),
+	)
+	if assert.NoError(t, err) {
+		tr := api.httpClient.Transport.(*http.Transport)
+		assert.Equal(t, 8, tr.MaxIdleConnsPerHost)
+		assert.Equal(t, 30*time.Second, tr.IdleConnTimeout)
+		assert.Equal(t, 5*time.Second, tr.TLSHandshakeTimeout)
+		assert.False(t, tr.ForceAttemptHTTP2)
+	}
+
+	_, err = New("deadbeef", "cloudflare@example.org", HTTPClient(http.DefaultClient), MaxIdleConnsPerHost(8))
+	assert.Error(t, err)
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestRoundTripperOptions(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/z1", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"id": "z1"}}`)
+	})
+
+	var calls []string
+	wrapper := func(name string) func(http.RoundTripper) http.RoundTripper {
+		return func(next http.RoundTripper) http.RoundTripper {
+			return roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+				calls = append(calls, name)
+				return next.RoundTrip(req)
+			})
+		}
+	}
+
+	api, err := New("deadbeef", "cloudflare@example.org",
+		RoundTripper(wrapper("custom")(http.DefaultTransport)),
+		WrapTransport(wrapper("inner")),
+		WrapTransport(wrapper("outer")),
+	)
+	if assert.NoError(t, err) {
+		api.BaseURL = server.URL
+		_, err = api.ZoneDetails("z1")
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"outer", "inner", "custom"}, calls)
+	}
+
+	calls = nil
+	httpClient := &http.Client{}
+	api, err = New("deadbeef", "cloudflare@example.org", HTTPClient(httpClient), WrapTransport(wrapper("wrapped")))
+	if assert.NoError(t, err) {
+		api.BaseURL = server.URL
+		_, err = api.ZoneDetails("z1")
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"wrapped"}, calls)
+		assert.Nil(t, httpClient.Transport)
+	}
+
+	_, err = New("deadbeef", "cloudflare@example.org", RoundTripper(http.DefaultTransport), MaxIdleConnsPerHost(8))
+	assert.Error(t, err)
+	_, err = New("deadbeef", "cloudflare@example.org", RoundTripper(nil))
+	assert.Error(t, err)
+	_, err = New("deadbeef", "cloudflare@example.org", HTTPClient(httpClient), RoundTripper(http.DefaultTransport))
+	assert.Error(t, err)
+}
+
+func TestTLSConfig(t *testing.T) {
+	config := &tls.Config{ServerName: "api.example.com"}
+	cert := tls.Certificate{Certificate: [][]byte{[]byte("cert")}}
+	api, err := New("deadbeef", "cloudflare@example.org", TLSConfig(config), ClientCertificate(cert))
+	if assert.NoError(t, err) {
+		tr := api.httpClient.Transport.(*http.Transport)
+		assert.Equal(t, "api.example.com", tr.TLSClientConfig.ServerName)
+		assert.Len(t, tr.TLSClientConfig.Certificates, 1)
+		assert.Empty(t, config.Certificates)
+	}
+}
+
+func TestProxy(t *testing.T) {
+	// The proxy receives requests for the API with absolute URIs.
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "api.example.invalid", r.URL.Host)
+		assert.Equal(t, "/client/v4/user", r.URL.Path)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"id": "1"}}`)
+	}))
+	defer proxy.Close()
+
+	api, err := New("deadbeef", "cloudflare@example.org",
+		BaseURL("http://api.example.invalid/client/v4"), Proxy(proxy.URL))
+	if !assert.NoError(t, err) {
+		return
+	}
+	user, err := api.UserDetails()
+	if assert.NoError(t, err) {
+		assert.Equal(t, "1", user.ID)
+	}
+
+	for _, proxyURL := range []string{"ftp://proxy.example.com", "socks5://", "://"} {
+		_, err = New("deadbeef", "cloudflare@example.org", Proxy(proxyURL))
+		assert.Error(t, err, proxyURL)
+	}
+	_, err = New("deadbeef", "cloudflare@example.org", Proxy("socks5://proxy.example.com:1080"), RoundTripper(http.DefaultTransport))
+	assert.Error(t, err)
+}
+
+func TestDialer(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		host, _, _ := net.SplitHostPort(r.RemoteAddr)
+		assert.Equal(t, "127.0.0.1", host)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"id": "1"}}`)
+	}))
+	defer server.Close()
+
+	dialer := &net.Dialer{
+		Timeout:   5 * time.Second,
+		LocalAddr: &net.TCPAddr{IP: net.ParseIP("127.0.0.1")},
+	}
+	api, err := New("deadbeef", "cloudflare@example.org", BaseURL(server.URL), Dialer(dialer))
+	if !assert.NoError(t, err) {
+		return
+	}
+	_, err = api.UserDetails()
+	assert.NoError(t, err)
+
+	_, err = New("deadbeef", "cloudflare@example.org", Dialer(nil))
+	assert.Error(t, err)
+}
+
+func TestBaseURL(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/client/v4/zones/z1", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"id": "z1"}}`)
+	})
+
+	api, err := New("deadbeef", "cloudflare@example.org", BaseURL(server.URL+"/client/v4/"))
+	if assert.NoError(t, err) {
+		assert.Equal(t, server.URL+"/client/v4", api.BaseURL)
+		_, err = api.ZoneDetails("z1")
+		assert.NoError(t, err)
+	}
+
+	for _, u := range []string{"", "api.example.com/client/v4", "ftp://api.example.com", "https://", "http://[::1"} {
+		_, err = New("deadbeef", "cloudflare@example.org", BaseURL(u))
+		assert.Error(t, err, u)
+	}
+}
+
+func TestUserAgent(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var userAgent string
+	mux.HandleFunc("/ips", func(w http.ResponseWriter, r *http.Request) {
+		userAgent = r.Header.Get("User-Agent")
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {}}`)
+	})
+
+	_, err := client.IPs()
+	assert.NoError(t, err)
+	assert.Equal(t, "cloudflare-go/"+Version, userAgent)
+
+	assert.NoError(t, UserAgent("terraform/1.5.0")(client))
+	assert.NoError(t, UserAgent("my-tool/2.0")(client))
+	_, err = client.IPs()
+	assert.NoError(t, err)
+	assert.Equal(t, "cloudflare-go/"+Version+" terraform/1.5.0 my-tool/2.0", userAgent)
+
+	assert.NoError(t, Headers(http.Header{"User-Agent": {"custom"}})(client))
+	_, err = client.IPs()
+	assert.NoError(t, err)
+	assert.Equal(t, "custom", userAgent)
+
+	assert.Error(t, UserAgent(" ")(client))
+}
@@ -10,6 +10,8 @@ type Organization struct {
 }
 
 // OrganizationResponse represents the response from the Organization endpoint.
+//
+// Deprecated: see Response.
 type OrganizationResponse struct {
 	Response
 	Result     []Organization `json:"result"`
@@ -0,0 +1,83 @@
+package cloudflare
+
+import (
+	"net/url"
+	"time"
+)
+
+// OriginCACertificate is a certificate signed by the Cloudflare Origin CA,
+// which Cloudflare trusts when connecting to origin servers.
+//
+// The Origin CA endpoints only accept an API token or an Origin CA key; to
+// call them from a client authenticating with an API key, pass the Origin CA
+// key per call:
+//
+//	certs, err := api.With(cloudflare.UserServiceKey(originCAKey)).OriginCertificates(zoneID)
+type OriginCACertificate struct {
+	ID              string    `json:"id"`
+	Certificate     string    `json:"certificate"`
+	Hostnames       []string  `json:"hostnames"`
+	ExpiresOn       time.Time `json:"expires_on"`
+	RequestType     string    `json:"request_type"`
+	RequestValidity int       `json:"requested_validity"`
+	CSR             string    `json:"csr"`
+}
+
+// OriginCACertificateRequest describes an Origin CA certificate to create.
+// RequestType is one of "origin-rsa" or "origin-ecc", and RequestValidity is
+// the lifetime of the certificate in days.
+type OriginCACertificateRequest struct {
+	CSR             string   `json:"csr"`
+	Hostnames       []string `json:"hostnames"`
+	RequestType     string   `json:"request_type"`
+	RequestValidity int      `json:"requested_validity,omitempty"`
+}
+
+// OriginCertificates lists the Origin CA certificates of a zone.
+//
+// API reference: https://api.cloudflare.com/#origin-ca-list-certificates
+func (api *API) OriginCertificates(zoneID string) ([]OriginCACertificate, error) {
+	v := url.Values{}
+	v.Set("zone_id", zoneID)
+	var certs []OriginCACertificate
+	if _, err := api.makeRequestResult("GET", "/certificates?"+v.Encode(), nil, &certs); err != nil {
+		return nil, err
+	}
+	return certs, nil
+}
+
+// OriginCertificate fetches an Origin CA certificate.
+//
+// API reference: https://api.cloudflare.com/#origin-ca-get-certificate
+func (api *API) OriginCertificate(certificateID string) (OriginCACertificate, error) {
+	var cert OriginCACertificate
+	if _, err := api.makeRequestResult("GET", "/certificates/"+certificateID, nil, &cert); err != nil {
+		return OriginCACertificate{}, err
+	}
+	return cert, nil
+}
+
+// CreateOriginCertificate has the Origin CA sign a certificate for a CSR.
+//
+// API reference: https://api.cloudflare.com/#origin-ca-create-certificate
+func (api *API) CreateOriginCertificate(request OriginCACertificateRequest) (OriginCACertificate, error) {
+	var cert OriginCACertificate
+	if _, err := api.makeRequestResult("POST", "/certificates", request, &cert); err != nil {
+		return OriginCACertificate{}, err
+	}
+	return cert, nil
+}
+
+// RevokeOriginCertificate revokes an Origin CA certificate, returning the ID
+// of the revoked certificate.
+//
+// API reference: https://api.cloudflare.com/#origin-ca-revoke-certificate
+func (api *API) RevokeOriginCertificate(certificateID string) (string, error) {
+	var result struct {
+		ID string `json:"id"`
+	}
+	if _, err := api.makeRequestResult("DELETE", "/certificates/"+certificateID, nil, &result); err != nil {
+		return "", err
+	}
+	return result.ID, nil
+}
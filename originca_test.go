@@ -0,0 +1,100 @@
+package cloudflare
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOriginCertificatesUserServiceKey(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/certificates", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "023e105f4ecef8ad9ca31a8372d0c353", r.URL.Query().Get("zone_id"))
+		assert.Equal(t, "v1.0-servicekey", r.Header.Get("X-Auth-User-Service-Key"))
+		assert.Empty(t, r.Header.Get("X-Auth-Key"))
+		assert.Empty(t, r.Header.Get("X-Auth-Email"))
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": [
+				{
+					"id": "328578533902268680351274817346",
+					"certificate": "-----BEGIN CERTIFICATE-----",
+					"hostnames": ["example.com", "*.example.com"],
+					"expires_on": "2034-11-30T20:16:00Z",
+					"request_type": "origin-rsa",
+					"requested_validity": 5475
+				}
+			]
+		}`)
+	})
+
+	certs, err := client.With(UserServiceKey("v1.0-servicekey")).OriginCertificates("023e105f4ecef8ad9ca31a8372d0c353")
+	if assert.NoError(t, err) && assert.Len(t, certs, 1) {
+		assert.Equal(t, "328578533902268680351274817346", certs[0].ID)
+		assert.Equal(t, []string{"example.com", "*.example.com"}, certs[0].Hostnames)
+		assert.Equal(t, 5475, certs[0].RequestValidity)
+	}
+}
+
+func TestCreateOriginCertificate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/certificates", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		// Without a per-call service key the client's credentials are used.
+		assert.Equal(t, client.APIKey, r.Header.Get("X-Auth-Key"))
+		assert.Empty(t, r.Header.Get("X-Auth-User-Service-Key"))
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {"id": "abc", "hostnames": ["example.com"], "request_type": "origin-ecc", "csr": "-----BEGIN CERTIFICATE REQUEST-----"}
+		}`)
+	})
+
+	cert, err := client.CreateOriginCertificate(OriginCACertificateRequest{
+		CSR:         "-----BEGIN CERTIFICATE REQUEST-----",
+		Hostnames:   []string{"example.com"},
+		RequestType: "origin-ecc",
+	})
+	if assert.NoError(t, err) {
+		assert.Equal(t, "abc", cert.ID)
+		assert.Equal(t, "origin-ecc", cert.RequestType)
+	}
+}
+
+func TestRevokeOriginCertificate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/certificates/abc", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "DELETE", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"id": "abc"}}`)
+	})
+
+	id, err := client.RevokeOriginCertificate("abc")
+	if assert.NoError(t, err) {
+		assert.Equal(t, "abc", id)
+	}
+}
+
+func TestNewWithUserServiceKey(t *testing.T) {
+	_, err := NewWithUserServiceKey("")
+	assert.EqualError(t, err, errEmptyUserServiceKey)
+
+	api, err := NewWithUserServiceKey("v1.0-servicekey")
+	if assert.NoError(t, err) {
+		assert.Equal(t, "v1.0-servicekey", api.APIUserServiceKey)
+	}
+}
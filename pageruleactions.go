@@ -0,0 +1,195 @@
+package cloudflare
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// PageRuleForwardingURL is the value of a forwarding_url page rule action.
+// StatusCode is 301 or 302, and URL may refer to wildcards matched by the
+// rule's target as $1, $2 and so on.
+type PageRuleForwardingURL struct {
+	URL        string `json:"url"`
+	StatusCode int    `json:"status_code"`
+}
+
+// NewForwardingURLAction returns an action redirecting requests to url with
+// the given status code, 301 or 302.
+func NewForwardingURLAction(statusCode int, url string) PageRuleAction {
+	return PageRuleAction{ID: "forwarding_url", Value: PageRuleForwardingURL{URL: url, StatusCode: statusCode}}
+}
+
+// NewCacheLevelAction returns an action setting the cache level.
+func NewCacheLevelAction(level CacheLevel) PageRuleAction {
+	return PageRuleAction{ID: "cache_level", Value: level}
+}
+
+// NewSecurityLevelAction returns an action setting the security level.
+func NewSecurityLevelAction(level SecurityLevel) PageRuleAction {
+	return PageRuleAction{ID: "security_level", Value: level}
+}
+
+// NewSSLAction returns an action setting the SSL mode.
+func NewSSLAction(mode SSLMode) PageRuleAction {
+	return PageRuleAction{ID: "ssl", Value: mode}
+}
+
+// NewEdgeCacheTTLAction returns an action setting how long Cloudflare caches
+// resources, in whole seconds.
+func NewEdgeCacheTTLAction(ttl time.Duration) PageRuleAction {
+	return PageRuleAction{ID: "edge_cache_ttl", Value: int(ttl / time.Second)}
+}
+
+// NewBrowserCacheTTLAction returns an action setting how long browsers cache
+// resources, in whole seconds.
+func NewBrowserCacheTTLAction(ttl time.Duration) PageRuleAction {
+	return PageRuleAction{ID: "browser_cache_ttl", Value: int(ttl / time.Second)}
+}
+
+// NewToggleAction returns an action turning a feature, such as
+// "always_online", "respect_strong_etags" or "response_buffering", on or off.
+func NewToggleAction(id string, on bool) PageRuleAction {
+	return PageRuleAction{ID: id, Value: onOff(on)}
+}
+
+// NewAlwaysUseHTTPSAction returns an action redirecting HTTP requests to
+// HTTPS.
+func NewAlwaysUseHTTPSAction() PageRuleAction {
+	return PageRuleAction{ID: "always_use_https"}
+}
+
+// NewDisableSecurityAction returns an action disabling security features.
+func NewDisableSecurityAction() PageRuleAction {
+	return PageRuleAction{ID: "disable_security"}
+}
+
+// NewDisablePerformanceAction returns an action disabling performance
+// features.
+func NewDisablePerformanceAction() PageRuleAction {
+	return PageRuleAction{ID: "disable_performance"}
+}
+
+// NewDisableAppsAction returns an action disabling Cloudflare Apps.
+func NewDisableAppsAction() PageRuleAction {
+	return PageRuleAction{ID: "disable_apps"}
+}
+
+// PageRuleCacheKeyFields is the value of a cache_key_fields page rule action,
+// selecting which parts of a request make up its cache key.
+type PageRuleCacheKeyFields struct {
+	QueryString PageRuleCacheKeyQueryString `json:"query_string"`
+	Header      PageRuleCacheKeyHeader      `json:"header"`
+	Cookie      PageRuleCacheKeyCookie      `json:"cookie"`
+	Host        PageRuleCacheKeyHost        `json:"host"`
+	User        PageRuleCacheKeyUser        `json:"user"`
+}
+
+// PageRuleCacheKeyList is a list of names in a cache key. The API encodes the
+// list of all names as the string "*", which is represented as []string{"*"}.
+type PageRuleCacheKeyList []string
+
+// MarshalJSON encodes the list as an array, or as "*" for all names.
+func (l PageRuleCacheKeyList) MarshalJSON() ([]byte, error) {
+	if len(l) == 1 && l[0] == "*" {
+		return []byte(`"*"`), nil
+	}
+	if l == nil {
+		return []byte("[]"), nil
+	}
+	return json.Marshal([]string(l))
+}
+
+// UnmarshalJSON decodes an array of names, or a single name such as "*".
+func (l *PageRuleCacheKeyList) UnmarshalJSON(data []byte) error {
+	var name string
+	if err := json.Unmarshal(data, &name); err == nil {
+		*l = PageRuleCacheKeyList{name}
+		return nil
+	}
+	return json.Unmarshal(data, (*[]string)(l))
+}
+
+// PageRuleCacheKeyQueryString selects the query string parameters in a cache
+// key. At most one of Include and Exclude may be non-empty.
+type PageRuleCacheKeyQueryString struct {
+	Include PageRuleCacheKeyList `json:"include"`
+	Exclude PageRuleCacheKeyList `json:"exclude"`
+}
+
+// PageRuleCacheKeyHeader selects the request headers in a cache key.
+// CheckPresence lists headers whose presence, but not value, is included.
+type PageRuleCacheKeyHeader struct {
+	Include       PageRuleCacheKeyList `json:"include"`
+	Exclude       PageRuleCacheKeyList `json:"exclude"`
+	CheckPresence PageRuleCacheKeyList `json:"check_presence"`
+}
+
+// PageRuleCacheKeyCookie selects the cookies in a cache key.
+type PageRuleCacheKeyCookie struct {
+	Include       PageRuleCacheKeyList `json:"include"`
+	CheckPresence PageRuleCacheKeyList `json:"check_presence"`
+}
+
+// PageRuleCacheKeyHost selects whether the cache key uses the resolved host,
+// after any resolve override, rather than the Host header.
+type PageRuleCacheKeyHost struct {
+	Resolved bool `json:"resolved"`
+}
+
+// PageRuleCacheKeyUser selects which features of the client are in a cache
+// key.
+type PageRuleCacheKeyUser struct {
+	DeviceType bool `json:"device_type"`
+	Geo        bool `json:"geo"`
+	Lang       bool `json:"lang"`
+}
+
+// NewCacheKeyFieldsAction returns an action setting a custom cache key.
+func NewCacheKeyFieldsAction(fields PageRuleCacheKeyFields) PageRuleAction {
+	return PageRuleAction{ID: "cache_key_fields", Value: fields}
+}
+
+// PageRuleMinify is the value of a minify page rule action. Each field is
+// "on" or "off".
+type PageRuleMinify struct {
+	HTML string `json:"html"`
+	CSS  string `json:"css"`
+	JS   string `json:"js"`
+}
+
+// NewMinifyAction returns an action setting which resources are minified.
+func NewMinifyAction(html, css, js bool) PageRuleAction {
+	return PageRuleAction{ID: "minify", Value: PageRuleMinify{HTML: onOff(html), CSS: onOff(css), JS: onOff(js)}}
+}
+
+// NewHostHeaderOverrideAction returns an action replacing the Host header of
+// requests sent to the origin.
+func NewHostHeaderOverrideAction(host string) PageRuleAction {
+	return PageRuleAction{ID: "host_header_override", Value: host}
+}
+
+// NewResolveOverrideAction returns an action sending requests to another
+// origin, which must be a hostname in the zone.
+func NewResolveOverrideAction(host string) PageRuleAction {
+	return PageRuleAction{ID: "resolve_override", Value: host}
+}
+
+// NewBypassCacheOnCookieAction returns an action bypassing the cache for
+// requests with a cookie whose name matches pattern, e.g. "wp-.*|wordpress.*".
+func NewBypassCacheOnCookieAction(pattern string) PageRuleAction {
+	return PageRuleAction{ID: "bypass_cache_on_cookie", Value: pattern}
+}
+
+// NewCacheOnCookieAction returns an action caching requests with a cookie
+// whose name matches pattern.
+func NewCacheOnCookieAction(pattern string) PageRuleAction {
+	return PageRuleAction{ID: "cache_on_cookie", Value: pattern}
+}
+
+// onOff returns the API's "on" or "off" for a boolean.
+func onOff(on bool) string {
+	if on {
+		return "on"
+	}
+	return "off"
+}
@@ -0,0 +1,84 @@
+package cloudflare
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPageRuleActionBuilders(t *testing.T) {
+	rule := testPageRule
+	rule.Actions = []PageRuleAction{
+		NewForwardingURLAction(302, "https://example.com/$1"),
+		NewCacheLevelAction(CacheLevelBypass),
+		NewSecurityLevelAction(SecurityLevelHigh),
+		NewSSLAction(SSLModeStrict),
+		NewEdgeCacheTTLAction(2 * time.Hour),
+		NewBrowserCacheTTLAction(90 * time.Second),
+		NewToggleAction("always_online", true),
+		NewToggleAction("email_obfuscation", false),
+		NewAlwaysUseHTTPSAction(),
+		NewDisableSecurityAction(),
+	}
+	assert.NoError(t, rule.Validate())
+
+	b, err := json.Marshal(rule.Actions)
+	if assert.NoError(t, err) {
+		assert.JSONEq(t, `[
+			{"id": "forwarding_url", "value": {"url": "https://example.com/$1", "status_code": 302}},
+			{"id": "cache_level", "value": "bypass"},
+			{"id": "security_level", "value": "high"},
+			{"id": "ssl", "value": "strict"},
+			{"id": "edge_cache_ttl", "value": 7200},
+			{"id": "browser_cache_ttl", "value": 90},
+			{"id": "always_online", "value": "on"},
+			{"id": "email_obfuscation", "value": "off"},
+			{"id": "always_use_https", "value": null},
+			{"id": "disable_security", "value": null}
+		]`, string(b))
+	}
+
+	rule.Actions = []PageRuleAction{NewForwardingURLAction(200, "https://example.com")}
+	assert.Error(t, rule.Validate())
+}
+
+func TestPageRuleCacheKeyFields(t *testing.T) {
+	fields := PageRuleCacheKeyFields{
+		QueryString: PageRuleCacheKeyQueryString{Include: PageRuleCacheKeyList{"*"}},
+		Header:      PageRuleCacheKeyHeader{Include: PageRuleCacheKeyList{"X-Version"}},
+		User:        PageRuleCacheKeyUser{DeviceType: true},
+	}
+	b, err := json.Marshal(NewCacheKeyFieldsAction(fields))
+	if assert.NoError(t, err) {
+		assert.JSONEq(t, `{"id": "cache_key_fields", "value": {
+			"query_string": {"include": "*", "exclude": []},
+			"header": {"include": ["X-Version"], "exclude": [], "check_presence": []},
+			"cookie": {"include": [], "check_presence": []},
+			"host": {"resolved": false},
+			"user": {"device_type": true, "geo": false, "lang": false}
+		}}`, string(b))
+
+		var action struct {
+			Value PageRuleCacheKeyFields `json:"value"`
+		}
+		assert.NoError(t, json.Unmarshal(b, &action))
+		assert.Equal(t, PageRuleCacheKeyList{"*"}, action.Value.QueryString.Include)
+		assert.Equal(t, PageRuleCacheKeyList{"X-Version"}, action.Value.Header.Include)
+	}
+
+	rule := testPageRule
+	rule.Actions = []PageRuleAction{
+		NewCacheKeyFieldsAction(fields),
+		NewMinifyAction(true, true, false),
+		NewHostHeaderOverrideAction("origin.example.com"),
+		NewResolveOverrideAction("origin.example.com"),
+		NewBypassCacheOnCookieAction("wp-.*"),
+		NewToggleAction("respect_strong_etags", true),
+	}
+	assert.NoError(t, rule.Validate())
+
+	rule.Actions = []PageRuleAction{{ID: "minify", Value: "on"}}
+	assert.Error(t, rule.Validate())
+}
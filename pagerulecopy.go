@@ -0,0 +1,76 @@
+package cloudflare
+
+import "github.com/pkg/errors"
+
+// PageRuleCopyOptions controls the behaviour of CopyPageRules.
+type PageRuleCopyOptions struct {
+	// RewriteHosts rewrites hostnames within the source zone to the
+	// equivalent hostnames within the destination zone, in both targets and
+	// forwarding URLs. Hostnames outside of the source zone are left alone.
+	RewriteHosts bool
+	// Replace deletes rules in the destination zone whose targets are not
+	// among the copied rules. Without it, such rules are left in place.
+	Replace bool
+	// DryRun computes the changes without making them.
+	DryRun bool
+}
+
+// CopyPageRules clones the Page Rules of one zone into another, returning the
+// changes which were made (or which would be made, for a dry run). Rules are
+// matched on their targets as by SyncPageRules, so copying the same rules
+// again only changes rules which differ.
+func (api *API) CopyPageRules(srcZoneID, dstZoneID string, opts PageRuleCopyOptions) ([]ZoneConfigChange, error) {
+	rules, err := api.ListPageRules(srcZoneID)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not list Page Rules")
+	}
+
+	var from, to string
+	if opts.RewriteHosts {
+		src, err := api.ZoneDetails(srcZoneID)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not get source zone details")
+		}
+		dst, err := api.ZoneDetails(dstZoneID)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not get destination zone details")
+		}
+		from, to = src.Name, dst.Name
+	}
+
+	desired := make([]PageRule, len(rules))
+	for i, rule := range rules {
+		if desired[i], err = copyPageRule(rule, from, to); err != nil {
+			return nil, err
+		}
+	}
+	return api.SyncPageRules(dstZoneID, desired, PageRuleSyncOptions{AllowDeletes: opts.Replace, DryRun: opts.DryRun})
+}
+
+// copyPageRule returns a copy of rule, without its identifier and timestamps,
+// with hostnames in the zone from rewritten to the zone to.
+func copyPageRule(rule PageRule, from, to string) (PageRule, error) {
+	copied := PageRule{
+		Targets:  make([]PageRuleTarget, len(rule.Targets)),
+		Actions:  make([]PageRuleAction, len(rule.Actions)),
+		Priority: rule.Priority,
+		Status:   rule.Status,
+	}
+	for i, t := range rule.Targets {
+		t.Constraint.Value = rewriteZoneURLPattern(t.Constraint.Value, from, to)
+		copied.Targets[i] = t
+	}
+	for i, a := range rule.Actions {
+		if a.ID == "forwarding_url" && from != "" {
+			s, err := PageRule{Actions: []PageRuleAction{a}}.Settings()
+			if err != nil {
+				return PageRule{}, err
+			}
+			fwd := *s.ForwardingURL
+			fwd.URL = rewriteZoneURLPattern(fwd.URL, from, to)
+			a.Value = fwd
+		}
+		copied.Actions[i] = a
+	}
+	return copied, nil
+}
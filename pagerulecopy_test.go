@@ -0,0 +1,71 @@
+package cloudflare
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCopyPageRules(t *testing.T) {
+	setup()
+	defer teardown()
+
+	for id, name := range map[string]string{"src": "example.com", "dst": "example.org"} {
+		id, name := id, name
+		mux.HandleFunc("/zones/"+id, func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("content-type", "application/json")
+			fmt.Fprintf(w, `{"success": true, "errors": [], "messages": [], "result": {"id": %q, "name": %q}}`, id, name)
+		})
+	}
+	mux.HandleFunc("/zones/src/pagerules", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": [
+				{"id": "s1", "targets": [{"target": "url", "constraint": {"operator": "matches", "value": "www.example.com/old/*"}}],
+				 "actions": [{"id": "forwarding_url", "value": {"url": "https://www.example.com/new/$1", "status_code": 301}}],
+				 "priority": 1, "status": "active"}
+			]
+		}`)
+	})
+	var created []PageRule
+	mux.HandleFunc("/zones/dst/pagerules", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		switch r.Method {
+		case "GET":
+			fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": []}`)
+		case "POST":
+			var rule PageRule
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&rule))
+			created = append(created, rule)
+			fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"id": "d1"}}`)
+		}
+	})
+
+	changes, err := client.CopyPageRules("src", "dst", PageRuleCopyOptions{RewriteHosts: true, DryRun: true})
+	if assert.NoError(t, err) && assert.Len(t, changes, 1) {
+		assert.Equal(t, ZoneConfigCreate, changes[0].Action)
+	}
+	assert.Empty(t, created)
+
+	_, err = client.CopyPageRules("src", "dst", PageRuleCopyOptions{RewriteHosts: true})
+	if assert.NoError(t, err) && assert.Len(t, created, 1) {
+		assert.Equal(t, "www.example.org/old/*", pageRuleTargetValue(created[0]))
+		s, err := created[0].Settings()
+		if assert.NoError(t, err) && assert.NotNil(t, s.ForwardingURL) {
+			assert.Equal(t, PageRuleForwardingURL{URL: "https://www.example.org/new/$1", StatusCode: 301}, *s.ForwardingURL)
+		}
+	}
+
+	created = nil
+	_, err = client.CopyPageRules("src", "dst", PageRuleCopyOptions{})
+	if assert.NoError(t, err) && assert.Len(t, created, 1) {
+		assert.Equal(t, "www.example.com/old/*", pageRuleTargetValue(created[0]))
+	}
+}
@@ -0,0 +1,79 @@
+package cloudflare
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// PageRuleExportVersion is the version of the document format produced by
+// ExportPageRules. It is incremented whenever the format changes
+// incompatibly.
+const PageRuleExportVersion = 1
+
+// PageRuleExport is a versioned, portable document holding a zone's Page
+// Rules, for promoting rules from one zone to another (e.g. from staging to
+// production). It is encoded as JSON only: there are no YAML tags or YAML
+// encoding, as the package does not depend on a YAML library. JSON is valid
+// YAML, so YAML tools can read an export, with the keys of the JSON tags.
+type PageRuleExport struct {
+	Version    int        `json:"version"`
+	ExportedOn time.Time  `json:"exported_on"`
+	ZoneID     string     `json:"zone_id"`
+	ZoneName   string     `json:"zone_name"`
+	PageRules  []PageRule `json:"page_rules"`
+}
+
+// ExportPageRules gathers the Page Rules of a zone into a document which can
+// be passed to ImportPageRules.
+func (api *API) ExportPageRules(zoneID string) (PageRuleExport, error) {
+	zone, err := api.ZoneDetails(zoneID)
+	if err != nil {
+		return PageRuleExport{}, errors.Wrap(err, "could not get zone details")
+	}
+	rules, err := api.ListPageRules(zoneID)
+	if err != nil {
+		return PageRuleExport{}, errors.Wrap(err, "could not list Page Rules")
+	}
+	if rules == nil {
+		rules = []PageRule{}
+	}
+	return PageRuleExport{
+		Version:    PageRuleExportVersion,
+		ExportedOn: time.Now().UTC(),
+		ZoneID:     zone.ID,
+		ZoneName:   zone.Name,
+		PageRules:  rules,
+	}, nil
+}
+
+// ImportPageRules recreates the Page Rules in a document created by
+// ExportPageRules in the given zone, which need not be the zone it was
+// exported from, returning the changes which were made.
+//
+// Identifiers and timestamps from the source zone are discarded, and targets
+// and forwarding URLs are rewritten from the source zone's name to the
+// destination zone's name.
+// Rules are then matched on their targets as by SyncPageRules: existing rules
+// with the same target are updated. If replace is true, rules in the
+// destination zone which are not in the document are deleted; otherwise they
+// are left in place.
+func (api *API) ImportPageRules(zoneID string, doc PageRuleExport, replace bool) ([]ZoneConfigChange, error) {
+	if doc.Version > PageRuleExportVersion {
+		return nil, errors.Errorf("unsupported page rule export version %d", doc.Version)
+	}
+
+	zone, err := api.ZoneDetails(zoneID)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get zone details")
+	}
+
+	rules := make([]PageRule, len(doc.PageRules))
+	for i, rule := range doc.PageRules {
+		if rules[i], err = copyPageRule(rule, doc.ZoneName, zone.Name); err != nil {
+			return nil, err
+		}
+	}
+
+	return api.SyncPageRules(zoneID, rules, PageRuleSyncOptions{AllowDeletes: replace})
+}
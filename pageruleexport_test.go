@@ -0,0 +1,98 @@
+package cloudflare
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExportAndImportPageRules(t *testing.T) {
+	setup()
+	defer teardown()
+
+	zone := func(id, name string) {
+		mux.HandleFunc("/zones/"+id, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+			w.Header().Set("content-type", "application/json")
+			fmt.Fprintf(w, `{"success": true, "errors": [], "messages": [], "result": {"id": %q, "name": %q}}`, id, name)
+		})
+	}
+	zone("staging", "staging.example")
+	zone("prod", "example.com")
+
+	mux.HandleFunc("/zones/staging/pagerules", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": [
+				{"id": "s1", "targets": [{"target": "url", "constraint": {"operator": "matches", "value": "*staging.example/static/*"}}],
+				 "actions": [{"id": "cache_level", "value": "cache_everything"}], "priority": 1, "status": "active",
+				 "created_on": "2017-01-01T00:00:00Z", "modified_on": "2017-01-01T00:00:00Z"}
+			]
+		}`)
+	})
+
+	var methods []string
+	var created PageRule
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		methods = append(methods, r.Method+" "+r.URL.Path)
+		w.Header().Set("content-type", "application/json")
+		switch r.Method {
+		case "GET":
+			fmt.Fprint(w, `{
+				"success": true,
+				"errors": [],
+				"messages": [],
+				"result": [
+					{"id": "p1", "targets": [{"target": "url", "constraint": {"operator": "matches", "value": "example.com/old/*"}}],
+					 "actions": [{"id": "always_use_https"}], "priority": 1, "status": "active"}
+				]
+			}`)
+		case "POST":
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&created))
+			fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"id": "p2"}}`)
+		default:
+			fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"id": "p1"}}`)
+		}
+	}
+	mux.HandleFunc("/zones/prod/pagerules", handler)
+	mux.HandleFunc("/zones/prod/pagerules/", handler)
+
+	doc, err := client.ExportPageRules("staging")
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, PageRuleExportVersion, doc.Version)
+	assert.Equal(t, "staging.example", doc.ZoneName)
+	assert.Len(t, doc.PageRules, 1)
+
+	// The document survives a round trip through JSON.
+	b, err := json.Marshal(doc)
+	if assert.NoError(t, err) {
+		doc = PageRuleExport{}
+		assert.NoError(t, json.Unmarshal(b, &doc))
+	}
+
+	changes, err := client.ImportPageRules("prod", doc, false)
+	if assert.NoError(t, err) && assert.Len(t, changes, 1) {
+		assert.Equal(t, ZoneConfigCreate, changes[0].Action)
+	}
+	assert.Empty(t, created.ID)
+	assert.True(t, created.CreatedOn.IsZero())
+	assert.Equal(t, "*example.com/static/*", pageRuleTargetValue(created))
+	assert.NotContains(t, methods, "DELETE /zones/prod/pagerules/p1")
+
+	_, err = client.ImportPageRules("prod", doc, true)
+	assert.NoError(t, err)
+	assert.Contains(t, methods, "DELETE /zones/prod/pagerules/p1")
+
+	doc.Version = PageRuleExportVersion + 1
+	_, err = client.ImportPageRules("prod", doc, false)
+	assert.Error(t, err)
+}
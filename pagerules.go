@@ -2,7 +2,10 @@ package cloudflare
 
 import (
 	"encoding/json"
+	"net/url"
+	"reflect"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/pkg/errors"
@@ -29,24 +32,42 @@ Valid IDs are:
 
   always_online
   always_use_https
+  automatic_https_rewrites
   browser_cache_ttl
   browser_check
+  bypass_cache_on_cookie
+  cache_deception_armor
+  cache_key_fields
   cache_level
+  cache_on_cookie
   disable_apps
   disable_performance
   disable_railgun
   disable_security
   edge_cache_ttl
   email_obfuscation
+  explicit_cache_control
   forwarding_url
+  host_header_override
   ip_geolocation
+  minify
   mirage
+  opportunistic_encryption
+  origin_error_page_pass_thru
+  resolve_override
+  respect_strong_etags
+  response_buffering
   rocket_loader
   security_level
   server_side_exclude
   smart_errors
+  sort_query_string_for_cache
   ssl
+  true_client_ip_header
   waf
+
+Constructors such as NewCacheLevelAction and NewForwardingURLAction return
+actions with correctly typed values.
 */
 type PageRuleAction struct {
 	ID    string      `json:"id"`
@@ -55,42 +76,71 @@ type PageRuleAction struct {
 
 // PageRuleActions maps API action IDs to human-readable strings
 var PageRuleActions = map[string]string{
-	"always_online":       "Always Online",            // Value of type string
-	"always_use_https":    "Always Use HTTPS",         // Value of type interface{}
-	"browser_cache_ttl":   "Browser Cache TTL",        // Value of type int
-	"browser_check":       "Browser Integrity Check",  // Value of type string
-	"cache_level":         "Cache Level",              // Value of type string
-	"disable_apps":        "Disable Apps",             // Value of type interface{}
-	"disable_performance": "Disable Performance",      // Value of type interface{}
-	"disable_railgun":     "Disable Railgun",          // Value of type string
-	"disable_security":    "Disable Security",         // Value of type interface{}
-	"edge_cache_ttl":      "Edge Cache TTL",           // Value of type int
-	"email_obfuscation":   "Email Obfuscation",        // Value of type string
-	"forwarding_url":      "Forwarding URL",           // Value of type map[string]interface
-	"ip_geolocation":      "IP Geolocation Header",    // Value of type string
-	"mirage":              "Mirage",                   // Value of type string
-	"rocket_loader":       "Rocker Loader",            // Value of type string
-	"security_level":      "Security Level",           // Value of type string
-	"server_side_exclude": "Server Side Excludes",     // Value of type string
-	"smart_errors":        "Smart Errors",             // Value of type string
-	"ssl":                 "SSL",                      // Value of type string
-	"waf":                 "Web Application Firewall", // Value of type string
+	"always_online":               "Always Online",               // Value of type string
+	"always_use_https":            "Always Use HTTPS",            // Value of type interface{}
+	"automatic_https_rewrites":    "Automatic HTTPS Rewrites",    // Value of type string
+	"browser_cache_ttl":           "Browser Cache TTL",           // Value of type int
+	"browser_check":               "Browser Integrity Check",     // Value of type string
+	"bypass_cache_on_cookie":      "Bypass Cache on Cookie",      // Value of type string
+	"cache_deception_armor":       "Cache Deception Armor",       // Value of type string
+	"cache_key_fields":            "Custom Cache Key",            // Value of type PageRuleCacheKeyFields
+	"cache_level":                 "Cache Level",                 // Value of type string
+	"cache_on_cookie":             "Cache on Cookie",             // Value of type string
+	"disable_apps":                "Disable Apps",                // Value of type interface{}
+	"disable_performance":         "Disable Performance",         // Value of type interface{}
+	"disable_railgun":             "Disable Railgun",             // Value of type string
+	"disable_security":            "Disable Security",            // Value of type interface{}
+	"edge_cache_ttl":              "Edge Cache TTL",              // Value of type int
+	"email_obfuscation":           "Email Obfuscation",           // Value of type string
+	"explicit_cache_control":      "Origin Cache Control",        // Value of type string
+	"forwarding_url":              "Forwarding URL",              // Value of type PageRuleForwardingURL
+	"host_header_override":        "Host Header Override",        // Value of type string
+	"ip_geolocation":              "IP Geolocation Header",       // Value of type string
+	"minify":                      "Minify",                      // Value of type PageRuleMinify
+	"mirage":                      "Mirage",                      // Value of type string
+	"opportunistic_encryption":    "Opportunistic Encryption",    // Value of type string
+	"origin_error_page_pass_thru": "Origin Error Page Pass-thru", // Value of type string
+	"resolve_override":            "Resolve Override",            // Value of type string
+	"respect_strong_etags":        "Respect Strong ETags",        // Value of type string
+	"response_buffering":          "Response Buffering",          // Value of type string
+	"rocket_loader":               "Rocker Loader",               // Value of type string
+	"security_level":              "Security Level",              // Value of type string
+	"server_side_exclude":         "Server Side Excludes",        // Value of type string
+	"smart_errors":                "Smart Errors",                // Value of type string
+	"sort_query_string_for_cache": "Query String Sort",           // Value of type string
+	"ssl":                         "SSL",                         // Value of type string
+	"true_client_ip_header":       "True Client IP Header",       // Value of type string
+	"waf":                         "Web Application Firewall",    // Value of type string
 }
 
-type MaybeInt int
+// PageRuleStatus is whether a page rule is applied.
+type PageRuleStatus string
+
+// Page rule statuses.
+const (
+	PageRuleStatusActive PageRuleStatus = "active"
+	PageRuleStatusPaused PageRuleStatus = "paused"
+)
 
 // PageRule describes a Page Rule.
+//
+// Priorities start at 1, and rules with higher priorities take precedence. A
+// zero Priority is not sent to the API, so that creating a rule without one
+// lets the API choose it, and updating a rule without one keeps the existing
+// priority rather than reordering the zone's rules.
 type PageRule struct {
 	ID         string           `json:"id,omitempty"`
 	Targets    []PageRuleTarget `json:"targets"`
 	Actions    []PageRuleAction `json:"actions"`
-	Priority   MaybeInt         `json:"priority"`
-	Status     string           `json:"status"` // can be: active, paused
+	Priority   FlexibleInt      `json:"priority,omitempty"`
+	Status     PageRuleStatus   `json:"status"`
 	ModifiedOn time.Time        `json:"modified_on,omitempty"`
 	CreatedOn  time.Time        `json:"created_on,omitempty"`
 }
 
 // PageRuleDetailResponse is the API response, containing a single PageRule.
+//
+// Deprecated: see Response.
 type PageRuleDetailResponse struct {
 	Success  bool     `json:"success"`
 	Errors   []string `json:"errors"`
@@ -99,55 +149,201 @@ type PageRuleDetailResponse struct {
 }
 
 // PageRulesResponse is the API response, containing an array of PageRules.
+//
+// Deprecated: see Response.
 type PageRulesResponse struct {
-	Success  bool       `json:"success"`
-	Errors   []string   `json:"errors"`
-	Messages []string   `json:"messages"`
-	Result   []PageRule `json:"result"`
+	Success    bool       `json:"success"`
+	Errors     []string   `json:"errors"`
+	Messages   []string   `json:"messages"`
+	Result     []PageRule `json:"result"`
+	ResultInfo ResultInfo `json:"result_info"`
 }
 
-/*
-Since the CF API switches between quoted and unquoted ints depending on the
-request, this attempts to figure out which form it is in
-*/
-func (f *MaybeInt) UnmarshalJSON(rawData []byte) (err error) {
-	var v int
+// pageRuleNumericActions are the page rule actions whose values are numbers,
+// pageRuleObjectActions those whose values are objects, and
+// pageRuleValuelessActions those which take no value.
+var (
+	pageRuleNumericActions = map[string]bool{
+		"browser_cache_ttl": true,
+		"edge_cache_ttl":    true,
+	}
+	pageRuleObjectActions = map[string]bool{
+		"cache_key_fields": true,
+		"minify":           true,
+	}
+	pageRuleValuelessActions = map[string]bool{
+		"always_use_https":    true,
+		"disable_apps":        true,
+		"disable_performance": true,
+		"disable_security":    true,
+	}
+)
 
-	data := string(rawData)
+// Validate checks that the page rule is complete enough to be created: that
+// it has url targets with valid patterns to match (see
+// ValidatePageRulePattern), and actions with known IDs and values of the
+// right type. It is called by CreatePageRule, which returns its error wrapped
+// in a *UserError without calling the API. UpdatePageRule makes the same
+// checks, except that it allows actions missing from PageRuleActions, so that
+// rules read from the API can be written back unchanged.
+func (r PageRule) Validate() error {
+	return r.validate(false)
+}
 
-	// If the value is quoted, remove quotes
-	var js string
-	if json.Unmarshal(rawData, &js) == nil {
-		data, err = strconv.Unquote(string(rawData))
-		if err != nil {
-			return err
+// validate implements Validate, ignoring actions with unknown IDs if
+// allowUnknown is set.
+func (r PageRule) validate(allowUnknown bool) error {
+	if len(r.Targets) == 0 {
+		return errors.New("page rule has no targets")
+	}
+	for i, t := range r.Targets {
+		if t.Target != "url" {
+			return errors.Errorf("page rule target %d: target must be \"url\", not %q", i, t.Target)
+		}
+		if t.Constraint.Operator != "matches" {
+			return errors.Errorf("page rule target %d: operator must be \"matches\", not %q", i, t.Constraint.Operator)
+		}
+		if err := ValidatePageRulePattern(t.Constraint.Value); err != nil {
+			return errors.Wrapf(err, "page rule target %d", i)
+		}
+	}
+
+	if len(r.Actions) == 0 {
+		return errors.New("page rule has no actions")
+	}
+	for i, a := range r.Actions {
+		if _, ok := PageRuleActions[a.ID]; !ok {
+			if allowUnknown {
+				continue
+			}
+			return errors.Errorf("page rule action %d: unknown action %q", i, a.ID)
+		}
+		if err := validatePageRuleActionValue(a); err != nil {
+			return errors.Wrapf(err, "page rule action %d (%s)", i, a.ID)
+		}
+	}
+
+	switch r.Status {
+	case "", PageRuleStatusActive, PageRuleStatusPaused:
+	default:
+		return errors.Errorf("page rule status must be %q or %q, not %q", PageRuleStatusActive, PageRuleStatusPaused, r.Status)
+	}
+	if r.Priority < 0 {
+		return errors.Errorf("page rule priority must not be negative, not %d", r.Priority)
+	}
+	return nil
+}
+
+// validatePageRuleActionValue checks that the value of a page rule action has
+// the type the API expects for it.
+func validatePageRuleActionValue(a PageRuleAction) error {
+	if pageRuleValuelessActions[a.ID] {
+		return nil
+	}
+	if a.Value == nil {
+		return errors.New("value must be set")
+	}
+	v := reflect.ValueOf(a.Value)
+	switch {
+	case pageRuleNumericActions[a.ID]:
+		switch v.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+			reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+			reflect.Float32, reflect.Float64:
+			return nil
+		}
+		return errors.Errorf("value must be a number, not %T", a.Value)
+	case pageRuleObjectActions[a.ID]:
+		switch v.Kind() {
+		case reflect.Struct, reflect.Map:
+			return nil
+		}
+		return errors.Errorf("value must be an object, not %T", a.Value)
+	case a.ID == "forwarding_url":
+		return validateForwardingURL(a.Value)
+	}
+	if v.Kind() != reflect.String {
+		return errors.Errorf("value must be a string, not %T", a.Value)
+	}
+	if v.String() == "" {
+		return errors.New("value must not be empty")
+	}
+	return nil
+}
+
+// validateForwardingURL checks the value of a forwarding_url action, which the
+// API otherwise rejects with an opaque error 1004. It must be an object with a
+// status_code of 301 or 302 and an absolute http or https url.
+func validateForwardingURL(value interface{}) error {
+	var fwd map[string]interface{}
+	b, err := json.Marshal(value)
+	if err != nil || json.Unmarshal(b, &fwd) != nil || fwd == nil {
+		return errors.Errorf("value must be an object with url and status_code fields, not %T", value)
+	}
+
+	switch code := fwd["status_code"].(type) {
+	case nil:
+		return errors.New("status_code must be set to 301 or 302")
+	case float64:
+		if code != 301 && code != 302 {
+			return errors.Errorf("status_code must be 301 or 302, not %v", code)
 		}
+	default:
+		return errors.Errorf("status_code must be the number 301 or 302, not %v", code)
 	}
 
-	err = json.Unmarshal([]byte(data), &v)
-	*f = MaybeInt(v)
-	return err
+	switch u := fwd["url"].(type) {
+	case nil:
+		return errors.New("url must be set")
+	case string:
+		if u == "" {
+			return errors.New("url must not be empty")
+		}
+		parsed, err := url.Parse(u)
+		if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+			return errors.Errorf("url must be an absolute http or https URL, not %q", u)
+		}
+	default:
+		return errors.Errorf("url must be a string, not %v", u)
+	}
+	return nil
 }
 
 /*
-CreatePageRule creates a new Page Rule for a zone.
+CreatePageRule creates a new Page Rule for a zone. The rule is checked with
+Validate first.
 
 API reference:
   https://api.cloudflare.com/#page-rules-for-a-zone-create-a-page-rule
   POST /zones/:zone_identifier/pagerules
 */
 func (api *API) CreatePageRule(zoneID string, rule PageRule) (PageRule, error) {
+	if err := rule.Validate(); err != nil {
+		return PageRule{}, &UserError{Err: err}
+	}
 	uri := "/zones/" + zoneID + "/pagerules"
-	res, err := api.makeRequest("POST", uri, rule)
-	if err != nil {
-		return PageRule{}, errors.Wrap(err, errMakeRequestError)
+	var result PageRule
+	create := func() error {
+		_, err := api.makeRequestResult("POST", uri, rule, &result)
+		return err
 	}
-	var r PageRuleDetailResponse
-	err = json.Unmarshal(res, &r)
-	if err != nil {
-		return PageRule{}, errors.Wrap(err, errUnmarshalError)
+	find := func() (bool, error) {
+		rules, err := api.ListPageRules(zoneID)
+		if err != nil {
+			return false, err
+		}
+		for _, r := range rules {
+			if jsonEqual(r.Targets, rule.Targets) && jsonEqual(r.Actions, rule.Actions) {
+				result = r
+				return true, nil
+			}
+		}
+		return false, nil
 	}
-	return r.Result, nil
+	if err := api.createVerified(create, find); err != nil {
+		return PageRule{}, err
+	}
+	return result, nil
 }
 
 /*
@@ -158,17 +354,73 @@ API reference:
   GET /zones/:zone_identifier/pagerules
 */
 func (api *API) ListPageRules(zoneID string) ([]PageRule, error) {
+	return api.ListPageRulesWithParams(zoneID, PageRuleListParams{})
+}
+
+// PageRuleListParams filters and orders the page rules returned by
+// ListPageRulesWithParams. Empty fields are left to the API's defaults.
+type PageRuleListParams struct {
+	Status PageRuleStatus
+	// Order is the field to sort by: "status" or "priority".
+	Order string
+	// Direction is the sort direction: "asc" or "desc".
+	Direction string
+	// Match is whether rules must match "all" the filters or "any" of them.
+	Match string
+}
+
+func (p PageRuleListParams) encode() string {
+	v := url.Values{}
+	if p.Status != "" {
+		v.Set("status", string(p.Status))
+	}
+	if p.Order != "" {
+		v.Set("order", p.Order)
+	}
+	if p.Direction != "" {
+		v.Set("direction", p.Direction)
+	}
+	if p.Match != "" {
+		v.Set("match", p.Match)
+	}
+	return v.Encode()
+}
+
+// ListPageRulesWithParams returns the page rules of a zone, filtered and
+// ordered by params, e.g. only active rules sorted by priority.
+//
+// API reference: https://api.cloudflare.com/#page-rules-for-a-zone-list-page-rules
+func (api *API) ListPageRulesWithParams(zoneID string, params PageRuleListParams) ([]PageRule, error) {
 	uri := "/zones/" + zoneID + "/pagerules"
-	res, err := api.makeRequest("GET", uri, nil)
-	if err != nil {
-		return []PageRule{}, errors.Wrap(err, errMakeRequestError)
+	if q := params.encode(); q != "" {
+		uri += "?" + q
+	}
+	var result []PageRule
+	if _, err := api.makeRequestResult("GET", uri, nil, &result); err != nil {
+		return []PageRule{}, err
 	}
-	var r PageRulesResponse
-	err = json.Unmarshal(res, &r)
+	return result, nil
+}
+
+// StreamPageRules is like ListPageRules, but calls fn with each page rule as
+// the response is decoded rather than returning them all at once. An error
+// returned by fn stops decoding and is returned.
+//
+// API reference: https://api.cloudflare.com/#page-rules-for-a-zone-list-page-rules
+func (api *API) StreamPageRules(zoneID string, fn func(PageRule) error) error {
+	body, err := api.makeRequestStream("GET", "/zones/"+zoneID+"/pagerules", nil, nil)
 	if err != nil {
-		return []PageRule{}, errors.Wrap(err, errUnmarshalError)
+		return errors.Wrap(err, errMakeRequestError)
 	}
-	return r.Result, nil
+	defer body.Close()
+
+	return decodeResultItems(body, api.json(), nil, func(item json.RawMessage) error {
+		var rule PageRule
+		if err := api.json().Unmarshal(item, &rule); err != nil {
+			return errors.Wrap(err, errUnmarshalError)
+		}
+		return fn(rule)
+	})
 }
 
 /*
@@ -180,60 +432,113 @@ API reference:
 */
 func (api *API) PageRule(zoneID, ruleID string) (PageRule, error) {
 	uri := "/zones/" + zoneID + "/pagerules/" + ruleID
-	res, err := api.makeRequest("GET", uri, nil)
-	if err != nil {
-		return PageRule{}, errors.Wrap(err, errMakeRequestError)
+	var result PageRule
+	if _, err := api.makeRequestResult("GET", uri, nil, &result); err != nil {
+		return PageRule{}, err
 	}
-	var r PageRuleDetailResponse
-	err = json.Unmarshal(res, &r)
+	return result, nil
+}
+
+// PageRuleNotFoundError is returned by FindPageRuleByTarget when no page rule
+// has the given URL pattern.
+type PageRuleNotFoundError struct {
+	ZoneID string
+	Target string
+}
+
+func (e *PageRuleNotFoundError) Error() string {
+	return "no page rule in zone " + e.ZoneID + " matches " + strconv.Quote(e.Target)
+}
+
+// AmbiguousPageRuleError is returned by FindPageRuleByTarget when more than
+// one page rule has the given URL pattern.
+type AmbiguousPageRuleError struct {
+	ZoneID string
+	Target string
+	IDs    []string
+}
+
+func (e *AmbiguousPageRuleError) Error() string {
+	return "page rule target " + strconv.Quote(e.Target) + " is ambiguous, matching IDs " + strings.Join(e.IDs, ", ")
+}
+
+// FindPageRuleByTarget returns the page rule of a zone whose target is the
+// URL pattern urlPattern, such as "*example.com/images/*". The pattern must
+// match exactly: it is compared with the rule's constraint value, not matched
+// against it.
+//
+// If no rule has the pattern, a *PageRuleNotFoundError is returned. If more
+// than one rule has it, an *AmbiguousPageRuleError is returned.
+func (api *API) FindPageRuleByTarget(zoneID, urlPattern string) (PageRule, error) {
+	rules, err := api.ListPageRules(zoneID)
 	if err != nil {
-		return PageRule{}, errors.Wrap(err, errUnmarshalError)
+		return PageRule{}, err
 	}
-	return r.Result, nil
+	var found []PageRule
+	for _, r := range rules {
+		if pageRuleTargetValue(r) == urlPattern {
+			found = append(found, r)
+		}
+	}
+	switch len(found) {
+	case 0:
+		return PageRule{}, &PageRuleNotFoundError{ZoneID: zoneID, Target: urlPattern}
+	case 1:
+		return found[0], nil
+	}
+	ids := make([]string, len(found))
+	for i, r := range found {
+		ids[i] = r.ID
+	}
+	return PageRule{}, &AmbiguousPageRuleError{ZoneID: zoneID, Target: urlPattern, IDs: ids}
 }
 
 /*
 ChangePageRule lets change individual settings for a Page Rule. This is in
-contrast to UpdatePageRule which replaces the entire Page Rule.
+contrast to UpdatePageRule which replaces the entire Page Rule. The values of
+any known actions given are checked as by Validate first.
 
 API reference:
   https://api.cloudflare.com/#page-rules-for-a-zone-change-a-page-rule
   PATCH /zones/:zone_identifier/pagerules/:identifier
 */
 func (api *API) ChangePageRule(zoneID, ruleID string, rule PageRule) (PageRule, error) {
-	uri := "/zones/" + zoneID + "/pagerules/" + ruleID
-	res, err := api.makeRequest("PATCH", uri, rule)
-	if err != nil {
-		return PageRule{}, errors.Wrap(err, errMakeRequestError)
+	for i, a := range rule.Actions {
+		if _, ok := PageRuleActions[a.ID]; !ok {
+			continue
+		}
+		if err := validatePageRuleActionValue(a); err != nil {
+			return PageRule{}, &UserError{Err: errors.Wrapf(err, "page rule action %d (%s)", i, a.ID)}
+		}
 	}
-	var r PageRuleDetailResponse
-	err = json.Unmarshal(res, &r)
-	if err != nil {
-		return PageRule{}, errors.Wrap(err, errUnmarshalError)
+	uri := "/zones/" + zoneID + "/pagerules/" + ruleID
+	var result PageRule
+	if _, err := api.makeRequestResult("PATCH", uri, rule, &result); err != nil {
+		return PageRule{}, err
 	}
-	return r.Result, nil
+	return result, nil
 }
 
 /*
 UpdatePageRule lets you replace a Page Rule. This is in contrast to
-ChangePageRule which lets you change individual settings.
+ChangePageRule which lets you change individual settings. The rule is checked
+as by Validate first, except that actions with IDs missing from
+PageRuleActions are allowed.
 
 API reference:
   https://api.cloudflare.com/#page-rules-for-a-zone-update-a-page-rule
   PUT /zones/:zone_identifier/pagerules/:identifier
 */
 func (api *API) UpdatePageRule(zoneID, ruleID string, rule PageRule) (PageRule, error) {
-	uri := "/zones/" + zoneID + "/pagerules/" + ruleID
-	res, err := api.makeRequest("PUT", uri, rule)
-	if err != nil {
-		return PageRule{}, errors.Wrap(err, errMakeRequestError)
+	if err := rule.validate(true); err != nil {
+		return PageRule{}, &UserError{Err: err}
 	}
-	var r PageRuleDetailResponse
-	err = json.Unmarshal(res, &r)
-	if err != nil {
-		return PageRule{}, errors.Wrap(err, errUnmarshalError)
+	uri := "/zones/" + zoneID + "/pagerules/" + ruleID
+	var result PageRule
+	if _, err := api.makeRequestResult("PUT", uri, rule, &result); err != nil {
+		return PageRule{}, err
 	}
-	return r.Result, nil
+	return result, nil
 }
 
 /*
@@ -245,14 +550,36 @@ API reference:
 */
 func (api *API) DeletePageRule(zoneID, ruleID string) error {
 	uri := "/zones/" + zoneID + "/pagerules/" + ruleID
-	res, err := api.makeRequest("DELETE", uri, nil)
-	if err != nil {
-		return errors.Wrap(err, errMakeRequestError)
-	}
-	var r PageRuleDetailResponse
-	err = json.Unmarshal(res, &r)
-	if err != nil {
-		return errors.Wrap(err, errUnmarshalError)
+	if _, err := api.makeRequestResult("DELETE", uri, nil, nil); err != nil {
+		return err
 	}
 	return nil
 }
+
+// PageRuleSetting is a page rule action which a zone's plan supports, with
+// the values it accepts.
+type PageRuleSetting struct {
+	ID         string                    `json:"id"`
+	Properties []PageRuleSettingProperty `json:"properties"`
+}
+
+// PageRuleSettingProperty describes a value accepted by a page rule action.
+// Type is e.g. "select", in which case Choices lists the valid values.
+type PageRuleSettingProperty struct {
+	Name    string        `json:"name"`
+	Type    string        `json:"type"`
+	Choices []interface{} `json:"choices,omitempty"`
+}
+
+// ListAvailablePageRuleSettings returns the page rule actions which a zone's
+// plan supports, unlike PageRuleActions, which lists every action known to
+// this package.
+//
+// API reference: https://api.cloudflare.com/#page-rules-for-a-zone-list-available-page-rules-settings
+func (api *API) ListAvailablePageRuleSettings(zoneID string) ([]PageRuleSetting, error) {
+	var settings []PageRuleSetting
+	if _, err := api.makeRequestResult("GET", "/zones/"+zoneID+"/pagerules/settings", nil, &settings); err != nil {
+		return nil, err
+	}
+	return settings, nil
+}
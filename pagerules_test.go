@@ -0,0 +1,277 @@
+package cloudflare
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPageRuleValidate(t *testing.T) {
+	assert.NoError(t, testPageRule.Validate())
+
+	valid := func(actions ...PageRuleAction) PageRule {
+		r := testPageRule
+		r.Actions = actions
+		return r
+	}
+	assert.NoError(t, valid(
+		PageRuleAction{ID: "cache_level", Value: CacheLevelCacheEverything},
+		PageRuleAction{ID: "edge_cache_ttl", Value: 7200},
+		PageRuleAction{ID: "always_use_https"},
+		PageRuleAction{ID: "forwarding_url", Value: map[string]interface{}{"url": "https://example.com/$1", "status_code": 301}},
+	).Validate())
+
+	for name, rule := range map[string]PageRule{
+		"no targets":     {Actions: testPageRule.Actions},
+		"no actions":     valid(),
+		"unknown action": valid(PageRuleAction{ID: "cache_everything", Value: "on"}),
+		"missing value":  valid(PageRuleAction{ID: "ssl"}),
+		"string ttl":     valid(PageRuleAction{ID: "browser_cache_ttl", Value: "3600"}),
+		"numeric level":  valid(PageRuleAction{ID: "security_level", Value: 3}),
+		"bad forward":    valid(PageRuleAction{ID: "forwarding_url", Value: map[string]interface{}{"url": "https://example.com", "status_code": 200}}),
+	} {
+		assert.Error(t, rule.Validate(), name)
+	}
+
+	r := testPageRule
+	r.Status = "disabled"
+	assert.EqualError(t, r.Validate(), `page rule status must be "active" or "paused", not "disabled"`)
+
+	r = testPageRule
+	r.Targets = append(r.Targets[:0:0], r.Targets...)
+	r.Targets[0].Constraint.Value = ""
+	assert.EqualError(t, r.Validate(), "page rule target 0: URL pattern must not be empty")
+}
+
+func TestPageRuleValidateForwardingURL(t *testing.T) {
+	forward := func(value interface{}) PageRule {
+		r := testPageRule
+		r.Actions = []PageRuleAction{{ID: "forwarding_url", Value: value}}
+		return r
+	}
+	assert.NoError(t, forward(PageRuleForwardingURL{URL: "http://example.com/$1", StatusCode: 302}).Validate())
+
+	for value, want := range map[interface{}]string{
+		"https://example.com":                                              "value must be an object with url and status_code fields, not string",
+		PageRuleForwardingURL{URL: "https://example.com"}:                  "status_code must be 301 or 302, not 0",
+		PageRuleForwardingURL{URL: "https://example.com", StatusCode: 200}: "status_code must be 301 or 302, not 200",
+		PageRuleForwardingURL{StatusCode: 301}:                             "url must not be empty",
+		PageRuleForwardingURL{URL: "example.com/new", StatusCode: 301}:     `url must be an absolute http or https URL, not "example.com/new"`,
+	} {
+		assert.EqualError(t, forward(value).Validate(), "page rule action 0 (forwarding_url): "+want)
+	}
+
+	for value, want := range map[string]string{
+		`{"status_code": 301}`:                           "url must be set",
+		`{"url": 1, "status_code": 301}`:                 "url must be a string, not 1",
+		`{"url": "https://a.com", "status_code": "301"}`: "status_code must be the number 301 or 302, not 301",
+	} {
+		var v map[string]interface{}
+		assert.NoError(t, json.Unmarshal([]byte(value), &v))
+		assert.EqualError(t, forward(v).Validate(), "page rule action 0 (forwarding_url): "+want)
+	}
+}
+
+func TestChangePageRuleInvalid(t *testing.T) {
+	setup()
+	defer teardown()
+
+	_, err := client.ChangePageRule("z1", "r1", PageRule{Actions: []PageRuleAction{
+		NewForwardingURLAction(200, "https://example.com"),
+	}})
+	assert.IsType(t, &UserError{}, err)
+}
+
+func TestUpdatePageRuleUnknownAction(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/z1/pagerules/r1", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PUT", r.Method)
+		b, _ := ioutil.ReadAll(r.Body)
+		assert.Contains(t, string(b), `"id":"new_action"`)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"id": "r1"}}`)
+	})
+
+	// An action this package doesn't know of, as read back from the API, is
+	// written back rather than rejected.
+	rule := testPageRule
+	rule.Actions = append(rule.Actions[:0:0], rule.Actions...)
+	rule.Actions = append(rule.Actions, PageRuleAction{ID: "new_action", Value: map[string]interface{}{"enabled": true}})
+	assert.Error(t, rule.Validate())
+	_, err := client.UpdatePageRule("z1", "r1", rule)
+	assert.NoError(t, err)
+
+	_, err = client.CreatePageRule("z1", rule)
+	assert.IsType(t, &UserError{}, err)
+}
+
+func TestCreatePageRuleInvalid(t *testing.T) {
+	setup()
+	defer teardown()
+
+	// No handler is registered, so any request would fail with a 404.
+	_, err := client.CreatePageRule("z1", PageRule{Targets: testPageRule.Targets})
+	if assert.Error(t, err) {
+		_, ok := err.(*UserError)
+		assert.True(t, ok)
+		assert.Equal(t, "page rule has no actions", err.Error())
+	}
+}
+
+func TestListAvailablePageRuleSettings(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/z1/pagerules/settings", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": [
+				{"id": "always_online", "properties": [{"name": "value", "type": "select", "choices": ["on", "off"]}]},
+				{"id": "browser_cache_ttl", "properties": [{"name": "value", "type": "select", "choices": [30, 60, 120]}]}
+			]
+		}`)
+	})
+
+	settings, err := client.ListAvailablePageRuleSettings("z1")
+	if assert.NoError(t, err) && assert.Len(t, settings, 2) {
+		assert.Equal(t, PageRuleSetting{
+			ID:         "always_online",
+			Properties: []PageRuleSettingProperty{{Name: "value", Type: "select", Choices: []interface{}{"on", "off"}}},
+		}, settings[0])
+		assert.Equal(t, []interface{}{float64(30), float64(60), float64(120)}, settings[1].Properties[0].Choices)
+	}
+}
+
+func TestListPageRulesWithParams(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var query string
+	mux.HandleFunc("/zones/z1/pagerules", func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.RawQuery
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": [{"id": "r1", "status": "active", "priority": 1}]}`)
+	})
+
+	rules, err := client.ListPageRulesWithParams("z1", PageRuleListParams{
+		Status:    PageRuleStatusActive,
+		Order:     "priority",
+		Direction: "asc",
+		Match:     "all",
+	})
+	if assert.NoError(t, err) && assert.Len(t, rules, 1) {
+		assert.Equal(t, "r1", rules[0].ID)
+	}
+	assert.Equal(t, "direction=asc&match=all&order=priority&status=active", query)
+
+	_, err = client.ListPageRules("z1")
+	assert.NoError(t, err)
+	assert.Equal(t, "", query)
+}
+
+func TestReorderPriorities(t *testing.T) {
+	for _, tc := range []struct {
+		current, want []int
+	}{
+		{[]int{3, 2, 1}, []int{3, 2, 1}},
+		{[]int{10, 5, 1}, []int{10, 5, 1}},
+		{[]int{1, 2, 3}, []int{5, 4, 3}},
+		{[]int{10, 1, 5}, []int{10, 9, 5}},
+		{[]int{1, 10, 5}, []int{11, 10, 5}},
+		{[]int{5, 4, 6}, []int{5, 4, 3}},
+		{[]int{2, 1, 3}, []int{3, 2, 1}},
+		{[]int{}, []int{}},
+	} {
+		assert.Equal(t, tc.want, reorderPriorities(tc.current), "%v", tc.current)
+	}
+}
+
+func TestReorderPageRules(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/z1/pagerules", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": [
+				{"id": "a", "priority": 3},
+				{"id": "b", "priority": 2},
+				{"id": "c", "priority": 1}
+			]
+		}`)
+	})
+	patches := make(map[string]string)
+	for _, id := range []string{"a", "b", "c"} {
+		id := id
+		mux.HandleFunc("/zones/z1/pagerules/"+id, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "PATCH", r.Method, "Expected method 'PATCH', got %s", r.Method)
+			body, _ := ioutil.ReadAll(r.Body)
+			patches[id] = strings.TrimSpace(string(body))
+			w.Header().Set("content-type", "application/json")
+			fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {}}`)
+		})
+	}
+
+	// Moving c to the front only needs c's priority to change.
+	assert.NoError(t, client.ReorderPageRules("z1", []string{"c", "a", "b"}))
+	assert.Equal(t, map[string]string{"c": `{"priority":4}`}, patches)
+
+	for name, ids := range map[string][]string{
+		"unknown":   {"a", "b", "d"},
+		"duplicate": {"a", "b", "b"},
+		"missing":   {"a", "b"},
+	} {
+		err := client.ReorderPageRules("z1", ids)
+		_, ok := err.(*UserError)
+		assert.True(t, ok, "%s: %v", name, err)
+	}
+}
+
+func TestFindPageRuleByTarget(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/z1/pagerules", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		target := func(id, pattern string) string {
+			return `{"id": "` + id + `", "targets": [{"target": "url", "constraint": {"operator": "matches", "value": "` + pattern + `"}}]}`
+		}
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": [`+
+			target("a", "*example.com/*")+","+
+			target("b", "example.com/images/*")+","+
+			target("c", "*example.com/blog/*")+","+
+			target("d", "*example.com/blog/*")+`]}`)
+	})
+
+	rule, err := client.FindPageRuleByTarget("z1", "example.com/images/*")
+	if assert.NoError(t, err) {
+		assert.Equal(t, "b", rule.ID)
+	}
+
+	// Patterns are compared, not matched against each other.
+	_, err = client.FindPageRuleByTarget("z1", "example.com/*")
+	if assert.IsType(t, &PageRuleNotFoundError{}, err) {
+		assert.EqualError(t, err, `no page rule in zone z1 matches "example.com/*"`)
+	}
+
+	_, err = client.FindPageRuleByTarget("z1", "*example.com/blog/*")
+	if assert.IsType(t, &AmbiguousPageRuleError{}, err) {
+		assert.Equal(t, []string{"c", "d"}, err.(*AmbiguousPageRuleError).IDs)
+	}
+}
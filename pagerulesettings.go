@@ -0,0 +1,145 @@
+package cloudflare
+
+import (
+	"encoding/json"
+	"reflect"
+
+	"github.com/pkg/errors"
+)
+
+// PageRuleSettings is the typed form of a page rule's actions, one field per
+// action in PageRuleActions. Nil fields, and false ones for actions which
+// take no value, are unset; toggles which the API sets to "on" or "off" are
+// booleans.
+//
+// Two rules with equal settings have the same effect, whatever the order of
+// their actions, so settings can be compared with reflect.DeepEqual.
+type PageRuleSettings struct {
+	AlwaysOnline            *bool                   `pagerule:"always_online"`
+	AlwaysUseHTTPS          bool                    `pagerule:"always_use_https"`
+	AutomaticHTTPSRewrites  *bool                   `pagerule:"automatic_https_rewrites"`
+	BrowserCacheTTL         *int                    `pagerule:"browser_cache_ttl"`
+	BrowserCheck            *bool                   `pagerule:"browser_check"`
+	BypassCacheOnCookie     *string                 `pagerule:"bypass_cache_on_cookie"`
+	CacheDeceptionArmor     *bool                   `pagerule:"cache_deception_armor"`
+	CacheKeyFields          *PageRuleCacheKeyFields `pagerule:"cache_key_fields"`
+	CacheLevel              *CacheLevel             `pagerule:"cache_level"`
+	CacheOnCookie           *string                 `pagerule:"cache_on_cookie"`
+	DisableApps             bool                    `pagerule:"disable_apps"`
+	DisablePerformance      bool                    `pagerule:"disable_performance"`
+	DisableRailgun          *bool                   `pagerule:"disable_railgun"`
+	DisableSecurity         bool                    `pagerule:"disable_security"`
+	EdgeCacheTTL            *int                    `pagerule:"edge_cache_ttl"`
+	EmailObfuscation        *bool                   `pagerule:"email_obfuscation"`
+	ExplicitCacheControl    *bool                   `pagerule:"explicit_cache_control"`
+	ForwardingURL           *PageRuleForwardingURL  `pagerule:"forwarding_url"`
+	HostHeaderOverride      *string                 `pagerule:"host_header_override"`
+	IPGeolocation           *bool                   `pagerule:"ip_geolocation"`
+	Minify                  *PageRuleMinify         `pagerule:"minify"`
+	Mirage                  *bool                   `pagerule:"mirage"`
+	OpportunisticEncryption *bool                   `pagerule:"opportunistic_encryption"`
+	OriginErrorPagePassThru *bool                   `pagerule:"origin_error_page_pass_thru"`
+	ResolveOverride         *string                 `pagerule:"resolve_override"`
+	RespectStrongETags      *bool                   `pagerule:"respect_strong_etags"`
+	ResponseBuffering       *bool                   `pagerule:"response_buffering"`
+	RocketLoader            *bool                   `pagerule:"rocket_loader"`
+	SecurityLevel           *SecurityLevel          `pagerule:"security_level"`
+	ServerSideExclude       *bool                   `pagerule:"server_side_exclude"`
+	SmartErrors             *bool                   `pagerule:"smart_errors"`
+	SortQueryStringForCache *bool                   `pagerule:"sort_query_string_for_cache"`
+	SSL                     *SSLMode                `pagerule:"ssl"`
+	TrueClientIPHeader      *bool                   `pagerule:"true_client_ip_header"`
+	WAF                     *bool                   `pagerule:"waf"`
+}
+
+// Settings decodes the rule's actions into PageRuleSettings. It fails if an
+// action is unknown, is listed twice or has a value of the wrong type.
+func (r PageRule) Settings() (PageRuleSettings, error) {
+	var s PageRuleSettings
+	v := reflect.ValueOf(&s).Elem()
+	fields := pageRuleSettingFields()
+	seen := make(map[string]bool, len(r.Actions))
+	for _, a := range r.Actions {
+		i, ok := fields[a.ID]
+		if !ok {
+			return PageRuleSettings{}, errors.Errorf("unknown page rule action %q", a.ID)
+		}
+		if seen[a.ID] {
+			return PageRuleSettings{}, errors.Errorf("page rule action %q is listed more than once", a.ID)
+		}
+		seen[a.ID] = true
+		if err := decodeSettingValue(v.Field(i), a.Value); err != nil {
+			return PageRuleSettings{}, errors.Wrapf(err, "page rule action %q", a.ID)
+		}
+	}
+	return s, nil
+}
+
+// Actions encodes the settings as page rule actions, in the order of the
+// fields of PageRuleSettings.
+func (s PageRuleSettings) Actions() []PageRuleAction {
+	var actions []PageRuleAction
+	v := reflect.ValueOf(s)
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		id := t.Field(i).Tag.Get("pagerule")
+		f := v.Field(i)
+		switch {
+		case f.Kind() == reflect.Bool:
+			if f.Bool() {
+				actions = append(actions, PageRuleAction{ID: id})
+			}
+		case !f.IsNil():
+			actions = append(actions, PageRuleAction{ID: id, Value: encodeSettingValue(f)})
+		}
+	}
+	return actions
+}
+
+// pageRuleSettingFields maps action IDs to the index of their field in
+// PageRuleSettings.
+func pageRuleSettingFields() map[string]int {
+	t := reflect.TypeOf(PageRuleSettings{})
+	fields := make(map[string]int, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		fields[t.Field(i).Tag.Get("pagerule")] = i
+	}
+	return fields
+}
+
+// decodeSettingValue sets the PageRuleSettings or ZoneSettings field f from
+// an action's or setting's value, which is either as decoded from JSON or as
+// passed to a constructor such as NewCacheLevelAction.
+func decodeSettingValue(f reflect.Value, value interface{}) error {
+	switch {
+	case f.Kind() == reflect.Bool:
+		f.SetBool(true)
+		return nil
+	case f.Type().Elem().Kind() == reflect.Bool:
+		if value != "on" && value != "off" {
+			return errors.Errorf(`value must be "on" or "off", not %v`, value)
+		}
+		on := value == "on"
+		f.Set(reflect.ValueOf(&on))
+		return nil
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	p := reflect.New(f.Type().Elem())
+	if err := json.Unmarshal(data, p.Interface()); err != nil {
+		return err
+	}
+	f.Set(p)
+	return nil
+}
+
+// encodeSettingValue returns the value of the non-nil PageRuleSettings or
+// ZoneSettings pointer field f as sent to the API.
+func encodeSettingValue(f reflect.Value) interface{} {
+	if f.Elem().Kind() == reflect.Bool {
+		return onOff(f.Elem().Bool())
+	}
+	return f.Elem().Interface()
+}
@@ -0,0 +1,76 @@
+package cloudflare
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPageRuleSettings(t *testing.T) {
+	var rule PageRule
+	err := json.Unmarshal([]byte(`{
+		"actions": [
+			{"id": "ssl", "value": "strict"},
+			{"id": "always_online", "value": "off"},
+			{"id": "edge_cache_ttl", "value": 7200},
+			{"id": "always_use_https"},
+			{"id": "forwarding_url", "value": {"url": "https://example.com/$1", "status_code": 301}},
+			{"id": "minify", "value": {"html": "on", "css": "off", "js": "on"}}
+		]
+	}`), &rule)
+	assert.NoError(t, err)
+
+	s, err := rule.Settings()
+	if assert.NoError(t, err) {
+		off, ttl, ssl := false, 7200, SSLModeStrict
+		assert.Equal(t, PageRuleSettings{
+			AlwaysOnline:   &off,
+			AlwaysUseHTTPS: true,
+			EdgeCacheTTL:   &ttl,
+			ForwardingURL:  &PageRuleForwardingURL{URL: "https://example.com/$1", StatusCode: 301},
+			Minify:         &PageRuleMinify{HTML: "on", CSS: "off", JS: "on"},
+			SSL:            &ssl,
+		}, s)
+
+		// Encoding and decoding again gives the same settings, and the
+		// actions encode to the same JSON as the constructors produce.
+		again, err := PageRule{Actions: s.Actions()}.Settings()
+		assert.NoError(t, err)
+		assert.Equal(t, s, again)
+		assert.True(t, jsonEqual(s.Actions(), []PageRuleAction{
+			NewToggleAction("always_online", false),
+			NewAlwaysUseHTTPSAction(),
+			NewEdgeCacheTTLAction(2 * time.Hour),
+			NewForwardingURLAction(301, "https://example.com/$1"),
+			NewMinifyAction(true, false, true),
+			NewSSLAction(SSLModeStrict),
+		}))
+	}
+
+	// Actions built by the constructors decode too.
+	s, err = PageRule{Actions: []PageRuleAction{NewCacheLevelAction(CacheLevelCacheEverything)}}.Settings()
+	if assert.NoError(t, err) && assert.NotNil(t, s.CacheLevel) {
+		assert.Equal(t, CacheLevelCacheEverything, *s.CacheLevel)
+	}
+
+	for name, actions := range map[string][]PageRuleAction{
+		"unknown":   {{ID: "cache_everything", Value: "on"}},
+		"duplicate": {{ID: "waf", Value: "on"}, {ID: "waf", Value: "off"}},
+		"toggle":    {{ID: "waf", Value: true}},
+		"type":      {{ID: "edge_cache_ttl", Value: "7200"}},
+	} {
+		_, err := PageRule{Actions: actions}.Settings()
+		assert.Error(t, err, name)
+	}
+}
+
+func TestPageRuleSettingsCoverActions(t *testing.T) {
+	fields := pageRuleSettingFields()
+	assert.Len(t, fields, len(PageRuleActions))
+	for id := range PageRuleActions {
+		_, ok := fields[id]
+		assert.True(t, ok, "no PageRuleSettings field for %s", id)
+	}
+}
@@ -0,0 +1,147 @@
+package cloudflare
+
+import (
+	"sort"
+	"strconv"
+
+	"github.com/pkg/errors"
+)
+
+// ReorderPageRules orders the page rules of a zone as listed by orderedIDs,
+// which must hold the ID of each of the zone's rules once, with the rule which
+// takes precedence (the one listed first in the dashboard) first. Rules with a
+// higher priority take precedence, so the first rule ends up with the highest
+// priority.
+//
+// Only the priorities which must change are updated: rules already in the
+// right order relative to each other keep their priorities where the gaps
+// between them leave room for the others.
+func (api *API) ReorderPageRules(zoneID string, orderedIDs []string) error {
+	rules, err := api.ListPageRules(zoneID)
+	if err != nil {
+		return err
+	}
+	current := make(map[string]int, len(rules))
+	for _, r := range rules {
+		current[r.ID] = int(r.Priority)
+	}
+	seen := make(map[string]bool, len(orderedIDs))
+	for _, id := range orderedIDs {
+		if _, ok := current[id]; !ok {
+			return &UserError{Err: errors.Errorf("page rule %s does not exist in zone %s", id, zoneID)}
+		}
+		if seen[id] {
+			return &UserError{Err: errors.Errorf("page rule %s is listed more than once", id)}
+		}
+		seen[id] = true
+	}
+	if len(orderedIDs) != len(rules) {
+		return &UserError{Err: errors.Errorf("%d of the zone's %d page rules were listed", len(orderedIDs), len(rules))}
+	}
+
+	priorities := make([]int, len(orderedIDs))
+	for i, id := range orderedIDs {
+		priorities[i] = current[id]
+	}
+	for i, p := range reorderPriorities(priorities) {
+		if p == priorities[i] {
+			continue
+		}
+		params := struct {
+			Priority int `json:"priority"`
+		}{p}
+		uri := "/zones/" + zoneID + "/pagerules/" + orderedIDs[i]
+		if _, err := api.makeRequestResult("PATCH", uri, params, nil); err != nil {
+			return errors.Wrap(err, "could not set priority of page rule "+orderedIDs[i]+" to "+strconv.Itoa(p))
+		}
+	}
+	return nil
+}
+
+// reorderPriorities returns strictly decreasing priorities, all at least 1,
+// for items with the given current priorities, changing as few as possible.
+// It keeps the longest run of current priorities which is already strictly
+// decreasing and fits the others into the gaps, falling back to numbering
+// the items from len(current) down to 1 when the gaps are too small.
+func reorderPriorities(current []int) []int {
+	n := len(current)
+	keep := longestDecreasing(current)
+
+	result := make([]int, n)
+	fits := true
+	prev := -1 // index of the previous kept item
+	for k := 0; k <= len(keep); k++ {
+		next := n // index of the next kept item
+		if k < len(keep) {
+			next = keep[k]
+		}
+		// The items between prev and next need priorities strictly between
+		// their priorities (or unbounded above, and at least 1 below).
+		hi := -1
+		if prev >= 0 {
+			hi = current[prev]
+		}
+		lo := 0
+		if next < n {
+			lo = current[next]
+		}
+		gap := next - prev - 1
+		if hi < 0 {
+			hi = lo + gap + 1
+		}
+		if hi-lo-1 < gap {
+			fits = false
+			break
+		}
+		for i := prev + 1; i < next; i++ {
+			result[i] = hi - (i - prev)
+		}
+		if next < n {
+			result[next] = current[next]
+		}
+		prev = next
+	}
+	if fits {
+		return result
+	}
+
+	for i := range result {
+		result[i] = n - i
+	}
+	return result
+}
+
+// longestDecreasing returns the indices of a longest strictly decreasing
+// subsequence of values whose members are all at least 1.
+func longestDecreasing(values []int) []int {
+	// tails[l] is the index of the last item of the best subsequence of
+	// length l+1 found so far: the one ending with the largest value.
+	var tails []int
+	prev := make([]int, len(values))
+	for i, v := range values {
+		prev[i] = -1
+		if v < 1 {
+			continue
+		}
+		l := sort.Search(len(tails), func(j int) bool {
+			return values[tails[j]] <= v
+		})
+		if l > 0 {
+			prev[i] = tails[l-1]
+		}
+		if l == len(tails) {
+			tails = append(tails, i)
+		} else {
+			tails[l] = i
+		}
+	}
+
+	seq := make([]int, len(tails))
+	if len(tails) == 0 {
+		return seq
+	}
+	for i, k := len(tails)-1, tails[len(tails)-1]; i >= 0; i, k = i-1, prev[k] {
+		seq[i] = k
+	}
+	return seq
+}
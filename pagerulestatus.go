@@ -0,0 +1,91 @@
+package cloudflare
+
+import (
+	"fmt"
+	"sort"
+)
+
+// SetPageRuleStatus pauses or resumes a page rule, leaving the rest of the rule
+// unchanged.
+//
+// API reference: https://api.cloudflare.com/#page-rules-for-a-zone-change-a-page-rule
+func (api *API) SetPageRuleStatus(zoneID, ruleID string, status PageRuleStatus) (PageRule, error) {
+	params := struct {
+		Status PageRuleStatus `json:"status"`
+	}{status}
+	uri := "/zones/" + zoneID + "/pagerules/" + ruleID
+	var result PageRule
+	if _, err := api.makeRequestResult("PATCH", uri, params, &result); err != nil {
+		return PageRule{}, err
+	}
+	return result, nil
+}
+
+// SetPageRulesStatus pauses or resumes many page rules concurrently, backing
+// off when the API's rate limit is exceeded (see Batch). Every change is
+// attempted; the returned map holds the error for each rule ID which could not
+// be changed, and is nil if all were.
+func (api *API) SetPageRulesStatus(zoneID string, ids []string, status PageRuleStatus) map[string]error {
+	return forEachID(ids, func(id string) error {
+		_, err := api.SetPageRuleStatus(zoneID, id, status)
+		return err
+	})
+}
+
+// PageRuleStatusError is returned by PauseAllPageRules and ResumeAllPageRules
+// when the status of some rules could not be changed.
+type PageRuleStatusError struct {
+	Status PageRuleStatus
+	// Errors holds the error for each rule ID which could not be changed.
+	Errors map[string]error
+}
+
+func (e *PageRuleStatusError) Error() string {
+	ids := make([]string, 0, len(e.Errors))
+	for id := range e.Errors {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return fmt.Sprintf("could not set %d page rules to %s: %v", len(ids), e.Status, ids)
+}
+
+// PauseAllPageRules pauses every active page rule of a zone, returning the IDs
+// of the rules it paused so that they alone can be resumed later with
+// SetPageRulesStatus. If some rules could not be paused, the IDs of the others
+// are returned with a *PageRuleStatusError.
+func (api *API) PauseAllPageRules(zoneID string) ([]string, error) {
+	return api.setAllPageRulesStatus(zoneID, PageRuleStatusActive, PageRuleStatusPaused)
+}
+
+// ResumeAllPageRules resumes every paused page rule of a zone, returning the
+// IDs of the rules it resumed. If some rules could not be resumed, the IDs of
+// the others are returned with a *PageRuleStatusError.
+func (api *API) ResumeAllPageRules(zoneID string) ([]string, error) {
+	return api.setAllPageRulesStatus(zoneID, PageRuleStatusPaused, PageRuleStatusActive)
+}
+
+// setAllPageRulesStatus changes the status of every page rule of a zone which
+// has the status from to the status to.
+func (api *API) setAllPageRulesStatus(zoneID string, from, to PageRuleStatus) ([]string, error) {
+	rules, err := api.ListPageRulesWithParams(zoneID, PageRuleListParams{Status: from})
+	if err != nil {
+		return nil, err
+	}
+	var ids []string
+	for _, r := range rules {
+		if r.Status == from {
+			ids = append(ids, r.ID)
+		}
+	}
+	errs := api.SetPageRulesStatus(zoneID, ids, to)
+	if errs == nil {
+		return ids, nil
+	}
+	var changed []string
+	for _, id := range ids {
+		if errs[id] == nil {
+			changed = append(changed, id)
+		}
+	}
+	return changed, &PageRuleStatusError{Status: to, Errors: errs}
+}
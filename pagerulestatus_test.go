@@ -0,0 +1,73 @@
+package cloudflare
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPauseAndResumeAllPageRules(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var mu sync.Mutex
+	status := map[string]string{"r1": "active", "r2": "paused", "r3": "active", "bad": "paused"}
+	mux.HandleFunc("/zones/z1/pagerules", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		want := r.URL.Query().Get("status")
+		w.Header().Set("content-type", "application/json")
+		mu.Lock()
+		defer mu.Unlock()
+		var rules []string
+		for _, id := range []string{"r1", "r2", "r3", "bad"} {
+			if status[id] == want {
+				rules = append(rules, fmt.Sprintf(`{"id": %q, "status": %q}`, id, status[id]))
+			}
+		}
+		fmt.Fprintf(w, `{"success": true, "errors": [], "messages": [], "result": [%s]}`, strings.Join(rules, ","))
+	})
+	mux.HandleFunc("/zones/z1/pagerules/", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PATCH", r.Method, "Expected method 'PATCH', got %s", r.Method)
+		id := strings.TrimPrefix(r.URL.Path, "/zones/z1/pagerules/")
+		body, _ := ioutil.ReadAll(r.Body)
+		w.Header().Set("content-type", "application/json")
+		if id == "bad" {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, `{"success": false, "errors": [{"code": 1004, "message": "Invalid"}], "messages": [], "result": null}`)
+			return
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		switch strings.TrimSpace(string(body)) {
+		case `{"status":"active"}`:
+			status[id] = "active"
+		case `{"status":"paused"}`:
+			status[id] = "paused"
+		default:
+			t.Errorf("unexpected body %s", body)
+		}
+		fmt.Fprintf(w, `{"success": true, "errors": [], "messages": [], "result": {"id": %q, "status": %q}}`, id, status[id])
+	})
+
+	paused, err := client.PauseAllPageRules("z1")
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []string{"r1", "r3"}, paused)
+	assert.Equal(t, map[string]string{"r1": "paused", "r2": "paused", "r3": "paused", "bad": "paused"}, status)
+
+	resumed, err := client.ResumeAllPageRules("z1")
+	assert.ElementsMatch(t, []string{"r1", "r2", "r3"}, resumed)
+	if assert.IsType(t, &PageRuleStatusError{}, err) {
+		assert.Len(t, err.(*PageRuleStatusError).Errors, 1)
+		assert.EqualError(t, err, "could not set 1 page rules to active: [bad]")
+	}
+
+	rule, err := client.SetPageRuleStatus("z1", "r2", PageRuleStatusPaused)
+	if assert.NoError(t, err) {
+		assert.Equal(t, PageRuleStatusPaused, rule.Status)
+	}
+}
@@ -0,0 +1,58 @@
+package cloudflare
+
+import "github.com/pkg/errors"
+
+// PageRuleSyncOptions controls the behaviour of SyncPageRules.
+type PageRuleSyncOptions struct {
+	// AllowDeletes permits rules whose target is not in the desired set to be
+	// deleted. Without it, such rules are left in place.
+	AllowDeletes bool
+	// DryRun computes the changes without making them.
+	DryRun bool
+}
+
+// SyncPageRules brings the Page Rules of a zone in line with the desired set,
+// returning the changes which were made (or which would be made, for a dry
+// run).
+//
+// Rules are matched on their target URL pattern, which must be unique within
+// the desired set. A matched rule is updated only if its actions, priority or
+// status differ; actions are compared irrespective of their order, and a zero
+// priority or empty status in a desired rule keeps the existing one. Running
+// the same sync twice therefore makes no changes the second time.
+func (api *API) SyncPageRules(zoneID string, desired []PageRule, opts PageRuleSyncOptions) ([]ZoneConfigChange, error) {
+	targets := make(map[string]bool, len(desired))
+	for i, rule := range desired {
+		target := pageRuleTargetValue(rule)
+		if target == "" {
+			return nil, &UserError{Err: errors.Errorf("page rule %d has no URL target", i)}
+		}
+		if targets[target] {
+			return nil, &UserError{Err: errors.Errorf("page rule target %q is listed more than once", target)}
+		}
+		targets[target] = true
+	}
+
+	existing, err := api.ListPageRules(zoneID)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not list Page Rules")
+	}
+
+	var changes []ZoneConfigChange
+	for _, c := range diffPageRules(existing, desired) {
+		if c.Action == ZoneConfigDelete && !opts.AllowDeletes {
+			continue
+		}
+		changes = append(changes, c)
+	}
+
+	if opts.DryRun {
+		return changes, nil
+	}
+	for i, c := range changes {
+		if err := api.applyZoneConfigChange(zoneID, c); err != nil {
+			return changes[:i], errors.Wrap(err, "failed to "+c.String())
+		}
+	}
+	return changes, nil
+}
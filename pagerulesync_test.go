@@ -0,0 +1,83 @@
+package cloudflare
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSyncPageRules(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var methods []string
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		methods = append(methods, r.Method+" "+r.URL.Path)
+		if r.Method == "GET" && strings.HasSuffix(r.URL.Path, "/pagerules") {
+			fmt.Fprint(w, `{
+				"success": true,
+				"errors": [],
+				"messages": [],
+				"result": [
+					{"id": "1", "targets": [{"target": "url", "constraint": {"operator": "matches", "value": "*example.com/static/*"}}],
+					 "actions": [{"id": "cache_level", "value": "cache_everything"}, {"id": "edge_cache_ttl", "value": 7200}],
+					 "priority": 2, "status": "active"},
+					{"id": "2", "targets": [{"target": "url", "constraint": {"operator": "matches", "value": "*example.com/old/*"}}],
+					 "actions": [{"id": "forwarding_url", "value": {"url": "https://example.com/new/$1", "status_code": 301}}],
+					 "priority": 1, "status": "active"}
+				]
+			}`)
+			return
+		}
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"id": "3"}}`)
+	}
+	mux.HandleFunc("/zones/foo/pagerules", handler)
+	mux.HandleFunc("/zones/foo/pagerules/", handler)
+
+	static := func(actions ...PageRuleAction) PageRule {
+		r := testPageRule
+		r.Targets = append(r.Targets[:0:0], r.Targets...)
+		r.Targets[0].Constraint.Value = "*example.com/static/*"
+		r.Actions = actions
+		r.Status = ""
+		return r
+	}
+
+	// The same actions in another order are already in sync.
+	changes, err := client.SyncPageRules("foo", []PageRule{
+		static(NewEdgeCacheTTLAction(2*time.Hour), NewCacheLevelAction(CacheLevelCacheEverything)),
+	}, PageRuleSyncOptions{})
+	assert.NoError(t, err)
+	assert.Empty(t, changes)
+	assert.Equal(t, []string{"GET /zones/foo/pagerules"}, methods)
+
+	desired := []PageRule{
+		static(NewCacheLevelAction(CacheLevelBypass)),
+		testPageRule,
+	}
+	changes, err = client.SyncPageRules("foo", desired, PageRuleSyncOptions{DryRun: true})
+	if assert.NoError(t, err) && assert.Len(t, changes, 2) {
+		assert.Equal(t, ZoneConfigUpdate, changes[0].Action)
+		assert.Equal(t, "1", changes[0].ID)
+		assert.Equal(t, ZoneConfigCreate, changes[1].Action)
+	}
+
+	methods = nil
+	changes, err = client.SyncPageRules("foo", desired, PageRuleSyncOptions{AllowDeletes: true})
+	assert.NoError(t, err)
+	assert.Len(t, changes, 3)
+	assert.Equal(t, []string{
+		"GET /zones/foo/pagerules",
+		"PUT /zones/foo/pagerules/1",
+		"POST /zones/foo/pagerules",
+		"DELETE /zones/foo/pagerules/2",
+	}, methods)
+
+	_, err = client.SyncPageRules("foo", []PageRule{testPageRule, testPageRule}, PageRuleSyncOptions{})
+	assert.IsType(t, &UserError{}, err)
+}
@@ -0,0 +1,131 @@
+package cloudflare
+
+import (
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// NewPageRuleTarget returns a target matching requests whose URLs match
+// pattern, such as "*example.com/images/*", after checking the pattern with
+// ValidatePageRulePattern.
+func NewPageRuleTarget(pattern string) (PageRuleTarget, error) {
+	if err := ValidatePageRulePattern(pattern); err != nil {
+		return PageRuleTarget{}, err
+	}
+	var t PageRuleTarget
+	t.Target = "url"
+	t.Constraint.Operator = "matches"
+	t.Constraint.Value = pattern
+	return t, nil
+}
+
+// pageRulePatternHost matches the host of a URL pattern.
+var pageRulePatternHost = regexp.MustCompile(`^[A-Za-z0-9*]([A-Za-z0-9*.-]*[A-Za-z0-9*])?$`)
+
+// ValidatePageRulePattern checks that pattern is a URL pattern the API will
+// accept: an optional "http://" or "https://" scheme, a host, and an optional
+// port, path and query string. Any part but the scheme may contain "*"
+// wildcards, each of which matches any run of characters, including none.
+func ValidatePageRulePattern(pattern string) error {
+	if pattern == "" {
+		return errors.New("URL pattern must not be empty")
+	}
+	if strings.ContainsAny(pattern, " \t\r\n#") {
+		return errors.Errorf("URL pattern %q must not contain whitespace or a fragment", pattern)
+	}
+	scheme, host, port, _ := splitPageRulePattern(pattern)
+	switch scheme {
+	case "", "http", "https":
+	default:
+		return errors.Errorf("URL pattern %q must use http or https, not %q", pattern, scheme)
+	}
+	if host == "" {
+		return errors.Errorf("URL pattern %q has no host", pattern)
+	}
+	if !pageRulePatternHost.MatchString(host) {
+		return errors.Errorf("URL pattern %q has an invalid host %q", pattern, host)
+	}
+	if strings.Trim(port, "0123456789*") != "" {
+		return errors.Errorf("URL pattern %q has an invalid port %q", pattern, port)
+	}
+	return nil
+}
+
+// Matches reports whether a request for rawurl would match the target, as
+// Cloudflare evaluates URL patterns: a pattern without a scheme matches both
+// http and https, one without a path matches only the root path, host names
+// are compared without regard to case, and default ports may be left out.
+func (t PageRuleTarget) Matches(rawurl string) bool {
+	if t.Target != "url" || t.Constraint.Operator != "matches" {
+		return false
+	}
+	return matchPageRulePattern(t.Constraint.Value, rawurl)
+}
+
+// matchPageRulePattern reports whether rawurl matches the URL pattern.
+func matchPageRulePattern(pattern, rawurl string) bool {
+	u, err := url.Parse(rawurl)
+	if err != nil || u.Host == "" {
+		return false
+	}
+	scheme, host, port, rest := splitPageRulePattern(pattern)
+	if scheme != "" && scheme != u.Scheme {
+		return false
+	}
+
+	urlPort := u.Port()
+	if urlPort == "" || (u.Scheme == "http" && urlPort == "80") || (u.Scheme == "https" && urlPort == "443") {
+		urlPort = ""
+	}
+	if port == "" && urlPort != "" {
+		return false
+	}
+	if port != "" && !wildcardMatch(port, urlPort) {
+		return false
+	}
+	if !wildcardMatch(strings.ToLower(host), strings.ToLower(u.Hostname())) {
+		return false
+	}
+
+	path := u.EscapedPath()
+	if path == "" {
+		path = "/"
+	}
+	if u.RawQuery != "" {
+		path += "?" + u.RawQuery
+	}
+	if !strings.HasPrefix(rest, "/") {
+		rest = "/" + rest
+	}
+	return wildcardMatch(rest, path)
+}
+
+// splitPageRulePattern splits a URL pattern into its scheme, host, port and
+// the rest: its path and query string.
+func splitPageRulePattern(pattern string) (scheme, host, port, rest string) {
+	if i := strings.Index(pattern, "://"); i >= 0 {
+		scheme, pattern = pattern[:i], pattern[i+3:]
+	}
+	host = pattern
+	if i := strings.IndexAny(pattern, "/?"); i >= 0 {
+		host, rest = pattern[:i], pattern[i:]
+	}
+	if i := strings.LastIndex(host, ":"); i >= 0 {
+		host, port = host[:i], host[i+1:]
+	}
+	return scheme, host, port, rest
+}
+
+// wildcardMatch reports whether the whole of s matches pattern, in which "*"
+// matches any run of characters.
+func wildcardMatch(pattern, s string) bool {
+	parts := strings.Split(pattern, "*")
+	for i, p := range parts {
+		parts[i] = regexp.QuoteMeta(p)
+	}
+	re, err := regexp.Compile("^" + strings.Join(parts, ".*") + "$")
+	return err == nil && re.MatchString(s)
+}
@@ -0,0 +1,66 @@
+package cloudflare
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewPageRuleTarget(t *testing.T) {
+	target, err := NewPageRuleTarget("*example.com/images/*")
+	if assert.NoError(t, err) {
+		assert.Equal(t, "url", target.Target)
+		assert.Equal(t, "matches", target.Constraint.Operator)
+		assert.Equal(t, "*example.com/images/*", target.Constraint.Value)
+		assert.NoError(t, PageRule{Targets: []PageRuleTarget{target}, Actions: testPageRule.Actions}.Validate())
+	}
+
+	for _, pattern := range []string{
+		"example.com",
+		"https://www.example.com:8443/a/*?q=*",
+		"*.example.com/*",
+		"*",
+	} {
+		assert.NoError(t, ValidatePageRulePattern(pattern), pattern)
+	}
+	for _, pattern := range []string{
+		"",
+		"ftp://example.com/*",
+		"https:///path",
+		"example .com/*",
+		"example.com/#top",
+		"example.com:http/*",
+		"-example.com/*",
+	} {
+		_, err := NewPageRuleTarget(pattern)
+		assert.Error(t, err, pattern)
+	}
+}
+
+func TestPageRuleTargetMatches(t *testing.T) {
+	tests := []struct {
+		pattern, url string
+		want         bool
+	}{
+		{"*example.com/images/*", "https://www.example.com/images/a.png", true},
+		{"*example.com/images/*", "http://example.com/images/", true},
+		{"*example.com/images/*", "https://example.com/img/a.png", false},
+		{"example.com", "https://example.com", true},
+		{"example.com", "https://example.com/", true},
+		{"example.com", "https://example.com/a", false},
+		{"https://example.com/*", "http://example.com/a", false},
+		{"https://example.com/*", "https://EXAMPLE.com:443/a", true},
+		{"example.com/*", "https://example.com:8443/a", false},
+		{"example.com:8443/*", "https://example.com:8443/a", true},
+		{"example.com/*", "https://example.com/a?b=c", true},
+		{"example.com/a", "https://example.com/a?b=c", false},
+		{"example.com/a.b", "https://example.com/aXb", false},
+		{"*.example.com/*", "https://example.com/", false},
+	}
+	for _, tt := range tests {
+		target, err := NewPageRuleTarget(tt.pattern)
+		if assert.NoError(t, err, tt.pattern) {
+			assert.Equal(t, tt.want, target.Matches(tt.url), "%s %s", tt.pattern, tt.url)
+		}
+	}
+}
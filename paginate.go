@@ -0,0 +1,72 @@
+package cloudflare
+
+import (
+	"encoding/json"
+	"net/url"
+	"reflect"
+	"strconv"
+
+	"github.com/pkg/errors"
+)
+
+// paginate requests every page of results of a GET list endpoint, calling fn
+// with the result of each page in turn. query may be nil; its page parameter
+// is overwritten.
+func (api *API) paginate(uri string, query url.Values, fn func(result json.RawMessage) error) error {
+	v := url.Values{}
+	for k, vs := range query {
+		v[k] = vs
+	}
+	for page := 1; ; page++ {
+		v.Set("page", strconv.Itoa(page))
+		var result json.RawMessage
+		r, err := api.makeRequestResult("GET", uri+"?"+v.Encode(), nil, &result)
+		if err != nil {
+			return err
+		}
+		if err := fn(result); err != nil {
+			return err
+		}
+		if !r.ResultInfo.HasMorePages() {
+			return nil
+		}
+	}
+}
+
+// paginateInto requests every page of results of a GET list endpoint,
+// appending the items to the slice pointed to by items.
+func (api *API) paginateInto(uri string, query url.Values, items interface{}) error {
+	return api.paginate(uri, query, func(result json.RawMessage) error {
+		return api.appendJSONArray(items, result)
+	})
+}
+
+// appendJSONArray decodes a JSON array and appends its items to the slice
+// pointed to by items.
+func (api *API) appendJSONArray(items interface{}, array json.RawMessage) error {
+	slice := reflect.ValueOf(items).Elem()
+	page := reflect.New(slice.Type())
+	if err := api.json().Unmarshal(array, page.Interface()); err != nil {
+		return errors.Wrap(err, errUnmarshalError)
+	}
+	slice.Set(reflect.AppendSlice(slice, page.Elem()))
+	return nil
+}
+
+// Paginate requests every page of results of a GET list endpoint at path, such
+// as "/zones", calling fn with each item. It is intended for endpoints without
+// a method of their own; iteration stops at the first error returned by fn.
+func (api *API) Paginate(path string, query url.Values, fn func(item json.RawMessage) error) error {
+	return api.paginate(path, query, func(result json.RawMessage) error {
+		var items []json.RawMessage
+		if err := api.json().Unmarshal(result, &items); err != nil {
+			return errors.Wrap(err, errUnmarshalError)
+		}
+		for _, item := range items {
+			if err := fn(item); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
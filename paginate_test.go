@@ -0,0 +1,73 @@
+package cloudflare
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func handlePages(t *testing.T, path string) {
+	mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "active", r.URL.Query().Get("status"))
+		w.Header().Set("content-type", "application/json")
+		switch r.URL.Query().Get("page") {
+		case "1":
+			fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": [{"id": "a"}, {"id": "b"}], "result_info": {"page": 1, "per_page": 2, "count": 2, "total_count": 3}}`)
+		case "2":
+			fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": [{"id": "c"}], "result_info": {"page": 2, "per_page": 2, "count": 1, "total_count": 3}}`)
+		default:
+			t.Errorf("unexpected page %q", r.URL.Query().Get("page"))
+		}
+	})
+}
+
+func TestPaginate(t *testing.T) {
+	setup()
+	defer teardown()
+	handlePages(t, "/things")
+
+	query := url.Values{"status": {"active"}}
+	var ids []string
+	err := client.Paginate("/things", query, func(item json.RawMessage) error {
+		var v struct {
+			ID string `json:"id"`
+		}
+		if err := json.Unmarshal(item, &v); err != nil {
+			return err
+		}
+		ids = append(ids, v.ID)
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"a", "b", "c"}, ids)
+	assert.Empty(t, query.Get("page"))
+
+	stop := errors.New("stop")
+	var n int
+	err = client.Paginate("/things", query, func(item json.RawMessage) error {
+		n++
+		return stop
+	})
+	assert.Equal(t, stop, err)
+	assert.Equal(t, 1, n)
+}
+
+func TestPaginateInto(t *testing.T) {
+	setup()
+	defer teardown()
+	handlePages(t, "/things")
+
+	var items []struct {
+		ID string `json:"id"`
+	}
+	if assert.NoError(t, client.paginateInto("/things", url.Values{"status": {"active"}}, &items)) {
+		assert.Len(t, items, 3)
+		assert.Equal(t, "c", items[2].ID)
+	}
+}
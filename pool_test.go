@@ -0,0 +1,19 @@
+package cloudflare
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBufferPool(t *testing.T) {
+	buf := getBuffer()
+	buf.WriteString("stale")
+	putBuffer(buf)
+	assert.Equal(t, 0, getBuffer().Len())
+
+	// Oversized buffers are dropped rather than pooled; putBuffer must not
+	// panic on them.
+	putBuffer(bytes.NewBuffer(make([]byte, 0, maxPooledBufferSize+1)))
+}
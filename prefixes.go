@@ -0,0 +1,50 @@
+package cloudflare
+
+import "time"
+
+// PrefixAdvertisementStatus is the BGP advertisement status of a BYOIP
+// prefix.
+type PrefixAdvertisementStatus struct {
+	Advertised           bool       `json:"advertised"`
+	AdvertisedModifiedAt *time.Time `json:"advertised_modified_at,omitempty"`
+}
+
+// PrefixAdvertisementStatus returns whether a BYOIP prefix is advertised by
+// Cloudflare.
+//
+// API reference: https://developers.cloudflare.com/api/operations/dynamic-advertisement-get-advertisement-status
+func (api *API) PrefixAdvertisementStatus(accountID, prefixID string) (PrefixAdvertisementStatus, error) {
+	var status PrefixAdvertisementStatus
+	if _, err := api.makeRequestResult("GET", "/accounts/"+accountID+"/addressing/prefixes/"+prefixID+"/bgp/status", nil, &status); err != nil {
+		return PrefixAdvertisementStatus{}, err
+	}
+	return status, nil
+}
+
+// UpdatePrefixAdvertisementStatus starts or stops the advertisement of a BYOIP
+// prefix. The change propagates over several minutes.
+//
+// API reference: https://developers.cloudflare.com/api/operations/dynamic-advertisement-update-prefix-dynamic-advertisement-status
+func (api *API) UpdatePrefixAdvertisementStatus(accountID, prefixID string, advertised bool) (PrefixAdvertisementStatus, error) {
+	params := struct {
+		Advertised bool `json:"advertised"`
+	}{
+		Advertised: advertised,
+	}
+	var status PrefixAdvertisementStatus
+	if _, err := api.makeRequestResult("PATCH", "/accounts/"+accountID+"/addressing/prefixes/"+prefixID+"/bgp/status", params, &status); err != nil {
+		return PrefixAdvertisementStatus{}, err
+	}
+	return status, nil
+}
+
+// AdvertisePrefix makes Cloudflare advertise a BYOIP prefix, drawing its
+// traffic through Cloudflare.
+func (api *API) AdvertisePrefix(accountID, prefixID string) (PrefixAdvertisementStatus, error) {
+	return api.UpdatePrefixAdvertisementStatus(accountID, prefixID, true)
+}
+
+// WithdrawPrefix makes Cloudflare withdraw the advertisement of a BYOIP prefix.
+func (api *API) WithdrawPrefix(accountID, prefixID string) (PrefixAdvertisementStatus, error) {
+	return api.UpdatePrefixAdvertisementStatus(accountID, prefixID, false)
+}
@@ -0,0 +1,46 @@
+package cloudflare
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPrefixAdvertisementStatus(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/accounts/acc/addressing/prefixes/p1/bgp/status", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		switch r.Method {
+		case "GET":
+			fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"advertised": false, "advertised_modified_at": "2016-06-15T12:00:00Z"}}`)
+		case "PATCH":
+			var params map[string]interface{}
+			if assert.NoError(t, json.NewDecoder(r.Body).Decode(&params)) {
+				fmt.Fprintf(w, `{"success": true, "errors": [], "messages": [], "result": {"advertised": %t}}`, params["advertised"])
+			}
+		default:
+			t.Errorf("unexpected method %s", r.Method)
+		}
+	})
+
+	status, err := client.PrefixAdvertisementStatus("acc", "p1")
+	if assert.NoError(t, err) {
+		assert.False(t, status.Advertised)
+		assert.NotNil(t, status.AdvertisedModifiedAt)
+	}
+
+	status, err = client.AdvertisePrefix("acc", "p1")
+	if assert.NoError(t, err) {
+		assert.True(t, status.Advertised)
+	}
+
+	status, err = client.WithdrawPrefix("acc", "p1")
+	if assert.NoError(t, err) {
+		assert.False(t, status.Advertised)
+	}
+}
@@ -0,0 +1,77 @@
+package cloudflare
+
+// QueueMessage is a message pulled from a Queue. The message must be
+// acknowledged or retried, using its lease ID, before its visibility timeout
+// expires or it will be delivered again.
+type QueueMessage struct {
+	ID          string `json:"id"`
+	Body        string `json:"body"`
+	TimestampMS int64  `json:"timestamp_ms"`
+	Attempts    int    `json:"attempts"`
+	LeaseID     string `json:"lease_id"`
+}
+
+// QueuePullOptions controls how many messages are pulled from a Queue and for
+// how long they are hidden from other consumers. Zero values use the API's
+// defaults.
+type QueuePullOptions struct {
+	BatchSize           int `json:"batch_size,omitempty"`
+	VisibilityTimeoutMS int `json:"visibility_timeout_ms,omitempty"`
+}
+
+// QueueRetry asks for a pulled message to be delivered again, optionally after
+// a delay.
+type QueueRetry struct {
+	LeaseID      string `json:"lease_id"`
+	DelaySeconds int    `json:"delay_seconds,omitempty"`
+}
+
+// QueueAckResult reports how many messages were acknowledged and retried.
+type QueueAckResult struct {
+	AckCount   int      `json:"ackCount"`
+	RetryCount int      `json:"retryCount"`
+	Warnings   []string `json:"warnings"`
+}
+
+// PullQueueMessages pulls a batch of messages from a Queue with an HTTP pull
+// consumer.
+//
+// API reference: https://developers.cloudflare.com/api/operations/queue-v2-messages-pull
+func (api *API) PullQueueMessages(accountID, queueID string, opts QueuePullOptions) ([]QueueMessage, error) {
+	var result struct {
+		Messages []QueueMessage `json:"messages"`
+	}
+	if _, err := api.makeRequestResult("POST", "/accounts/"+accountID+"/queues/"+queueID+"/messages/pull", opts, &result); err != nil {
+		return nil, err
+	}
+	return result.Messages, nil
+}
+
+// AckQueueMessages acknowledges pulled messages by lease ID, removing them
+// from the Queue, and marks others for redelivery.
+//
+// API reference: https://developers.cloudflare.com/api/operations/queue-v2-messages-ack
+func (api *API) AckQueueMessages(accountID, queueID string, acks []string, retries []QueueRetry) (QueueAckResult, error) {
+	type ack struct {
+		LeaseID string `json:"lease_id"`
+	}
+	params := struct {
+		Acks    []ack        `json:"acks"`
+		Retries []QueueRetry `json:"retries"`
+	}{
+		Acks:    make([]ack, len(acks)),
+		Retries: retries,
+	}
+	for i, id := range acks {
+		params.Acks[i] = ack{LeaseID: id}
+	}
+	if params.Retries == nil {
+		params.Retries = []QueueRetry{}
+	}
+
+	var result QueueAckResult
+	if _, err := api.makeRequestResult("POST", "/accounts/"+accountID+"/queues/"+queueID+"/messages/ack", params, &result); err != nil {
+		return QueueAckResult{}, err
+	}
+	return result, nil
+}
@@ -0,0 +1,67 @@
+package cloudflare
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPullQueueMessages(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/accounts/acc/queues/q1/messages/pull", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		var opts QueuePullOptions
+		if assert.NoError(t, json.NewDecoder(r.Body).Decode(&opts)) {
+			assert.Equal(t, QueuePullOptions{BatchSize: 10, VisibilityTimeoutMS: 5000}, opts)
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {
+				"messages": [
+					{"id": "m1", "body": "hello", "timestamp_ms": 1700000000000, "attempts": 1, "lease_id": "l1"}
+				]
+			}
+		}`)
+	})
+
+	msgs, err := client.PullQueueMessages("acc", "q1", QueuePullOptions{BatchSize: 10, VisibilityTimeoutMS: 5000})
+	if assert.NoError(t, err) {
+		assert.Equal(t, []QueueMessage{{
+			ID:          "m1",
+			Body:        "hello",
+			TimestampMS: 1700000000000,
+			Attempts:    1,
+			LeaseID:     "l1",
+		}}, msgs)
+	}
+}
+
+func TestAckQueueMessages(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/accounts/acc/queues/q1/messages/ack", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		var params map[string]interface{}
+		if assert.NoError(t, json.NewDecoder(r.Body).Decode(&params)) {
+			assert.Equal(t, []interface{}{map[string]interface{}{"lease_id": "l1"}}, params["acks"])
+			assert.Equal(t, []interface{}{map[string]interface{}{"lease_id": "l2", "delay_seconds": float64(30)}}, params["retries"])
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"ackCount": 1, "retryCount": 1, "warnings": []}}`)
+	})
+
+	res, err := client.AckQueueMessages("acc", "q1", []string{"l1"}, []QueueRetry{{LeaseID: "l2", DelaySeconds: 30}})
+	if assert.NoError(t, err) {
+		assert.Equal(t, 1, res.AckCount)
+		assert.Equal(t, 1, res.RetryCount)
+	}
+}
@@ -1,11 +1,8 @@
 package cloudflare
 
 import (
-	"encoding/json"
 	"net/url"
 	"time"
-
-	"github.com/pkg/errors"
 )
 
 // Railgun represents a Railgun's properties.
@@ -33,18 +30,6 @@ type RailgunListOptions struct {
 	Direction string
 }
 
-// railgunResponse represents the response from the Create Railgun and the Railgun Details endpoints.
-type railgunResponse struct {
-	Response
-	Result Railgun `json:"result"`
-}
-
-// railgunsResponse represents the response from the List Railguns endpoint.
-type railgunsResponse struct {
-	Response
-	Result []Railgun `json:"result"`
-}
-
 // CreateRailgun creates a new Railgun.
 // API reference:
 // 	https://api.cloudflare.com/#railgun-create-railgun
@@ -56,15 +41,11 @@ func (api *API) CreateRailgun(name string) (Railgun, error) {
 	}{
 		Name: name,
 	}
-	res, err := api.makeRequest("POST", uri, params)
-	if err != nil {
-		return Railgun{}, errors.Wrap(err, errMakeRequestError)
+	var result Railgun
+	if _, err := api.makeRequestResult("POST", uri, params, &result); err != nil {
+		return Railgun{}, err
 	}
-	var r railgunResponse
-	if err := json.Unmarshal(res, &r); err != nil {
-		return Railgun{}, errors.Wrap(err, errUnmarshalError)
-	}
-	return r.Result, nil
+	return result, nil
 }
 
 // ListRailguns lists Railguns connected to an account.
@@ -77,15 +58,11 @@ func (api *API) ListRailguns(options RailgunListOptions) ([]Railgun, error) {
 		v.Set("direction", options.Direction)
 	}
 	uri := "/railguns" + "?" + v.Encode()
-	res, err := api.makeRequest("GET", uri, nil)
-	if err != nil {
-		return nil, errors.Wrap(err, errMakeRequestError)
-	}
-	var r railgunsResponse
-	if err := json.Unmarshal(res, &r); err != nil {
-		return nil, errors.Wrap(err, errUnmarshalError)
+	var result []Railgun
+	if _, err := api.makeRequestResult("GET", uri, nil, &result); err != nil {
+		return nil, err
 	}
-	return r.Result, nil
+	return result, nil
 }
 
 // RailgunDetails returns the details for a Railgun.
@@ -94,15 +71,11 @@ func (api *API) ListRailguns(options RailgunListOptions) ([]Railgun, error) {
 // 	GET /railguns/:identifier
 func (api *API) RailgunDetails(railgunID string) (Railgun, error) {
 	uri := "/railguns/" + railgunID
-	res, err := api.makeRequest("GET", uri, nil)
-	if err != nil {
-		return Railgun{}, errors.Wrap(err, errMakeRequestError)
-	}
-	var r railgunResponse
-	if err := json.Unmarshal(res, &r); err != nil {
-		return Railgun{}, errors.Wrap(err, errUnmarshalError)
+	var result Railgun
+	if _, err := api.makeRequestResult("GET", uri, nil, &result); err != nil {
+		return Railgun{}, err
 	}
-	return r.Result, nil
+	return result, nil
 }
 
 // RailgunZones returns the zones that are currently using a Railgun.
@@ -111,15 +84,11 @@ func (api *API) RailgunDetails(railgunID string) (Railgun, error) {
 // 	GET /railguns/:identifier/zones
 func (api *API) RailgunZones(railgunID string) ([]Zone, error) {
 	uri := "/railguns/" + railgunID + "/zones"
-	res, err := api.makeRequest("GET", uri, nil)
-	if err != nil {
-		return nil, errors.Wrap(err, errMakeRequestError)
+	var result []Zone
+	if _, err := api.makeRequestResult("GET", uri, nil, &result); err != nil {
+		return nil, err
 	}
-	var r ZonesResponse
-	if err := json.Unmarshal(res, &r); err != nil {
-		return nil, errors.Wrap(err, errUnmarshalError)
-	}
-	return r.Result, nil
+	return result, nil
 }
 
 // enableRailgun enables (true) or disables (false) a Railgun for all zones connected to it.
@@ -133,15 +102,11 @@ func (api *API) enableRailgun(railgunID string, enable bool) (Railgun, error) {
 	}{
 		Enabled: enable,
 	}
-	res, err := api.makeRequest("PATCH", uri, params)
-	if err != nil {
-		return Railgun{}, errors.Wrap(err, errMakeRequestError)
-	}
-	var r railgunResponse
-	if err := json.Unmarshal(res, &r); err != nil {
-		return Railgun{}, errors.Wrap(err, errUnmarshalError)
+	var result Railgun
+	if _, err := api.makeRequestResult("PATCH", uri, params, &result); err != nil {
+		return Railgun{}, err
 	}
-	return r.Result, nil
+	return result, nil
 }
 
 // EnableRailgun enables a Railgun for all zones connected to it.
@@ -166,8 +131,8 @@ func (api *API) DisableRailgun(railgunID string) (Railgun, error) {
 // 	DELETE /railguns/:identifier
 func (api *API) DeleteRailgun(railgunID string) error {
 	uri := "/railguns/" + railgunID
-	if _, err := api.makeRequest("DELETE", uri, nil); err != nil {
-		return errors.Wrap(err, errMakeRequestError)
+	if _, err := api.makeRequestResult("DELETE", uri, nil, nil); err != nil {
+		return err
 	}
 	return nil
 }
@@ -180,18 +145,6 @@ type ZoneRailgun struct {
 	Connected bool   `json:"connected"`
 }
 
-// zoneRailgunResponse represents the response from the Zone Railgun Details endpoint.
-type zoneRailgunResponse struct {
-	Response
-	Result ZoneRailgun `json:"result"`
-}
-
-// zoneRailgunsResponse represents the response from the Zone Railgun endpoint.
-type zoneRailgunsResponse struct {
-	Response
-	Result []ZoneRailgun `json:"result"`
-}
-
 // RailgunDiagnosis represents the test results from testing railgun connections
 // to a zone.
 type RailgunDiagnosis struct {
@@ -215,27 +168,17 @@ type RailgunDiagnosis struct {
 	CFCacheStatus string `json:"cf-cache-status"`
 }
 
-// railgunDiagnosisResponse represents the response from the Test Railgun Connection enpoint.
-type railgunDiagnosisResponse struct {
-	Response
-	Result RailgunDiagnosis `json:"result"`
-}
-
 // ZoneRailguns returns the available Railguns for a zone.
 // API reference:
 // 	https://api.cloudflare.com/#railguns-for-a-zone-get-available-railguns
 // 	GET /zones/:zone_identifier/railguns
 func (api *API) ZoneRailguns(zoneID string) ([]ZoneRailgun, error) {
 	uri := "/zones/" + zoneID + "/railguns"
-	res, err := api.makeRequest("GET", uri, nil)
-	if err != nil {
-		return nil, errors.Wrap(err, errMakeRequestError)
-	}
-	var r zoneRailgunsResponse
-	if err := json.Unmarshal(res, &r); err != nil {
-		return nil, errors.Wrap(err, errUnmarshalError)
+	var result []ZoneRailgun
+	if _, err := api.makeRequestResult("GET", uri, nil, &result); err != nil {
+		return nil, err
 	}
-	return r.Result, nil
+	return result, nil
 }
 
 // Railgun returns the configuration for a given Railgun.
@@ -244,15 +187,11 @@ func (api *API) ZoneRailguns(zoneID string) ([]ZoneRailgun, error) {
 // 	GET /zones/:zone_identifier/railguns/:identifier
 func (api *API) ZoneRailgunDetails(zoneID, railgunID string) (ZoneRailgun, error) {
 	uri := "/zones/" + zoneID + "/railguns/" + railgunID
-	res, err := api.makeRequest("GET", uri, nil)
-	if err != nil {
-		return ZoneRailgun{}, errors.Wrap(err, errMakeRequestError)
-	}
-	var r zoneRailgunResponse
-	if err := json.Unmarshal(res, &r); err != nil {
-		return ZoneRailgun{}, errors.Wrap(err, errUnmarshalError)
+	var result ZoneRailgun
+	if _, err := api.makeRequestResult("GET", uri, nil, &result); err != nil {
+		return ZoneRailgun{}, err
 	}
-	return r.Result, nil
+	return result, nil
 }
 
 // TestRailgunResponse tests a Railgun connection for a given zone.
@@ -261,15 +200,11 @@ func (api *API) ZoneRailgunDetails(zoneID, railgunID string) (ZoneRailgun, error
 //  GET /zones/:zone_identifier/railguns/:identifier/diagnose
 func (api *API) TestRailgunConnection(zoneID, railgunID string) (RailgunDiagnosis, error) {
 	uri := "/zones/" + zoneID + "/railguns/" + railgunID + "/diagnose"
-	res, err := api.makeRequest("GET", uri, nil)
-	if err != nil {
-		return RailgunDiagnosis{}, errors.Wrap(err, errMakeRequestError)
+	var result RailgunDiagnosis
+	if _, err := api.makeRequestResult("GET", uri, nil, &result); err != nil {
+		return RailgunDiagnosis{}, err
 	}
-	var r railgunDiagnosisResponse
-	if err := json.Unmarshal(res, &r); err != nil {
-		return RailgunDiagnosis{}, errors.Wrap(err, errUnmarshalError)
-	}
-	return r.Result, nil
+	return result, nil
 }
 
 // connectZoneRailgun connects (true) or disconnects (false) a Railgun for a given zone.
@@ -283,15 +218,11 @@ func (api *API) connectZoneRailgun(zoneID, railgunID string, connect bool) (Zone
 	}{
 		Connected: connect,
 	}
-	res, err := api.makeRequest("PATCH", uri, params)
-	if err != nil {
-		return ZoneRailgun{}, errors.Wrap(err, errMakeRequestError)
-	}
-	var r zoneRailgunResponse
-	if err := json.Unmarshal(res, &r); err != nil {
-		return ZoneRailgun{}, errors.Wrap(err, errUnmarshalError)
+	var result ZoneRailgun
+	if _, err := api.makeRequestResult("PATCH", uri, params, &result); err != nil {
+		return ZoneRailgun{}, err
 	}
-	return r.Result, nil
+	return result, nil
 }
 
 // ZoneRailgun connects a Railgun for a given zone.
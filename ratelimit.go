@@ -0,0 +1,88 @@
+package cloudflare
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// The API allows 1200 requests per five minutes for each user.
+const (
+	defaultRateLimit = 1200.0 / (5 * 60)
+	defaultBurst     = 1
+)
+
+// rateLimiter is a token bucket: tokens are added at rate per second up to
+// burst, and each request takes one, waiting for it if the bucket is empty.
+type rateLimiter struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+func newRateLimiter(rate float64, burst int) *rateLimiter {
+	return &rateLimiter{
+		rate:   rate,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   time.Now(),
+	}
+}
+
+// reserve takes a token and returns how long to wait before using it. Tokens
+// may be taken in advance, so concurrent callers queue in order.
+func (l *rateLimiter) reserve() time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	l.tokens += now.Sub(l.last).Seconds() * l.rate
+	if l.tokens > l.burst {
+		l.tokens = l.burst
+	}
+	l.last = now
+
+	l.tokens--
+	if l.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-l.tokens / l.rate * float64(time.Second))
+}
+
+// wait blocks until a request may be made, or ctx is done.
+func (l *rateLimiter) wait(ctx context.Context) error {
+	d := l.reserve()
+	if d <= 0 {
+		return nil
+	}
+	select {
+	case <-time.After(d):
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// RateLimit limits the client to rps requests per second, allowing bursts of
+// up to burst requests. This keeps bulk operations across many zones under
+// the API's rate limit rather than having them fail with HTTP 429; clients
+// sharing credentials should share the limit between them.
+func RateLimit(rps float64, burst int) Option {
+	return func(api *API) error {
+		if rps <= 0 || burst < 1 {
+			return errors.New("rate limit must be positive with a burst of at least 1")
+		}
+		api.rateLimiter = newRateLimiter(rps, burst)
+		return nil
+	}
+}
+
+// DefaultRateLimit limits the client to the API's rate limit of 1200 requests
+// per five minutes.
+func DefaultRateLimit() Option {
+	return RateLimit(defaultRateLimit, defaultBurst)
+}
@@ -0,0 +1,44 @@
+package cloudflare
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRateLimiterReserve(t *testing.T) {
+	l := newRateLimiter(10, 2)
+
+	assert.Equal(t, time.Duration(0), l.reserve())
+	assert.Equal(t, time.Duration(0), l.reserve())
+
+	// The bucket is empty, so the next tokens are a tenth of a second apart.
+	d := l.reserve()
+	assert.True(t, d > 90*time.Millisecond && d <= 100*time.Millisecond, "unexpected delay %s", d)
+	d = l.reserve()
+	assert.True(t, d > 190*time.Millisecond && d <= 200*time.Millisecond, "unexpected delay %s", d)
+}
+
+func TestRateLimit(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/z1", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"id": "z1"}}`)
+	})
+
+	assert.NoError(t, RateLimit(50, 1)(client))
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		_, err := client.ZoneDetails("z1")
+		assert.NoError(t, err)
+	}
+	assert.True(t, time.Since(start) >= 40*time.Millisecond)
+
+	assert.Error(t, RateLimit(0, 1)(client))
+	assert.Error(t, RateLimit(1, 0)(client))
+}
@@ -0,0 +1,108 @@
+package cloudflare
+
+// ZoneRateLimit is a Rate Limiting rule, which applies an action to clients
+// making more than Threshold matching requests within Period seconds. (The
+// client's own request rate is set with the RateLimit option.)
+type ZoneRateLimit struct {
+	ID          string                  `json:"id,omitempty"`
+	Disabled    bool                    `json:"disabled,omitempty"`
+	Description string                  `json:"description,omitempty"`
+	Match       RateLimitTrafficMatcher `json:"match"`
+	Bypass      []RateLimitKeyValue     `json:"bypass,omitempty"`
+	Threshold   int                     `json:"threshold"`
+	Period      int                     `json:"period"`
+	Action      RateLimitAction         `json:"action"`
+	Correlate   *RateLimitCorrelate     `json:"correlate,omitempty"`
+}
+
+// RateLimitTrafficMatcher describes the requests, and optionally the
+// responses, counted by a Rate Limiting rule.
+type RateLimitTrafficMatcher struct {
+	Request  RateLimitRequestMatcher  `json:"request"`
+	Response RateLimitResponseMatcher `json:"response"`
+}
+
+// RateLimitRequestMatcher matches requests by method, scheme and URL pattern.
+type RateLimitRequestMatcher struct {
+	Methods    []string `json:"methods,omitempty"`
+	Schemes    []string `json:"schemes,omitempty"`
+	URLPattern string   `json:"url,omitempty"`
+}
+
+// RateLimitResponseMatcher matches responses by status code, and whether they
+// came from the origin.
+type RateLimitResponseMatcher struct {
+	Statuses      []int `json:"status,omitempty"`
+	OriginTraffic *bool `json:"origin_traffic,omitempty"`
+}
+
+// RateLimitKeyValue is a name and value, such as a URL which bypasses a Rate
+// Limiting rule.
+type RateLimitKeyValue struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// RateLimitAction is the action taken against clients over a Rate Limiting
+// rule's threshold, for Timeout seconds. Valid modes are "simulate", "ban",
+// "challenge" and "js_challenge".
+type RateLimitAction struct {
+	Mode     string                   `json:"mode"`
+	Timeout  int                      `json:"timeout,omitempty"`
+	Response *RateLimitActionResponse `json:"response,omitempty"`
+}
+
+// RateLimitActionResponse is a custom response returned to banned clients.
+type RateLimitActionResponse struct {
+	ContentType string `json:"content_type"`
+	Body        string `json:"body"`
+}
+
+// RateLimitCorrelate changes how requests are counted; By may be "nat" to
+// count requests from clients behind the same NAT together.
+type RateLimitCorrelate struct {
+	By string `json:"by"`
+}
+
+// ListAllRateLimits returns all Rate Limiting rules for a zone.
+//
+// API reference: https://api.cloudflare.com/#rate-limits-for-a-zone-list-rate-limits
+func (api *API) ListAllRateLimits(zoneID string) ([]ZoneRateLimit, error) {
+	var limits []ZoneRateLimit
+	if err := api.paginateInto("/zones/"+zoneID+"/rate_limits", nil, &limits); err != nil {
+		return nil, err
+	}
+	return limits, nil
+}
+
+// CreateRateLimit creates a Rate Limiting rule.
+//
+// API reference: https://api.cloudflare.com/#rate-limits-for-a-zone-create-a-ratelimit
+func (api *API) CreateRateLimit(zoneID string, limit ZoneRateLimit) (ZoneRateLimit, error) {
+	var result ZoneRateLimit
+	if _, err := api.makeRequestResult("POST", "/zones/"+zoneID+"/rate_limits", limit, &result); err != nil {
+		return ZoneRateLimit{}, err
+	}
+	return result, nil
+}
+
+// UpdateRateLimit replaces a Rate Limiting rule.
+//
+// API reference: https://api.cloudflare.com/#rate-limits-for-a-zone-update-rate-limit
+func (api *API) UpdateRateLimit(zoneID, limitID string, limit ZoneRateLimit) (ZoneRateLimit, error) {
+	var result ZoneRateLimit
+	if _, err := api.makeRequestResult("PUT", "/zones/"+zoneID+"/rate_limits/"+limitID, limit, &result); err != nil {
+		return ZoneRateLimit{}, err
+	}
+	return result, nil
+}
+
+// DeleteRateLimit deletes a Rate Limiting rule.
+//
+// API reference: https://api.cloudflare.com/#rate-limits-for-a-zone-delete-rate-limit
+func (api *API) DeleteRateLimit(zoneID, limitID string) error {
+	if _, err := api.makeRequestResult("DELETE", "/zones/"+zoneID+"/rate_limits/"+limitID, nil, nil); err != nil {
+		return err
+	}
+	return nil
+}
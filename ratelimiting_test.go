@@ -0,0 +1,64 @@
+package cloudflare
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListAllRateLimits(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/foo/rate_limits", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": [
+				{
+					"id": "372e67954025e0ba6aaa6d586b9e0b59",
+					"description": "Limit login attempts",
+					"match": {
+						"request": {"methods": ["POST"], "schemes": ["HTTPS"], "url": "*example.com/login"},
+						"response": {"status": [401, 403], "origin_traffic": true}
+					},
+					"threshold": 5,
+					"period": 60,
+					"action": {"mode": "ban", "timeout": 600}
+				}
+			]
+		}`)
+	})
+
+	limits, err := client.ListAllRateLimits("foo")
+	if !assert.NoError(t, err) || !assert.Len(t, limits, 1) {
+		return
+	}
+	limit := limits[0]
+	assert.Equal(t, "*example.com/login", limit.Match.Request.URLPattern)
+	assert.Equal(t, []int{401, 403}, limit.Match.Response.Statuses)
+	if assert.NotNil(t, limit.Match.Response.OriginTraffic) {
+		assert.True(t, *limit.Match.Response.OriginTraffic)
+	}
+	assert.Equal(t, 5, limit.Threshold)
+	assert.Equal(t, 60, limit.Period)
+	assert.Equal(t, RateLimitAction{Mode: "ban", Timeout: 600}, limit.Action)
+}
+
+func TestDeleteRateLimit(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/foo/rate_limits/372e67954025e0ba6aaa6d586b9e0b59", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "DELETE", r.Method, "Expected method 'DELETE', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"id": "372e67954025e0ba6aaa6d586b9e0b59"}}`)
+	})
+
+	assert.NoError(t, client.DeleteRateLimit("foo", "372e67954025e0ba6aaa6d586b9e0b59"))
+}
@@ -0,0 +1,31 @@
+package cloudflare
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// Raw makes a request to any API endpoint, for endpoints this package does not
+// yet support. path is relative to BaseURL, e.g. "/zones/:id/some_feature",
+// and may include a query string. body, if not nil, is sent as JSON, and
+// header holds any extra headers, which may be nil. The request is
+// authenticated, rate limited, retried and intercepted like any other.
+//
+// The complete response body is returned, usually the JSON envelope with
+// "success", "errors" and "result". A response with an unsuccessful HTTP
+// status returns an error wrapping an *APIError. The envelope itself is not
+// checked, so a 200 response with "success": false is returned without error
+// and it is up to the caller to inspect it.
+func (api *API) Raw(ctx context.Context, method, path string, body interface{}, header http.Header) ([]byte, error) {
+	if !strings.HasPrefix(path, "/") {
+		return nil, errors.Errorf("path %q must start with /", path)
+	}
+	res, err := api.makeRequestContext(ctx, method, path, body, header)
+	if err != nil {
+		return nil, errors.Wrap(err, errMakeRequestError)
+	}
+	return res, nil
+}
@@ -0,0 +1,72 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRaw(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/z1/new_feature", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PUT", r.Method)
+		assert.Equal(t, "1", r.URL.Query().Get("preview"))
+		assert.Equal(t, "beta", r.Header.Get("X-Feature"))
+		assert.NotEmpty(t, r.Header.Get("X-Auth-Key"))
+		body, _ := ioutil.ReadAll(r.Body)
+		assert.JSONEq(t, `{"enabled": true}`, string(body))
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"enabled": true}}`)
+	})
+	mux.HandleFunc("/zones/z1/missing_feature", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"success": false, "errors": [{"code": 7003, "message": "No route for the URI"}], "messages": [], "result": null}`)
+	})
+
+	res, err := client.Raw(context.Background(), "PUT", "/zones/z1/new_feature?preview=1",
+		map[string]bool{"enabled": true}, http.Header{"X-Feature": {"beta"}})
+	if assert.NoError(t, err) {
+		var r struct {
+			Result struct {
+				Enabled bool `json:"enabled"`
+			} `json:"result"`
+		}
+		assert.NoError(t, json.Unmarshal(res, &r))
+		assert.True(t, r.Result.Enabled)
+	}
+
+	_, err = client.Raw(context.Background(), "GET", "/zones/z1/missing_feature", nil, nil)
+	e, ok := AsAPIError(err)
+	if assert.True(t, ok) {
+		assert.Equal(t, 7003, e.ErrorCode())
+	}
+
+	_, err = client.Raw(context.Background(), "GET", "zones", nil, nil)
+	assert.Error(t, err)
+}
+
+func TestRawContext(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/slow", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+
+	client.retryPolicy = RetryPolicy{MaxAttempts: 5, BaseDelay: time.Hour, MaxDelay: time.Hour}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, err := client.Raw(ctx, "GET", "/slow", nil, nil)
+	assert.Error(t, err)
+	assert.True(t, time.Since(start) < time.Minute)
+}
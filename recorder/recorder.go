@@ -0,0 +1,217 @@
+// Package recorder provides an http.RoundTripper which records interactions
+// with the Cloudflare API to a fixture file and replays them later, allowing
+// tests to run without network access while using real API payloads.
+//
+// A recorder is used by configuring the API client's HTTP client with it:
+//
+//	rec, err := recorder.New("testdata/zones.json", recorder.ModeReplay, nil)
+//	if err != nil {
+//		t.Fatal(err)
+//	}
+//	defer rec.Stop()
+//
+//	api, err := cloudflare.New(key, email, cloudflare.HTTPClient(&http.Client{Transport: rec}))
+//
+// Fixtures are recorded by running the same code in ModeRecord with real
+// credentials. Authentication headers are never written to fixtures, and a
+// Sanitize function can be provided to scrub anything else (e.g. account IDs).
+package recorder
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// Mode controls whether a Recorder records or replays interactions.
+type Mode int
+
+const (
+	// ModeReplay serves responses from the fixture file, and never makes real
+	// requests. Requests without a recorded response fail.
+	ModeReplay Mode = iota
+	// ModeRecord makes real requests and records them, replacing the fixture
+	// file when Stop is called.
+	ModeRecord
+)
+
+// Request is a recorded HTTP request.
+type Request struct {
+	Method string `json:"method"`
+	URL    string `json:"url"`
+	Body   string `json:"body,omitempty"`
+}
+
+// Response is a recorded HTTP response.
+type Response struct {
+	StatusCode int         `json:"status_code"`
+	Header     http.Header `json:"header,omitempty"`
+	Body       string      `json:"body"`
+}
+
+// Interaction is a request and the response it received.
+type Interaction struct {
+	Request  Request  `json:"request"`
+	Response Response `json:"response"`
+}
+
+// Recorder is an http.RoundTripper which records or replays interactions. It
+// is safe for concurrent use.
+type Recorder struct {
+	// Sanitize, if set, is called on every interaction before it is written
+	// to the fixture file.
+	Sanitize func(*Interaction)
+
+	path      string
+	mode      Mode
+	transport http.RoundTripper
+
+	mu           sync.Mutex
+	interactions []Interaction
+	// replayed records which interactions have already been served, so that
+	// repeated identical requests are answered in recorded order.
+	replayed []bool
+}
+
+// New creates a Recorder using the fixture file at path. In ModeRecord,
+// requests are made with transport, or http.DefaultTransport if it is nil.
+func New(path string, mode Mode, transport http.RoundTripper) (*Recorder, error) {
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	r := &Recorder{
+		path:      path,
+		mode:      mode,
+		transport: transport,
+	}
+
+	if mode == ModeReplay {
+		b, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not read fixture")
+		}
+		if err := json.Unmarshal(b, &r.interactions); err != nil {
+			return nil, errors.Wrap(err, "could not parse fixture")
+		}
+		r.replayed = make([]bool, len(r.interactions))
+	}
+
+	return r, nil
+}
+
+// RoundTrip implements http.RoundTripper.
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body []byte
+	if req.Body != nil {
+		var err error
+		body, err = ioutil.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		req.Body = ioutil.NopCloser(bytes.NewReader(body))
+	}
+
+	if r.mode == ModeReplay {
+		return r.replay(req)
+	}
+	return r.record(req, body)
+}
+
+func (r *Recorder) replay(req *http.Request) (*http.Response, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for i, in := range r.interactions {
+		if r.replayed[i] || in.Request.Method != req.Method || in.Request.URL != req.URL.RequestURI() {
+			continue
+		}
+		r.replayed[i] = true
+		return &http.Response{
+			Status:        fmt.Sprintf("%d %s", in.Response.StatusCode, http.StatusText(in.Response.StatusCode)),
+			StatusCode:    in.Response.StatusCode,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        in.Response.Header,
+			Body:          ioutil.NopCloser(bytes.NewBufferString(in.Response.Body)),
+			ContentLength: int64(len(in.Response.Body)),
+			Request:       req,
+		}, nil
+	}
+
+	return nil, errors.Errorf("recorder: no recorded response for %s %s", req.Method, req.URL.RequestURI())
+}
+
+func (r *Recorder) record(req *http.Request, body []byte) (*http.Response, error) {
+	resp, err := r.transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = ioutil.NopCloser(bytes.NewReader(respBody))
+
+	header := make(http.Header)
+	for k, v := range resp.Header {
+		if k != "Set-Cookie" {
+			header[k] = v
+		}
+	}
+
+	// Only the method, URI and body of the request are recorded, so that
+	// credentials sent in headers never reach the fixture.
+	in := Interaction{
+		Request: Request{
+			Method: req.Method,
+			URL:    req.URL.RequestURI(),
+			Body:   string(body),
+		},
+		Response: Response{
+			StatusCode: resp.StatusCode,
+			Header:     header,
+			Body:       string(respBody),
+		},
+	}
+
+	r.mu.Lock()
+	r.interactions = append(r.interactions, in)
+	r.mu.Unlock()
+
+	return resp, nil
+}
+
+// Stop finishes the recording. In ModeRecord, the recorded interactions are
+// sanitized and written to the fixture file.
+func (r *Recorder) Stop() error {
+	if r.mode != ModeRecord {
+		return nil
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.Sanitize != nil {
+		for i := range r.interactions {
+			r.Sanitize(&r.interactions[i])
+		}
+	}
+
+	b, err := json.MarshalIndent(r.interactions, "", "  ")
+	if err != nil {
+		return errors.Wrap(err, "could not encode fixture")
+	}
+	if err := ioutil.WriteFile(r.path, append(b, '\n'), os.FileMode(0644)); err != nil {
+		return errors.Wrap(err, "could not write fixture")
+	}
+	return nil
+}
@@ -0,0 +1,93 @@
+package recorder
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReplay(t *testing.T) {
+	rec, err := New("testdata/user.json", ModeReplay, nil)
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer rec.Stop()
+
+	api, err := cloudflare.New("deadbeef", "user@example.com", cloudflare.HTTPClient(&http.Client{Transport: rec}))
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	user, err := api.UserDetails()
+	if assert.NoError(t, err) {
+		assert.Equal(t, "7c5dae5552338874e5053f2534d2767a", user.ID)
+		assert.Equal(t, "cfuser12345", user.Username)
+	}
+
+	// Each recorded interaction is only replayed once.
+	_, err = api.UserDetails()
+	assert.Error(t, err)
+}
+
+func TestRecord(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		w.Header().Set("Set-Cookie", "__cfduid=secret")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"id": "1", "email": "user@example.com"}}`)
+	}))
+	defer server.Close()
+
+	dir, err := ioutil.TempDir("", "recorder")
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "fixture.json")
+
+	rec, err := New(path, ModeRecord, nil)
+	if !assert.NoError(t, err) {
+		return
+	}
+	rec.Sanitize = func(in *Interaction) {
+		in.Response.Body = strings.Replace(in.Response.Body, "user@example.com", "redacted@example.com", -1)
+	}
+
+	api, err := cloudflare.New("deadbeef", "user@example.com", cloudflare.HTTPClient(&http.Client{Transport: rec}))
+	if !assert.NoError(t, err) {
+		return
+	}
+	api.BaseURL = server.URL
+
+	_, err = api.UserDetails()
+	assert.NoError(t, err)
+	assert.NoError(t, rec.Stop())
+
+	b, err := ioutil.ReadFile(path)
+	if assert.NoError(t, err) {
+		assert.NotContains(t, string(b), "deadbeef")
+		assert.NotContains(t, string(b), "secret")
+		assert.NotContains(t, string(b), "user@example.com")
+		assert.Contains(t, string(b), "redacted@example.com")
+	}
+
+	// The new fixture can be replayed without the server.
+	server.Close()
+	rec, err = New(path, ModeReplay, nil)
+	if !assert.NoError(t, err) {
+		return
+	}
+	api, _ = cloudflare.New("deadbeef", "user@example.com", cloudflare.HTTPClient(&http.Client{Transport: rec}))
+	api.BaseURL = server.URL
+	user, err := api.UserDetails()
+	if assert.NoError(t, err) {
+		assert.Equal(t, "redacted@example.com", user.Email)
+	}
+}
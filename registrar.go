@@ -0,0 +1,92 @@
+package cloudflare
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// RegistrarDomain is a domain registered with, or being transferred to,
+// Cloudflare Registrar.
+type RegistrarDomain struct {
+	ID               string              `json:"id"`
+	Available        bool                `json:"available"`
+	SupportedTLD     bool                `json:"supported_tld"`
+	CanRegister      bool                `json:"can_register"`
+	TransferIn       RegistrarTransferIn `json:"transfer_in"`
+	CurrentRegistrar string              `json:"current_registrar"`
+	ExpiresAt        time.Time           `json:"expires_at"`
+	RegistryStatuses string              `json:"registry_statuses"`
+	Locked           bool                `json:"locked"`
+	CreatedAt        time.Time           `json:"created_at"`
+	UpdatedAt        time.Time           `json:"updated_at"`
+}
+
+// RegistrarTransferIn holds the status of each step of a domain transfer into
+// Cloudflare Registrar. Steps are "needed", "ok", "pending" or "unknown".
+type RegistrarTransferIn struct {
+	UnlockDomain      string `json:"unlock_domain"`
+	DisablePrivacy    string `json:"disable_privacy"`
+	EnterAuthCode     string `json:"enter_auth_code"`
+	ApproveTransfer   string `json:"approve_transfer"`
+	AcceptFoa         string `json:"accept_foa"`
+	CanCancelTransfer bool   `json:"can_cancel_transfer"`
+}
+
+// RegistrarDomains lists the domains of an account in Cloudflare Registrar,
+// including those with transfers in progress.
+//
+// API reference: https://api.cloudflare.com/#registrar-domains-list-domains
+func (api *API) RegistrarDomains(accountID string) ([]RegistrarDomain, error) {
+	var domains []RegistrarDomain
+	if _, err := api.makeRequestResult("GET", "/accounts/"+accountID+"/registrar/domains", nil, &domains); err != nil {
+		return nil, err
+	}
+	return domains, nil
+}
+
+// RegistrarDomain returns the details of a domain. For a domain not yet with
+// Cloudflare Registrar, SupportedTLD and Locked indicate whether it can be
+// transferred; during a transfer, TransferIn reports its progress.
+//
+// API reference: https://api.cloudflare.com/#registrar-domains-get-domain
+func (api *API) RegistrarDomain(accountID, domainName string) (RegistrarDomain, error) {
+	var domain RegistrarDomain
+	if _, err := api.makeRequestResult("GET", "/accounts/"+accountID+"/registrar/domains/"+domainName, nil, &domain); err != nil {
+		return RegistrarDomain{}, err
+	}
+	return domain, nil
+}
+
+// TransferRegistrarDomain initiates the transfer of a domain into Cloudflare
+// Registrar, submitting the authorization code obtained from the current
+// registrar. The domain must already be a zone on the account.
+//
+// API reference: https://api.cloudflare.com/#registrar-domains-transfer-domain
+func (api *API) TransferRegistrarDomain(accountID, domainName, authCode string) ([]RegistrarDomain, error) {
+	if authCode == "" {
+		return nil, errors.New("an authorization code is required to transfer a domain")
+	}
+	params := struct {
+		AuthCode string `json:"auth_code"`
+	}{
+		AuthCode: authCode,
+	}
+	var domains []RegistrarDomain
+	if _, err := api.makeRequestResult("POST", "/accounts/"+accountID+"/registrar/domains/"+domainName+"/transfer", params, &domains); err != nil {
+		return nil, err
+	}
+	return domains, nil
+}
+
+// CancelRegistrarDomainTransfer cancels a domain transfer which is still in
+// progress, if TransferIn.CanCancelTransfer allows it.
+//
+// API reference: https://api.cloudflare.com/#registrar-domains-cancel-transfer
+func (api *API) CancelRegistrarDomainTransfer(accountID, domainName string) ([]RegistrarDomain, error) {
+	var domains []RegistrarDomain
+	if _, err := api.makeRequestResult("POST", "/accounts/"+accountID+"/registrar/domains/"+domainName+"/cancel_transfer", nil, &domains); err != nil {
+		return nil, err
+	}
+	return domains, nil
+}
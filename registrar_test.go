@@ -0,0 +1,87 @@
+package cloudflare
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testRegistrarDomain = `{
+	"id": "ea95132c15732412d22c1476fa83f27a",
+	"available": false,
+	"supported_tld": true,
+	"can_register": false,
+	"transfer_in": {
+		"unlock_domain": "ok",
+		"disable_privacy": "ok",
+		"enter_auth_code": "needed",
+		"approve_transfer": "unknown",
+		"accept_foa": "needed",
+		"can_cancel_transfer": true
+	},
+	"current_registrar": "Example Registrar",
+	"registry_statuses": "ok",
+	"locked": false
+}`
+
+func TestRegistrarDomain(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/accounts/acc/registrar/domains/example.com", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{"success": true, "errors": [], "messages": [], "result": %s}`, testRegistrarDomain)
+	})
+
+	d, err := client.RegistrarDomain("acc", "example.com")
+	if assert.NoError(t, err) {
+		assert.True(t, d.SupportedTLD)
+		assert.False(t, d.Locked)
+		assert.Equal(t, "Example Registrar", d.CurrentRegistrar)
+		assert.Equal(t, RegistrarTransferIn{
+			UnlockDomain:      "ok",
+			DisablePrivacy:    "ok",
+			EnterAuthCode:     "needed",
+			ApproveTransfer:   "unknown",
+			AcceptFoa:         "needed",
+			CanCancelTransfer: true,
+		}, d.TransferIn)
+	}
+}
+
+func TestTransferRegistrarDomain(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/accounts/acc/registrar/domains/example.com/transfer", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		var params map[string]string
+		if assert.NoError(t, json.NewDecoder(r.Body).Decode(&params)) {
+			assert.Equal(t, "s3cret", params["auth_code"])
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{"success": true, "errors": [], "messages": [], "result": [%s]}`, testRegistrarDomain)
+	})
+	mux.HandleFunc("/accounts/acc/registrar/domains/example.com/cancel_transfer", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{"success": true, "errors": [], "messages": [], "result": [%s]}`, testRegistrarDomain)
+	})
+
+	domains, err := client.TransferRegistrarDomain("acc", "example.com", "s3cret")
+	if assert.NoError(t, err) && assert.Len(t, domains, 1) {
+		assert.Equal(t, "ea95132c15732412d22c1476fa83f27a", domains[0].ID)
+	}
+
+	_, err = client.TransferRegistrarDomain("acc", "example.com", "")
+	assert.Error(t, err)
+
+	domains, err = client.CancelRegistrarDomainTransfer("acc", "example.com")
+	if assert.NoError(t, err) {
+		assert.Len(t, domains, 1)
+	}
+}
@@ -0,0 +1,123 @@
+package cloudflare
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// RequestOption configures the requests made by a client derived with With.
+type RequestOption func(*requestOptions)
+
+type requestOptions struct {
+	header         http.Header
+	query          url.Values
+	userServiceKey string
+	timeout        time.Duration
+}
+
+// RequestHeader adds a header to requests, e.g. X-Auth-User-Service-Key for
+// the Origin CA endpoints. It is set after the client's own headers, so can
+// override them.
+func RequestHeader(key, value string) RequestOption {
+	return func(o *requestOptions) {
+		if o.header == nil {
+			o.header = make(http.Header)
+		}
+		o.header.Add(key, value)
+	}
+}
+
+// UserServiceKey authenticates requests with an Origin CA key (a user service
+// key) in place of the client's credentials, as the Origin CA certificate
+// endpoints require when the client does not use an API token.
+func UserServiceKey(key string) RequestOption {
+	return func(o *requestOptions) {
+		o.userServiceKey = key
+	}
+}
+
+// RequestTimeout limits how long each call may take, including any retries, to
+// d. It applies in addition to the timeout of the client's HTTP client, if it
+// has one, which limits each attempt; a call can be given more time than the
+// client's usual calls by leaving the HTTP client without a timeout and using
+// RequestTimeout for the others:
+//
+//	resp, err := api.With(cloudflare.RequestTimeout(2*time.Minute)).PurgeEverything(zoneID)
+func RequestTimeout(d time.Duration) RequestOption {
+	return func(o *requestOptions) {
+		o.timeout = d
+	}
+}
+
+// RequestQuery adds a query parameter to requests, for options of an endpoint
+// which a method does not support.
+func RequestQuery(key, value string) RequestOption {
+	return func(o *requestOptions) {
+		if o.query == nil {
+			o.query = make(url.Values)
+		}
+		o.query.Add(key, value)
+	}
+}
+
+// With returns a client which adds the given headers and query parameters to
+// every request, and otherwise behaves as, and shares its caches with, api. It
+// is intended for use with a single call:
+//
+//	records, err := api.With(cloudflare.RequestQuery("match", "any")).DNSRecords(zoneID, rr)
+func (api *API) With(opts ...RequestOption) *API {
+	c := *api
+	c.requestOptions = requestOptions{
+		header:         cloneHeader(api.requestOptions.header),
+		query:          cloneValues(api.requestOptions.query),
+		userServiceKey: api.requestOptions.userServiceKey,
+		timeout:        api.requestOptions.timeout,
+	}
+	for _, opt := range opts {
+		opt(&c.requestOptions)
+	}
+	return &c
+}
+
+// withTimeout returns a context limited by the request timeout, if there is
+// one.
+func (o requestOptions) withTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	if o.timeout <= 0 {
+		return ctx, func() {}
+	}
+	return context.WithTimeout(ctx, o.timeout)
+}
+
+// withQuery returns uri with the query parameters of the request options
+// added.
+func (o requestOptions) withQuery(uri string) string {
+	if len(o.query) == 0 {
+		return uri
+	}
+	sep := "?"
+	if strings.Contains(uri, "?") {
+		sep = "&"
+	}
+	return uri + sep + o.query.Encode()
+}
+
+func cloneHeader(h http.Header) http.Header {
+	if h == nil {
+		return nil
+	}
+	return h.Clone()
+}
+
+func cloneValues(v url.Values) url.Values {
+	if v == nil {
+		return nil
+	}
+	c := make(url.Values, len(v))
+	for k, vs := range v {
+		c[k] = append([]string(nil), vs...)
+	}
+	return c
+}
@@ -0,0 +1,62 @@
+package cloudflare
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWith(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var queries []string
+	var keys []string
+	mux.HandleFunc("/zones/z1/dns_records", func(w http.ResponseWriter, r *http.Request) {
+		queries = append(queries, r.URL.RawQuery)
+		keys = append(keys, r.Header.Get("X-Auth-User-Service-Key"))
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": []}`)
+	})
+
+	derived := client.With(RequestHeader("X-Auth-User-Service-Key", "v1.0-key"), RequestQuery("match", "any"))
+	_, err := derived.DNSRecords("z1", DNSRecord{Type: "A"})
+	assert.NoError(t, err)
+	_, err = derived.With(RequestQuery("order", "name")).DNSRecords("z1", DNSRecord{})
+	assert.NoError(t, err)
+	_, err = client.DNSRecords("z1", DNSRecord{})
+	assert.NoError(t, err)
+
+	assert.Equal(t, []string{"page=1&type=A&match=any", "page=1&match=any&order=name", "page=1"}, queries)
+	assert.Equal(t, []string{"v1.0-key", "v1.0-key", ""}, keys)
+	assert.Empty(t, client.requestOptions.query)
+	assert.True(t, derived.cache == client.cache)
+}
+
+func TestRequestTimeout(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/z1/pagerules", func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-time.After(100 * time.Millisecond):
+		case <-r.Context().Done():
+			return
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": []}`)
+	})
+
+	start := time.Now()
+	_, err := client.With(RequestTimeout(20 * time.Millisecond)).ListPageRules("z1")
+	assert.Error(t, err)
+	assert.True(t, time.Since(start) < 100*time.Millisecond)
+
+	_, err = client.ListPageRules("z1")
+	assert.NoError(t, err)
+	_, err = client.With(RequestTimeout(time.Second)).ListPageRules("z1")
+	assert.NoError(t, err)
+}
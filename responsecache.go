@@ -0,0 +1,153 @@
+package cloudflare
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// ResponseCacheStore stores the responses cached by CacheResponses, e.g. in
+// memory (see NewMemoryCacheStore) or a shared cache such as Redis. Keys are
+// opaque strings. Implementations must be safe for concurrent use.
+type ResponseCacheStore interface {
+	// Get returns the response stored for key, if it has not expired.
+	Get(key string) ([]byte, bool)
+	// Set stores a response for key for ttl.
+	Set(key string, body []byte, ttl time.Duration)
+	// Delete removes the response stored for key.
+	Delete(key string)
+	// DeletePrefix removes the responses whose keys start with prefix.
+	DeletePrefix(prefix string)
+}
+
+// CacheResponses caches the responses to GET requests, such as those made by
+// ListPageRules and ZoneDetails, in store for ttl, to reduce the API quota
+// used by read-heavy workloads like dashboards. Cached responses are returned
+// without making a request.
+//
+// A successful change made through the client invalidates the responses for
+// the changed object and its ancestors, e.g. changing
+// /zones/:zone_id/pagerules/:id invalidates the zone's page rules, the zone
+// and the list of zones. Changes made elsewhere are only seen once responses
+// expire. Responses are cached separately for each base URL and set of
+// credentials, so a store may be shared between clients.
+func CacheResponses(store ResponseCacheStore, ttl time.Duration) Option {
+	return func(api *API) error {
+		if store == nil {
+			return errors.New("response cache store must not be nil")
+		}
+		if ttl <= 0 {
+			return errors.New("response cache TTL must be positive")
+		}
+		api.responseCache = store
+		api.responseCacheTTL = ttl
+		return nil
+	}
+}
+
+// responseCacheKey returns the key of the response for uri, which is scoped
+// to the client's base URL and credentials.
+func (api *API) responseCacheKey(uri string) string {
+	h := sha256.New()
+	for _, s := range []string{api.BaseURL, api.APIKey, api.APIEmail, api.APIToken, api.APIUserServiceKey, api.requestOptions.userServiceKey} {
+		h.Write([]byte(s))
+		h.Write([]byte{0})
+	}
+	return hex.EncodeToString(h.Sum(nil)[:8]) + ":" + uri
+}
+
+// cachedResponseBody returns the cached response to a request, if there is
+// one.
+func (api *API) cachedResponseBody(method, uri string) ([]byte, bool) {
+	if api.responseCache == nil || method != "GET" {
+		return nil, false
+	}
+	return api.responseCache.Get(api.responseCacheKey(uri))
+}
+
+// updateResponseCache caches the response to a successful GET request, or
+// invalidates the responses affected by a change. Responses whose envelope
+// reports "success": false are not cached, even though their HTTP status was
+// 200.
+func (api *API) updateResponseCache(method, uri string, body []byte) {
+	if api.responseCache == nil {
+		return
+	}
+	if method == "GET" {
+		var r struct {
+			Success bool `json:"success"`
+		}
+		if err := api.json().Unmarshal(body, &r); err == nil && r.Success {
+			api.responseCache.Set(api.responseCacheKey(uri), body, api.responseCacheTTL)
+		}
+		return
+	}
+
+	path := uri
+	if i := strings.IndexByte(path, '?'); i >= 0 {
+		path = path[:i]
+	}
+	path = strings.TrimSuffix(path, "/")
+	api.responseCache.DeletePrefix(api.responseCacheKey(path))
+	for i := strings.LastIndexByte(path, '/'); i > 0; i = strings.LastIndexByte(path, '/') {
+		path = path[:i]
+		api.responseCache.Delete(api.responseCacheKey(path))
+		api.responseCache.DeletePrefix(api.responseCacheKey(path + "?"))
+	}
+}
+
+// NewMemoryCacheStore returns a ResponseCacheStore which keeps responses in
+// memory. Expired responses are removed when they are next looked up.
+func NewMemoryCacheStore() ResponseCacheStore {
+	return &memoryCacheStore{entries: make(map[string]memoryCacheEntry)}
+}
+
+type memoryCacheStore struct {
+	mu      sync.Mutex
+	entries map[string]memoryCacheEntry
+}
+
+type memoryCacheEntry struct {
+	body    []byte
+	expires time.Time
+}
+
+func (s *memoryCacheStore) Get(key string) ([]byte, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	e, ok := s.entries[key]
+	if !ok {
+		return nil, false
+	}
+	if time.Now().After(e.expires) {
+		delete(s.entries, key)
+		return nil, false
+	}
+	return e.body, true
+}
+
+func (s *memoryCacheStore) Set(key string, body []byte, ttl time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.entries[key] = memoryCacheEntry{body: body, expires: time.Now().Add(ttl)}
+}
+
+func (s *memoryCacheStore) Delete(key string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.entries, key)
+}
+
+func (s *memoryCacheStore) DeletePrefix(prefix string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for key := range s.entries {
+		if strings.HasPrefix(key, prefix) {
+			delete(s.entries, key)
+		}
+	}
+}
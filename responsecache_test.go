@@ -0,0 +1,110 @@
+package cloudflare
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCacheResponses(t *testing.T) {
+	setup()
+	defer teardown()
+
+	requests := make(map[string]int)
+	mux.HandleFunc("/zones/z1/pagerules", func(w http.ResponseWriter, r *http.Request) {
+		requests[r.Method+" "+r.URL.Path]++
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": [{"id": "r1", "status": "active"}]}`)
+	})
+	mux.HandleFunc("/zones/z1/pagerules/r1", func(w http.ResponseWriter, r *http.Request) {
+		requests[r.Method+" "+r.URL.Path]++
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"id": "r1", "status": "disabled"}}`)
+	})
+	mux.HandleFunc("/zones/z1", func(w http.ResponseWriter, r *http.Request) {
+		requests[r.Method+" "+r.URL.Path]++
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"id": "z1", "name": "example.com"}}`)
+	})
+	mux.HandleFunc("/zones/z2", func(w http.ResponseWriter, r *http.Request) {
+		requests[r.Method+" "+r.URL.Path]++
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"id": "z2", "name": "example.org"}}`)
+	})
+
+	store := NewMemoryCacheStore()
+	assert.NoError(t, CacheResponses(store, time.Minute)(client))
+
+	for i := 0; i < 3; i++ {
+		rules, err := client.ListPageRules("z1")
+		assert.NoError(t, err)
+		assert.Len(t, rules, 1)
+		_, err = client.ZoneDetails("z1")
+		assert.NoError(t, err)
+		_, err = client.ZoneDetails("z2")
+		assert.NoError(t, err)
+	}
+	assert.Equal(t, map[string]int{"GET /zones/z1/pagerules": 1, "GET /zones/z1": 1, "GET /zones/z2": 1}, requests)
+
+	// Changing a page rule invalidates the zone's page rules and the zone,
+	// but not other zones.
+	_, err := client.ChangePageRule("z1", "r1", PageRule{Status: "disabled"})
+	assert.NoError(t, err)
+	_, err = client.ListPageRules("z1")
+	assert.NoError(t, err)
+	_, err = client.ZoneDetails("z1")
+	assert.NoError(t, err)
+	_, err = client.ZoneDetails("z2")
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]int{"GET /zones/z1/pagerules": 2, "GET /zones/z1": 2, "GET /zones/z2": 1, "PATCH /zones/z1/pagerules/r1": 1}, requests)
+
+	// Clients with other credentials do not see the cached responses.
+	other, err := NewWithAPIToken("token", BaseURL(server.URL), CacheResponses(store, time.Minute))
+	if assert.NoError(t, err) {
+		_, err = other.ZoneDetails("z2")
+		assert.NoError(t, err)
+		assert.Equal(t, 2, requests["GET /zones/z2"])
+	}
+
+	_, err = New("deadbeef", "cloudflare@example.org", CacheResponses(nil, time.Minute))
+	assert.Error(t, err)
+}
+
+func TestCacheResponsesUnsuccessful(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var requests int
+	mux.HandleFunc("/zones/z1", func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": false, "errors": [{"code": 1000, "message": "Try again"}], "messages": [], "result": null}`)
+	})
+	assert.NoError(t, CacheResponses(NewMemoryCacheStore(), time.Minute)(client))
+
+	for i := 0; i < 2; i++ {
+		_, err := client.ZoneDetails("z1")
+		assert.Error(t, err)
+	}
+	assert.Equal(t, 2, requests)
+}
+
+func TestMemoryCacheStore(t *testing.T) {
+	s := NewMemoryCacheStore()
+	s.Set("a/1", []byte("1"), time.Minute)
+	s.Set("a/2", []byte("2"), time.Minute)
+	s.Set("b", []byte("b"), -time.Second)
+
+	body, ok := s.Get("a/1")
+	assert.True(t, ok)
+	assert.Equal(t, "1", string(body))
+	_, ok = s.Get("b")
+	assert.False(t, ok)
+
+	s.DeletePrefix("a/")
+	_, ok = s.Get("a/2")
+	assert.False(t, ok)
+}
@@ -0,0 +1,59 @@
+package cloudflare
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/pkg/errors"
+)
+
+// ResponseTooLargeError is returned when a response body is larger than the
+// limit set with MaxResponseSize. Errors returned by the client's methods
+// wrap it; use errors.Cause to retrieve it.
+type ResponseTooLargeError struct {
+	Method string
+	URI    string
+	Limit  int64
+}
+
+func (e *ResponseTooLargeError) Error() string {
+	return fmt.Sprintf("response to %s %s exceeds the limit of %d bytes", e.Method, e.URI, e.Limit)
+}
+
+// MaxResponseSize limits the size of the response bodies the client reads
+// into memory to n bytes (after decompression), protecting long-running
+// programs from unbounded memory use should an endpoint return an enormous
+// payload. Larger responses fail with a *ResponseTooLargeError and are not
+// retried. Responses are unlimited by default. Methods which stream their
+// results, such as StreamDNSRecords and ExportDNSRecords, are not limited.
+func MaxResponseSize(n int64) Option {
+	return func(api *API) error {
+		if n <= 0 {
+			return errors.New("maximum response size must be positive")
+		}
+		api.maxResponseSize = n
+		return nil
+	}
+}
+
+// readResponseBody reads the body of resp, failing without reading further
+// if it is larger than the client's maximum response size.
+func (api *API) readResponseBody(method, uri string, resp *http.Response) ([]byte, error) {
+	if api.maxResponseSize <= 0 {
+		return ioutil.ReadAll(resp.Body)
+	}
+	tooLarge := &ResponseTooLargeError{Method: method, URI: uri, Limit: api.maxResponseSize}
+	if resp.ContentLength > api.maxResponseSize {
+		return nil, tooLarge
+	}
+	body, err := ioutil.ReadAll(io.LimitReader(resp.Body, api.maxResponseSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(body)) > api.maxResponseSize {
+		return nil, tooLarge
+	}
+	return body, nil
+}
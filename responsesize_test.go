@@ -0,0 +1,44 @@
+package cloudflare
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMaxResponseSize(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var attempts int
+	mux.HandleFunc("/zones/z1/pagerules", func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.Header().Set("content-type", "application/json")
+		// Flushing first makes the response chunked, with no Content-Length.
+		w.(http.Flusher).Flush()
+		fmt.Fprintf(w, `{"success": true, "errors": [], "messages": [], "result": [{"id": %q}]}`, strings.Repeat("a", 1000))
+	})
+	mux.HandleFunc("/zones/z2/pagerules", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": []}`)
+	})
+
+	assert.Error(t, MaxResponseSize(0)(client))
+	assert.NoError(t, MaxResponseSize(500)(client))
+	client.retryPolicy = RetryPolicy{MaxAttempts: 3}
+
+	_, err := client.ListPageRules("z1")
+	if e, ok := errors.Cause(err).(*ResponseTooLargeError); assert.True(t, ok) {
+		assert.Equal(t, int64(500), e.Limit)
+		assert.Equal(t, "response to GET /zones/z1/pagerules exceeds the limit of 500 bytes", e.Error())
+	}
+	assert.Equal(t, 1, attempts)
+
+	rules, err := client.ListPageRules("z2")
+	assert.NoError(t, err)
+	assert.Empty(t, rules)
+}
@@ -0,0 +1,121 @@
+package cloudflare
+
+import (
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// RetryPolicy controls how requests are retried after network errors,
+// rate limiting (HTTP 429) and transient server errors (HTTP 5xx).
+//
+// The delay before each retry doubles from BaseDelay up to MaxDelay. Jitter,
+// between 0 and 1, is the fraction of each delay which is randomised so that
+// concurrent clients do not retry in lockstep. A Retry-After header on a
+// rate limited response is respected when it asks for a longer delay.
+//
+// MaxAttempts counts the first attempt, and must not be negative; a policy
+// allowing one attempt or none does not retry. BaseDelay must be positive and
+// no greater than MaxDelay.
+type RetryPolicy struct {
+	MaxAttempts int
+	BaseDelay   time.Duration
+	MaxDelay    time.Duration
+	Jitter      float64
+}
+
+// DefaultRetryPolicy is a retry policy suitable for most uses.
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts: 4,
+	BaseDelay:   500 * time.Millisecond,
+	MaxDelay:    30 * time.Second,
+	Jitter:      0.5,
+}
+
+// Retry makes the client retry failed requests according to policy. Requests
+// are not retried by default. Only requests made with a JSON body (or none)
+// are retried; uploads streamed from an io.Reader are not. Requests creating
+// objects are only retried if they cannot have been acted on, when the
+// connection failed or they were rate limited. Requests failing because the
+// API is unavailable (see ErrAPIUnavailable) are retried like other service
+// failures. An invalid policy is an error.
+func Retry(policy RetryPolicy) Option {
+	return func(api *API) error {
+		if err := policy.validate(); err != nil {
+			return err
+		}
+		api.retryPolicy = policy
+		return nil
+	}
+}
+
+// validate returns an error describing why the policy is invalid, if it is.
+func (p RetryPolicy) validate() error {
+	switch {
+	case p.MaxAttempts < 0:
+		return errors.Errorf("retry policy max attempts %d must not be negative", p.MaxAttempts)
+	case p.BaseDelay <= 0:
+		return errors.Errorf("retry policy base delay %s must be positive", p.BaseDelay)
+	case p.MaxDelay < p.BaseDelay:
+		return errors.Errorf("retry policy max delay %s must not be less than base delay %s", p.MaxDelay, p.BaseDelay)
+	case !(p.Jitter >= 0 && p.Jitter <= 1):
+		return errors.Errorf("retry policy jitter %v must be between 0 and 1", p.Jitter)
+	}
+	return nil
+}
+
+// retryable reports whether a response with the given status code may
+// succeed if the request is repeated.
+func retryable(statusCode int) bool {
+	switch statusCode {
+	case http.StatusTooManyRequests, http.StatusInternalServerError, http.StatusBadGateway,
+		http.StatusServiceUnavailable, http.StatusGatewayTimeout, 520, 521, 522, 523, 524:
+		return true
+	}
+	return false
+}
+
+// retryDelay returns how long to wait before making another attempt at a
+// request, after attempts attempts which ended with resp or err, and whether
+// another attempt should be made at all.
+func (p RetryPolicy) retryDelay(attempts int, resp *http.Response, err error) (time.Duration, bool) {
+	if attempts >= p.MaxAttempts {
+		return 0, false
+	}
+	if err == nil && !retryable(resp.StatusCode) {
+		return 0, false
+	}
+
+	delay := p.BaseDelay << uint(attempts-1)
+	if delay > p.MaxDelay || delay <= 0 {
+		delay = p.MaxDelay
+	}
+	if p.Jitter > 0 && delay > 0 {
+		delay -= time.Duration(rand.Int63n(int64(float64(delay)*p.Jitter) + 1))
+	}
+
+	if resp != nil && resp.StatusCode == http.StatusTooManyRequests {
+		if after := retryAfter(resp.Header.Get("Retry-After")); after > delay {
+			delay = after
+		}
+	}
+	return delay, true
+}
+
+// retryAfter parses the value of a Retry-After header, which is either a
+// number of seconds or an HTTP date.
+func retryAfter(v string) time.Duration {
+	if v == "" {
+		return 0
+	}
+	if secs, err := strconv.Atoi(v); err == nil {
+		return time.Duration(secs) * time.Second
+	}
+	if t, err := http.ParseTime(v); err == nil {
+		return time.Until(t)
+	}
+	return 0
+}
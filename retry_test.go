@@ -0,0 +1,118 @@
+package cloudflare
+
+import (
+	"fmt"
+	"io/ioutil"
+	"math"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRetry(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var attempts int
+	mux.HandleFunc("/zones/z1/purge_cache", func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		body, _ := ioutil.ReadAll(r.Body)
+		assert.JSONEq(t, `{"purge_everything": true}`, string(body))
+		switch attempts {
+		case 1:
+			w.WriteHeader(http.StatusServiceUnavailable)
+		case 2:
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+		default:
+			w.Header().Set("content-type", "application/json")
+			fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"id": "z1"}}`)
+		}
+	})
+
+	rec := &recordingInterceptor{}
+	assert.NoError(t, Intercept(rec)(client))
+	client.retryPolicy = RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond, MaxDelay: time.Millisecond}
+	_, err := client.PurgeEverything("z1")
+	assert.NoError(t, err)
+	assert.Equal(t, 3, attempts)
+	if assert.Len(t, rec.responses, 3) {
+		assert.Equal(t, 3, rec.responses[2].Attempt)
+		assert.Equal(t, http.StatusTooManyRequests, rec.responses[1].StatusCode)
+	}
+
+	attempts = 0
+	client.retryPolicy = RetryPolicy{MaxAttempts: 2, BaseDelay: time.Millisecond, MaxDelay: time.Millisecond}
+	_, err = client.PurgeEverything("z1")
+	assert.Error(t, err)
+	assert.Equal(t, 2, attempts)
+}
+
+func TestRetryDisabledByDefault(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var attempts int
+	mux.HandleFunc("/zones/z1", func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusBadGateway)
+	})
+
+	_, err := client.ZoneDetails("z1")
+	assert.Error(t, err)
+	assert.Equal(t, 1, attempts)
+}
+
+func TestRetryDelay(t *testing.T) {
+	p := RetryPolicy{MaxAttempts: 5, BaseDelay: time.Second, MaxDelay: 3 * time.Second}
+	resp := func(code int, retryAfter string) *http.Response {
+		r := &http.Response{StatusCode: code, Header: make(http.Header)}
+		if retryAfter != "" {
+			r.Header.Set("Retry-After", retryAfter)
+		}
+		return r
+	}
+
+	d, ok := p.retryDelay(1, resp(500, ""), nil)
+	assert.True(t, ok)
+	assert.Equal(t, time.Second, d)
+	d, _ = p.retryDelay(2, resp(500, ""), nil)
+	assert.Equal(t, 2*time.Second, d)
+	d, _ = p.retryDelay(3, nil, fmt.Errorf("connection reset"))
+	assert.Equal(t, 3*time.Second, d)
+	d, _ = p.retryDelay(1, resp(429, "10"), nil)
+	assert.Equal(t, 10*time.Second, d)
+
+	_, ok = p.retryDelay(1, resp(400, ""), nil)
+	assert.False(t, ok)
+	_, ok = p.retryDelay(5, resp(500, ""), nil)
+	assert.False(t, ok)
+
+	p.Jitter = 0.5
+	for i := 0; i < 100; i++ {
+		d, _ = p.retryDelay(2, resp(500, ""), nil)
+		assert.True(t, d >= time.Second && d <= 2*time.Second, "delay %s out of range", d)
+	}
+}
+
+func TestRetryPolicyValidation(t *testing.T) {
+	setup()
+	defer teardown()
+
+	assert.NoError(t, Retry(DefaultRetryPolicy)(client))
+	assert.NoError(t, Retry(RetryPolicy{BaseDelay: time.Second, MaxDelay: time.Second, Jitter: 1})(client))
+
+	for _, p := range []RetryPolicy{
+		{MaxAttempts: -1, BaseDelay: time.Second, MaxDelay: time.Second},
+		{MaxAttempts: 3, MaxDelay: time.Second},
+		{MaxAttempts: 3, BaseDelay: -time.Second, MaxDelay: time.Second},
+		{MaxAttempts: 3, BaseDelay: 2 * time.Second, MaxDelay: time.Second},
+		{MaxAttempts: 3, BaseDelay: time.Second, MaxDelay: time.Second, Jitter: -0.1},
+		{MaxAttempts: 3, BaseDelay: time.Second, MaxDelay: time.Second, Jitter: 1.5},
+		{MaxAttempts: 3, BaseDelay: time.Second, MaxDelay: time.Second, Jitter: math.NaN()},
+	} {
+		assert.Error(t, Retry(p)(client), "%+v", p)
+	}
+}
@@ -0,0 +1,65 @@
+package cloudflare
+
+import "time"
+
+// Ruleset phases.
+const (
+	RulesetPhaseHTTPCustomErrors = "http_custom_errors"
+)
+
+// Ruleset is a list of rules run in a phase of request processing.
+type Ruleset struct {
+	ID          string        `json:"id,omitempty"`
+	Name        string        `json:"name,omitempty"`
+	Description string        `json:"description,omitempty"`
+	Kind        string        `json:"kind,omitempty"`
+	Phase       string        `json:"phase,omitempty"`
+	Version     string        `json:"version,omitempty"`
+	LastUpdated *time.Time    `json:"last_updated,omitempty"`
+	Rules       []RulesetRule `json:"rules"`
+}
+
+// RulesetRule is a rule which applies an action to requests matching its
+// expression.
+type RulesetRule struct {
+	ID               string                       `json:"id,omitempty"`
+	Ref              string                       `json:"ref,omitempty"`
+	Action           string                       `json:"action"`
+	ActionParameters *RulesetRuleActionParameters `json:"action_parameters,omitempty"`
+	Expression       string                       `json:"expression"`
+	Description      string                       `json:"description,omitempty"`
+	Enabled          bool                         `json:"enabled"`
+}
+
+// RulesetRuleActionParameters holds the parameters of a rule's action. Only
+// the fields relevant to the action are set.
+type RulesetRuleActionParameters struct {
+	// Parameters of the "serve_error" action.
+	Content     string `json:"content,omitempty"`
+	ContentType string `json:"content_type,omitempty"`
+	StatusCode  int    `json:"status_code,omitempty"`
+}
+
+// ZoneRulesetPhaseEntrypoint returns the entry point ruleset of a phase for a
+// zone.
+//
+// API reference: https://api.cloudflare.com/#zone-rulesets-get-a-zone-entry-point-ruleset
+func (api *API) ZoneRulesetPhaseEntrypoint(zoneID, phase string) (Ruleset, error) {
+	var rs Ruleset
+	if _, err := api.makeRequestResult("GET", "/zones/"+zoneID+"/rulesets/phases/"+phase+"/entrypoint", nil, &rs); err != nil {
+		return Ruleset{}, err
+	}
+	return rs, nil
+}
+
+// UpdateZoneRulesetPhaseEntrypoint replaces the rules of the entry point
+// ruleset of a phase for a zone, creating the ruleset if it does not exist.
+//
+// API reference: https://api.cloudflare.com/#zone-rulesets-update-a-zone-entry-point-ruleset
+func (api *API) UpdateZoneRulesetPhaseEntrypoint(zoneID, phase string, rs Ruleset) (Ruleset, error) {
+	var result Ruleset
+	if _, err := api.makeRequestResult("PUT", "/zones/"+zoneID+"/rulesets/phases/"+phase+"/entrypoint", rs, &result); err != nil {
+		return Ruleset{}, err
+	}
+	return result, nil
+}
@@ -1,11 +1,5 @@
 package cloudflare
 
-import (
-	"encoding/json"
-
-	"github.com/pkg/errors"
-)
-
 // ZoneCustomSSL represents custom SSL certificate metadata.
 type ZoneCustomSSL struct {
 	ID            string     `json:"id"`
@@ -22,18 +16,6 @@ type ZoneCustomSSL struct {
 	KeylessServer KeylessSSL `json:"keyless_server"`
 }
 
-// zoneCustomSSLResponse represents the response from the zone SSL details endpoint.
-type zoneCustomSSLResponse struct {
-	Response
-	Result ZoneCustomSSL `json:"result"`
-}
-
-// zoneCustomSSLsResponse represents the response from the zone SSL list endpoint.
-type zoneCustomSSLsResponse struct {
-	Response
-	Result []ZoneCustomSSL `json:"result"`
-}
-
 // ZoneCustomSSLOptions represents the parameters to create or update an existing
 // custom SSL configuration.
 type ZoneCustomSSLOptions struct {
@@ -55,15 +37,11 @@ type ZoneCustomSSLPriority struct {
 // 	POST /zones/:zone_identifier/custom_certificates
 func (api *API) CreateSSL(zoneID string, options ZoneCustomSSLOptions) (ZoneCustomSSL, error) {
 	uri := "/zones/" + zoneID + "/custom_certificates"
-	res, err := api.makeRequest("POST", uri, options)
-	if err != nil {
-		return ZoneCustomSSL{}, errors.Wrap(err, errMakeRequestError)
+	var result ZoneCustomSSL
+	if _, err := api.makeRequestResult("POST", uri, options, &result); err != nil {
+		return ZoneCustomSSL{}, err
 	}
-	var r zoneCustomSSLResponse
-	if err := json.Unmarshal(res, &r); err != nil {
-		return ZoneCustomSSL{}, errors.Wrap(err, errUnmarshalError)
-	}
-	return r.Result, nil
+	return result, nil
 }
 
 // ListSSL lists the custom certificates for the given zone.
@@ -72,15 +50,11 @@ func (api *API) CreateSSL(zoneID string, options ZoneCustomSSLOptions) (ZoneCust
 // 	GET /zones/:zone_identifier/custom_certificates
 func (api *API) ListSSL(zoneID string) ([]ZoneCustomSSL, error) {
 	uri := "/zones/" + zoneID + "/custom_certificates"
-	res, err := api.makeRequest("GET", uri, nil)
-	if err != nil {
-		return nil, errors.Wrap(err, errMakeRequestError)
-	}
-	var r zoneCustomSSLsResponse
-	if err := json.Unmarshal(res, &r); err != nil {
-		return nil, errors.Wrap(err, errUnmarshalError)
+	var result []ZoneCustomSSL
+	if _, err := api.makeRequestResult("GET", uri, nil, &result); err != nil {
+		return nil, err
 	}
-	return r.Result, nil
+	return result, nil
 }
 
 // SSLDetails returns the configuration details for a custom SSL certificate.
@@ -89,15 +63,11 @@ func (api *API) ListSSL(zoneID string) ([]ZoneCustomSSL, error) {
 // 	GET /zones/:zone_identifier/custom_certificates/:identifier
 func (api *API) SSLDetails(zoneID, certificateID string) (ZoneCustomSSL, error) {
 	uri := "/zones/" + zoneID + "/custom_certificates/" + certificateID
-	res, err := api.makeRequest("GET", uri, nil)
-	if err != nil {
-		return ZoneCustomSSL{}, errors.Wrap(err, errMakeRequestError)
-	}
-	var r zoneCustomSSLResponse
-	if err := json.Unmarshal(res, &r); err != nil {
-		return ZoneCustomSSL{}, errors.Wrap(err, errUnmarshalError)
+	var result ZoneCustomSSL
+	if _, err := api.makeRequestResult("GET", uri, nil, &result); err != nil {
+		return ZoneCustomSSL{}, err
 	}
-	return r.Result, nil
+	return result, nil
 }
 
 // UpdateSSL updates (replaces) a custom SSL certificate.
@@ -106,15 +76,11 @@ func (api *API) SSLDetails(zoneID, certificateID string) (ZoneCustomSSL, error)
 // 	PATCH /zones/:zone_identifier/custom_certificates/:identifier
 func (api *API) UpdateSSL(zoneID, certificateID string, options ZoneCustomSSLOptions) (ZoneCustomSSL, error) {
 	uri := "/zones/" + zoneID + "/custom_certificates/" + certificateID
-	res, err := api.makeRequest("PATCH", uri, options)
-	if err != nil {
-		return ZoneCustomSSL{}, errors.Wrap(err, errMakeRequestError)
+	var result ZoneCustomSSL
+	if _, err := api.makeRequestResult("PATCH", uri, options, &result); err != nil {
+		return ZoneCustomSSL{}, err
 	}
-	var r zoneCustomSSLResponse
-	if err := json.Unmarshal(res, &r); err != nil {
-		return ZoneCustomSSL{}, errors.Wrap(err, errUnmarshalError)
-	}
-	return r.Result, nil
+	return result, nil
 }
 
 // ReprioritizeSSL allows you to change the priority (which is served for a given
@@ -129,15 +95,11 @@ func (api *API) ReprioritizeSSL(zoneID string, p []ZoneCustomSSLPriority) ([]Zon
 	}{
 		Certificates: p,
 	}
-	res, err := api.makeRequest("PUT", uri, params)
-	if err != nil {
-		return nil, errors.Wrap(err, errMakeRequestError)
-	}
-	var r zoneCustomSSLsResponse
-	if err := json.Unmarshal(res, &r); err != nil {
-		return nil, errors.Wrap(err, errUnmarshalError)
+	var result []ZoneCustomSSL
+	if _, err := api.makeRequestResult("PUT", uri, params, &result); err != nil {
+		return nil, err
 	}
-	return r.Result, nil
+	return result, nil
 }
 
 // DeleteSSL deletes a custom SSL certificate from the given zone.
@@ -146,8 +108,8 @@ func (api *API) ReprioritizeSSL(zoneID string, p []ZoneCustomSSLPriority) ([]Zon
 // 	DELETE /zones/:zone_identifier/custom_certificates/:identifier
 func (api *API) DeleteSSL(zoneID, certificateID string) error {
 	uri := "/zones/" + zoneID + "/custom_certificates/" + certificateID
-	if _, err := api.makeRequest("DELETE", uri, nil); err != nil {
-		return errors.Wrap(err, errMakeRequestError)
+	if _, err := api.makeRequestResult("DELETE", uri, nil, nil); err != nil {
+		return err
 	}
 	return nil
 }
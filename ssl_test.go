@@ -363,7 +363,12 @@ func TestDeleteSSL(t *testing.T) {
 		assert.Equal(t, "DELETE", r.Method, "Expected method 'DELETE', got %s", r.Method)
 		w.Header().Set("content-type", "application/json")
 		fmt.Fprint(w, `{
-          "id": "7e7b8deba8538af625850b7b2530034c"
+          "success": true,
+          "errors": [],
+          "messages": [],
+          "result": {
+            "id": "7e7b8deba8538af625850b7b2530034c"
+          }
         }`)
 	}
 
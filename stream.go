@@ -0,0 +1,203 @@
+package cloudflare
+
+import "time"
+
+// StreamLiveInput is a Stream Live input which accepts a live broadcast.
+type StreamLiveInput struct {
+	UID                      string                    `json:"uid,omitempty"`
+	Meta                     map[string]interface{}    `json:"meta,omitempty"`
+	DefaultCreator           string                    `json:"defaultCreator,omitempty"`
+	Recording                *StreamLiveInputRecording `json:"recording,omitempty"`
+	DeleteRecordingAfterDays int                       `json:"deleteRecordingAfterDays,omitempty"`
+	RTMPS                    *StreamLiveInputRTMPS     `json:"rtmps,omitempty"`
+	RTMPSPlayback            *StreamLiveInputRTMPS     `json:"rtmpsPlayback,omitempty"`
+	SRT                      *StreamLiveInputSRT       `json:"srt,omitempty"`
+	SRTPlayback              *StreamLiveInputSRT       `json:"srtPlayback,omitempty"`
+	Created                  *time.Time                `json:"created,omitempty"`
+	Modified                 *time.Time                `json:"modified,omitempty"`
+}
+
+// StreamLiveInputRecording controls whether and how a live input's broadcasts
+// are recorded. Mode is "off" or "automatic".
+type StreamLiveInputRecording struct {
+	Mode              string   `json:"mode,omitempty"`
+	RequireSignedURLs bool     `json:"requireSignedURLs"`
+	AllowedOrigins    []string `json:"allowedOrigins,omitempty"`
+	TimeoutSeconds    int      `json:"timeoutSeconds,omitempty"`
+}
+
+// StreamLiveInputRTMPS holds the URL and key for broadcasting to, or playing
+// back from, a live input over RTMPS.
+type StreamLiveInputRTMPS struct {
+	URL       string `json:"url"`
+	StreamKey string `json:"streamKey"`
+}
+
+// StreamLiveInputSRT holds the URL and credentials for broadcasting to, or
+// playing back from, a live input over SRT.
+type StreamLiveInputSRT struct {
+	URL        string `json:"url"`
+	StreamID   string `json:"streamId"`
+	Passphrase string `json:"passphrase"`
+}
+
+// StreamLiveInputOutput restreams a live input to another RTMP(S) or SRT
+// destination.
+type StreamLiveInputOutput struct {
+	UID       string `json:"uid,omitempty"`
+	URL       string `json:"url"`
+	StreamKey string `json:"streamKey,omitempty"`
+	Enabled   bool   `json:"enabled"`
+}
+
+// StreamWebhook is the webhook which receives notifications about Stream
+// videos and live inputs.
+type StreamWebhook struct {
+	NotificationURL string     `json:"notificationUrl"`
+	Secret          string     `json:"secret,omitempty"`
+	Modified        *time.Time `json:"modified,omitempty"`
+}
+
+// StreamLiveInputs lists the live inputs of an account.
+//
+// API reference: https://developers.cloudflare.com/api/operations/stream-live-inputs-list-live-inputs
+func (api *API) StreamLiveInputs(accountID string) ([]StreamLiveInput, error) {
+	var result struct {
+		LiveInputs []StreamLiveInput `json:"liveInputs"`
+	}
+	if _, err := api.makeRequestResult("GET", "/accounts/"+accountID+"/stream/live_inputs", nil, &result); err != nil {
+		return nil, err
+	}
+	return result.LiveInputs, nil
+}
+
+// CreateStreamLiveInput creates a live input. The returned input holds the
+// RTMPS and SRT details for broadcasting to it.
+//
+// API reference: https://developers.cloudflare.com/api/operations/stream-live-inputs-create-a-live-input
+func (api *API) CreateStreamLiveInput(accountID string, input StreamLiveInput) (StreamLiveInput, error) {
+	var result StreamLiveInput
+	if _, err := api.makeRequestResult("POST", "/accounts/"+accountID+"/stream/live_inputs", input, &result); err != nil {
+		return StreamLiveInput{}, err
+	}
+	return result, nil
+}
+
+// StreamLiveInput returns a live input.
+//
+// API reference: https://developers.cloudflare.com/api/operations/stream-live-inputs-retrieve-a-live-input
+func (api *API) StreamLiveInput(accountID, inputID string) (StreamLiveInput, error) {
+	var result StreamLiveInput
+	if _, err := api.makeRequestResult("GET", "/accounts/"+accountID+"/stream/live_inputs/"+inputID, nil, &result); err != nil {
+		return StreamLiveInput{}, err
+	}
+	return result, nil
+}
+
+// UpdateStreamLiveInput changes the settings of a live input, such as its
+// recording settings.
+//
+// API reference: https://developers.cloudflare.com/api/operations/stream-live-inputs-update-a-live-input
+func (api *API) UpdateStreamLiveInput(accountID, inputID string, input StreamLiveInput) (StreamLiveInput, error) {
+	// Connection details are generated by the API and cannot be changed.
+	input.UID, input.RTMPS, input.RTMPSPlayback, input.SRT, input.SRTPlayback = "", nil, nil, nil, nil
+	input.Created, input.Modified = nil, nil
+
+	var result StreamLiveInput
+	if _, err := api.makeRequestResult("PUT", "/accounts/"+accountID+"/stream/live_inputs/"+inputID, input, &result); err != nil {
+		return StreamLiveInput{}, err
+	}
+	return result, nil
+}
+
+// DeleteStreamLiveInput deletes a live input, preventing further broadcasts
+// to it. Existing recordings are kept.
+//
+// API reference: https://developers.cloudflare.com/api/operations/stream-live-inputs-delete-a-live-input
+func (api *API) DeleteStreamLiveInput(accountID, inputID string) error {
+	if _, err := api.makeRequestResult("DELETE", "/accounts/"+accountID+"/stream/live_inputs/"+inputID, nil, nil); err != nil {
+		return err
+	}
+	return nil
+}
+
+// StreamLiveInputOutputs lists the restreaming outputs of a live input.
+//
+// API reference: https://developers.cloudflare.com/api/operations/stream-live-inputs-list-all-outputs-associated-with-a-specified-live-input
+func (api *API) StreamLiveInputOutputs(accountID, inputID string) ([]StreamLiveInputOutput, error) {
+	var outputs []StreamLiveInputOutput
+	if _, err := api.makeRequestResult("GET", "/accounts/"+accountID+"/stream/live_inputs/"+inputID+"/outputs", nil, &outputs); err != nil {
+		return nil, err
+	}
+	return outputs, nil
+}
+
+// CreateStreamLiveInputOutput adds a restreaming output to a live input.
+//
+// API reference: https://developers.cloudflare.com/api/operations/stream-live-inputs-create-a-new-output,-connected-to-a-live-input
+func (api *API) CreateStreamLiveInputOutput(accountID, inputID string, output StreamLiveInputOutput) (StreamLiveInputOutput, error) {
+	var result StreamLiveInputOutput
+	if _, err := api.makeRequestResult("POST", "/accounts/"+accountID+"/stream/live_inputs/"+inputID+"/outputs", output, &result); err != nil {
+		return StreamLiveInputOutput{}, err
+	}
+	return result, nil
+}
+
+// EnableStreamLiveInputOutput enables or disables a restreaming output.
+//
+// API reference: https://developers.cloudflare.com/api/operations/stream-live-inputs-update-an-output
+func (api *API) EnableStreamLiveInputOutput(accountID, inputID, outputID string, enabled bool) (StreamLiveInputOutput, error) {
+	params := struct {
+		Enabled bool `json:"enabled"`
+	}{
+		Enabled: enabled,
+	}
+	var result StreamLiveInputOutput
+	if _, err := api.makeRequestResult("PUT", "/accounts/"+accountID+"/stream/live_inputs/"+inputID+"/outputs/"+outputID, params, &result); err != nil {
+		return StreamLiveInputOutput{}, err
+	}
+	return result, nil
+}
+
+// DeleteStreamLiveInputOutput removes a restreaming output from a live input.
+//
+// API reference: https://developers.cloudflare.com/api/operations/stream-live-inputs-delete-an-output
+func (api *API) DeleteStreamLiveInputOutput(accountID, inputID, outputID string) error {
+	if _, err := api.makeRequestResult("DELETE", "/accounts/"+accountID+"/stream/live_inputs/"+inputID+"/outputs/"+outputID, nil, nil); err != nil {
+		return err
+	}
+	return nil
+}
+
+// StreamWebhook returns the Stream webhook of an account.
+//
+// API reference: https://developers.cloudflare.com/api/operations/stream-webhook-view-webhooks
+func (api *API) StreamWebhook(accountID string) (StreamWebhook, error) {
+	var result StreamWebhook
+	if _, err := api.makeRequestResult("GET", "/accounts/"+accountID+"/stream/webhook", nil, &result); err != nil {
+		return StreamWebhook{}, err
+	}
+	return result, nil
+}
+
+// SetStreamWebhook sets the URL which receives Stream notifications. The
+// returned webhook holds the secret used to sign notifications.
+//
+// API reference: https://developers.cloudflare.com/api/operations/stream-webhook-create-webhooks
+func (api *API) SetStreamWebhook(accountID, notificationURL string) (StreamWebhook, error) {
+	var result StreamWebhook
+	if _, err := api.makeRequestResult("PUT", "/accounts/"+accountID+"/stream/webhook", StreamWebhook{NotificationURL: notificationURL}, &result); err != nil {
+		return StreamWebhook{}, err
+	}
+	return result, nil
+}
+
+// DeleteStreamWebhook removes the Stream webhook of an account.
+//
+// API reference: https://developers.cloudflare.com/api/operations/stream-webhook-delete-webhooks
+func (api *API) DeleteStreamWebhook(accountID string) error {
+	if _, err := api.makeRequestResult("DELETE", "/accounts/"+accountID+"/stream/webhook", nil, nil); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,161 @@
+package cloudflare
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStreamLiveInputs(t *testing.T) {
+	setup()
+	defer teardown()
+
+	const input = `{
+		"uid": "in1",
+		"meta": {"name": "keynote"},
+		"recording": {"mode": "automatic", "requireSignedURLs": false, "timeoutSeconds": 10},
+		"rtmps": {"url": "rtmps://live.cloudflare.com:443/live/", "streamKey": "key"},
+		"srt": {"url": "srt://live.cloudflare.com:778", "streamId": "sid", "passphrase": "pass"}
+	}`
+	mux.HandleFunc("/accounts/acc/stream/live_inputs", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		switch r.Method {
+		case "GET":
+			fmt.Fprintf(w, `{"success": true, "errors": [], "messages": [], "result": {"liveInputs": [%s], "total": 1}}`, input)
+		case "POST":
+			var in StreamLiveInput
+			if assert.NoError(t, json.NewDecoder(r.Body).Decode(&in)) {
+				assert.Equal(t, "automatic", in.Recording.Mode)
+			}
+			fmt.Fprintf(w, `{"success": true, "errors": [], "messages": [], "result": %s}`, input)
+		default:
+			t.Errorf("unexpected method %s", r.Method)
+		}
+	})
+	mux.HandleFunc("/accounts/acc/stream/live_inputs/in1", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		switch r.Method {
+		case "PUT":
+			var in map[string]interface{}
+			if assert.NoError(t, json.NewDecoder(r.Body).Decode(&in)) {
+				assert.NotContains(t, in, "rtmps")
+				assert.NotContains(t, in, "uid")
+			}
+			fmt.Fprintf(w, `{"success": true, "errors": [], "messages": [], "result": %s}`, input)
+		case "DELETE":
+			fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": null}`)
+		default:
+			t.Errorf("unexpected method %s", r.Method)
+		}
+	})
+
+	inputs, err := client.StreamLiveInputs("acc")
+	if assert.NoError(t, err) && assert.Len(t, inputs, 1) {
+		assert.Equal(t, "in1", inputs[0].UID)
+		assert.Equal(t, &StreamLiveInputRTMPS{URL: "rtmps://live.cloudflare.com:443/live/", StreamKey: "key"}, inputs[0].RTMPS)
+		assert.Equal(t, &StreamLiveInputSRT{URL: "srt://live.cloudflare.com:778", StreamID: "sid", Passphrase: "pass"}, inputs[0].SRT)
+	}
+
+	in, err := client.CreateStreamLiveInput("acc", StreamLiveInput{
+		Recording: &StreamLiveInputRecording{Mode: "automatic"},
+	})
+	if assert.NoError(t, err) {
+		assert.Equal(t, "in1", in.UID)
+	}
+
+	_, err = client.UpdateStreamLiveInput("acc", "in1", in)
+	assert.NoError(t, err)
+
+	assert.NoError(t, client.DeleteStreamLiveInput("acc", "in1"))
+}
+
+func TestStreamLiveInputOutputs(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/accounts/acc/stream/live_inputs/in1/outputs", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		switch r.Method {
+		case "GET":
+			fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": [{"uid": "o1", "url": "rtmp://a.example/live", "streamKey": "k", "enabled": true}]}`)
+		case "POST":
+			var out StreamLiveInputOutput
+			if assert.NoError(t, json.NewDecoder(r.Body).Decode(&out)) {
+				assert.Equal(t, "rtmp://b.example/live", out.URL)
+			}
+			fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"uid": "o2", "url": "rtmp://b.example/live", "enabled": true}}`)
+		default:
+			t.Errorf("unexpected method %s", r.Method)
+		}
+	})
+	mux.HandleFunc("/accounts/acc/stream/live_inputs/in1/outputs/o1", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		switch r.Method {
+		case "PUT":
+			var params map[string]bool
+			if assert.NoError(t, json.NewDecoder(r.Body).Decode(&params)) {
+				assert.Equal(t, map[string]bool{"enabled": false}, params)
+			}
+			fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"uid": "o1", "enabled": false}}`)
+		case "DELETE":
+			fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": null}`)
+		default:
+			t.Errorf("unexpected method %s", r.Method)
+		}
+	})
+
+	outputs, err := client.StreamLiveInputOutputs("acc", "in1")
+	if assert.NoError(t, err) {
+		assert.Equal(t, []StreamLiveInputOutput{{UID: "o1", URL: "rtmp://a.example/live", StreamKey: "k", Enabled: true}}, outputs)
+	}
+
+	out, err := client.CreateStreamLiveInputOutput("acc", "in1", StreamLiveInputOutput{URL: "rtmp://b.example/live", Enabled: true})
+	if assert.NoError(t, err) {
+		assert.Equal(t, "o2", out.UID)
+	}
+
+	out, err = client.EnableStreamLiveInputOutput("acc", "in1", "o1", false)
+	if assert.NoError(t, err) {
+		assert.False(t, out.Enabled)
+	}
+
+	assert.NoError(t, client.DeleteStreamLiveInputOutput("acc", "in1", "o1"))
+}
+
+func TestStreamWebhook(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/accounts/acc/stream/webhook", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		switch r.Method {
+		case "GET", "PUT":
+			if r.Method == "PUT" {
+				var hook StreamWebhook
+				if assert.NoError(t, json.NewDecoder(r.Body).Decode(&hook)) {
+					assert.Equal(t, "https://example.com/hook", hook.NotificationURL)
+				}
+			}
+			fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"notificationUrl": "https://example.com/hook", "secret": "s"}}`)
+		case "DELETE":
+			fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": ""}`)
+		default:
+			t.Errorf("unexpected method %s", r.Method)
+		}
+	})
+
+	hook, err := client.SetStreamWebhook("acc", "https://example.com/hook")
+	if assert.NoError(t, err) {
+		assert.Equal(t, "s", hook.Secret)
+	}
+
+	hook, err = client.StreamWebhook("acc")
+	if assert.NoError(t, err) {
+		assert.Equal(t, "https://example.com/hook", hook.NotificationURL)
+	}
+
+	assert.NoError(t, client.DeleteStreamWebhook("acc"))
+}
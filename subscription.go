@@ -0,0 +1,145 @@
+package cloudflare
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Subscription is a subscription to a zone plan or an add-on product.
+type Subscription struct {
+	ID                 string                  `json:"id,omitempty"`
+	State              string                  `json:"state,omitempty"`
+	Price              float64                 `json:"price,omitempty"`
+	Currency           string                  `json:"currency,omitempty"`
+	Frequency          string                  `json:"frequency,omitempty"`
+	RatePlan           RatePlan                `json:"rate_plan"`
+	ComponentValues    []SubscriptionComponent `json:"component_values,omitempty"`
+	Zone               *ZoneID                 `json:"zone,omitempty"`
+	CurrentPeriodStart time.Time               `json:"current_period_start,omitempty"`
+	CurrentPeriodEnd   time.Time               `json:"current_period_end,omitempty"`
+}
+
+// RatePlan identifies the plan a subscription is for.
+type RatePlan struct {
+	ID                string   `json:"id"`
+	PublicName        string   `json:"public_name,omitempty"`
+	Currency          string   `json:"currency,omitempty"`
+	Scope             string   `json:"scope,omitempty"`
+	ExternallyManaged bool     `json:"externally_managed,omitempty"`
+	IsContract        bool     `json:"is_contract,omitempty"`
+	Sets              []string `json:"sets,omitempty"`
+}
+
+// SubscriptionComponent is a quantity of a component (such as page rules) of a
+// subscription.
+type SubscriptionComponent struct {
+	Name    string  `json:"name"`
+	Value   int     `json:"value"`
+	Default int     `json:"default,omitempty"`
+	Price   float64 `json:"price,omitempty"`
+}
+
+// AvailableRatePlan is a rate plan which a zone can subscribe to.
+type AvailableRatePlan struct {
+	ID         string                       `json:"id"`
+	Name       string                       `json:"name"`
+	Currency   string                       `json:"currency"`
+	Duration   int                          `json:"duration"`
+	Frequency  string                       `json:"frequency"`
+	Components []AvailableRatePlanComponent `json:"components"`
+}
+
+// AvailableRatePlanComponent is a component of an available rate plan, with
+// the quantity included by default and the price of each additional unit.
+type AvailableRatePlanComponent struct {
+	Name      string  `json:"name"`
+	Default   int     `json:"default"`
+	UnitPrice float64 `json:"unit_price"`
+}
+
+// AvailableZoneRatePlans returns the rate plans a zone can subscribe to.
+//
+// API reference: https://api.cloudflare.com/#zone-rate-plan-list-available-rate-plans
+func (api *API) AvailableZoneRatePlans(zoneID string) ([]AvailableRatePlan, error) {
+	var plans []AvailableRatePlan
+	if _, err := api.makeRequestResult("GET", "/zones/"+zoneID+"/available_rate_plans", nil, &plans); err != nil {
+		return nil, err
+	}
+	return plans, nil
+}
+
+// ZoneSubscription returns the plan subscription of a zone.
+//
+// API reference: https://api.cloudflare.com/#zone-subscription-zone-subscription-details
+func (api *API) ZoneSubscription(zoneID string) (Subscription, error) {
+	var sub Subscription
+	if _, err := api.makeRequestResult("GET", "/zones/"+zoneID+"/subscription", nil, &sub); err != nil {
+		return Subscription{}, err
+	}
+	return sub, nil
+}
+
+// UpdateZoneSubscription changes the plan subscription of a zone, e.g. to
+// upgrade or downgrade it. The rate plan ID must be set.
+//
+// API reference: https://api.cloudflare.com/#zone-subscription-update-zone-subscription
+func (api *API) UpdateZoneSubscription(zoneID string, sub Subscription) (Subscription, error) {
+	if sub.RatePlan.ID == "" {
+		return Subscription{}, errors.New("subscription rate plan ID must be set")
+	}
+	var result Subscription
+	if _, err := api.makeRequestResult("PUT", "/zones/"+zoneID+"/subscription", sub, &result); err != nil {
+		return Subscription{}, err
+	}
+	return result, nil
+}
+
+// AccountSubscriptions lists the subscriptions of an account, including add-on
+// products such as Argo.
+//
+// API reference: https://api.cloudflare.com/#account-subscriptions-list-subscriptions
+func (api *API) AccountSubscriptions(accountID string) ([]Subscription, error) {
+	var subs []Subscription
+	if _, err := api.makeRequestResult("GET", "/accounts/"+accountID+"/subscriptions", nil, &subs); err != nil {
+		return nil, err
+	}
+	return subs, nil
+}
+
+// CreateAccountSubscription subscribes an account to a product. The rate plan
+// ID must be set.
+//
+// API reference: https://api.cloudflare.com/#account-subscriptions-create-subscription
+func (api *API) CreateAccountSubscription(accountID string, sub Subscription) (Subscription, error) {
+	if sub.RatePlan.ID == "" {
+		return Subscription{}, errors.New("subscription rate plan ID must be set")
+	}
+	var result Subscription
+	if _, err := api.makeRequestResult("POST", "/accounts/"+accountID+"/subscriptions", sub, &result); err != nil {
+		return Subscription{}, err
+	}
+	return result, nil
+}
+
+// UpdateAccountSubscription changes an account subscription, e.g. its rate
+// plan or component quantities.
+//
+// API reference: https://api.cloudflare.com/#account-subscriptions-update-subscription
+func (api *API) UpdateAccountSubscription(accountID, subscriptionID string, sub Subscription) (Subscription, error) {
+	var result Subscription
+	if _, err := api.makeRequestResult("PUT", "/accounts/"+accountID+"/subscriptions/"+subscriptionID, sub, &result); err != nil {
+		return Subscription{}, err
+	}
+	return result, nil
+}
+
+// DeleteAccountSubscription cancels an account subscription.
+//
+// API reference: https://api.cloudflare.com/#account-subscriptions-delete-subscription
+func (api *API) DeleteAccountSubscription(accountID, subscriptionID string) error {
+	if _, err := api.makeRequestResult("DELETE", "/accounts/"+accountID+"/subscriptions/"+subscriptionID, nil, nil); err != nil {
+		return err
+	}
+	return nil
+}
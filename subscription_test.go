@@ -0,0 +1,156 @@
+package cloudflare
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAvailableZoneRatePlans(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/foo/available_rate_plans", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": [
+				{
+					"id": "free",
+					"name": "Free Plan",
+					"currency": "USD",
+					"duration": 1,
+					"frequency": "monthly",
+					"components": [{"name": "page_rules", "default": 3, "unit_price": 5}]
+				}
+			]
+		}`)
+	})
+
+	plans, err := client.AvailableZoneRatePlans("foo")
+	if assert.NoError(t, err) {
+		assert.Equal(t, []AvailableRatePlan{{
+			ID:         "free",
+			Name:       "Free Plan",
+			Currency:   "USD",
+			Duration:   1,
+			Frequency:  "monthly",
+			Components: []AvailableRatePlanComponent{{Name: "page_rules", Default: 3, UnitPrice: 5}},
+		}}, plans)
+	}
+}
+
+func TestZoneSubscription(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/foo/subscription", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		switch r.Method {
+		case "GET":
+			fmt.Fprint(w, `{
+				"success": true,
+				"errors": [],
+				"messages": [],
+				"result": {
+					"id": "sub1",
+					"state": "Paid",
+					"price": 20,
+					"currency": "USD",
+					"frequency": "monthly",
+					"rate_plan": {"id": "pro", "public_name": "Pro Plan", "scope": "zone"},
+					"zone": {"id": "foo"}
+				}
+			}`)
+		case "PUT":
+			var sub Subscription
+			if assert.NoError(t, json.NewDecoder(r.Body).Decode(&sub)) {
+				assert.Equal(t, "business", sub.RatePlan.ID)
+			}
+			fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"id": "sub1", "rate_plan": {"id": "business"}}}`)
+		default:
+			t.Errorf("unexpected method %s", r.Method)
+		}
+	})
+
+	sub, err := client.ZoneSubscription("foo")
+	if assert.NoError(t, err) {
+		assert.Equal(t, "sub1", sub.ID)
+		assert.Equal(t, "Paid", sub.State)
+		assert.Equal(t, float64(20), sub.Price)
+		assert.Equal(t, RatePlan{ID: "pro", PublicName: "Pro Plan", Scope: "zone"}, sub.RatePlan)
+		assert.Equal(t, &ZoneID{ID: "foo"}, sub.Zone)
+	}
+
+	sub, err = client.UpdateZoneSubscription("foo", Subscription{RatePlan: RatePlan{ID: "business"}})
+	if assert.NoError(t, err) {
+		assert.Equal(t, "business", sub.RatePlan.ID)
+	}
+
+	_, err = client.UpdateZoneSubscription("foo", Subscription{})
+	assert.Error(t, err)
+}
+
+func TestAccountSubscriptions(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/accounts/acc/subscriptions", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		switch r.Method {
+		case "GET":
+			fmt.Fprint(w, `{
+				"success": true,
+				"errors": [],
+				"messages": [],
+				"result": [{"id": "sub1", "rate_plan": {"id": "argo"}, "frequency": "monthly"}]
+			}`)
+		case "POST":
+			var sub Subscription
+			if assert.NoError(t, json.NewDecoder(r.Body).Decode(&sub)) {
+				assert.Equal(t, "stream", sub.RatePlan.ID)
+			}
+			fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"id": "sub2", "rate_plan": {"id": "stream"}}}`)
+		default:
+			t.Errorf("unexpected method %s", r.Method)
+		}
+	})
+	mux.HandleFunc("/accounts/acc/subscriptions/sub1", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		switch r.Method {
+		case "PUT":
+			var sub Subscription
+			if assert.NoError(t, json.NewDecoder(r.Body).Decode(&sub)) {
+				assert.Equal(t, "yearly", sub.Frequency)
+			}
+			fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"id": "sub1", "frequency": "yearly"}}`)
+		case "DELETE":
+			fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"subscription_id": "sub1"}}`)
+		default:
+			t.Errorf("unexpected method %s", r.Method)
+		}
+	})
+
+	subs, err := client.AccountSubscriptions("acc")
+	if assert.NoError(t, err) && assert.Len(t, subs, 1) {
+		assert.Equal(t, "argo", subs[0].RatePlan.ID)
+	}
+
+	sub, err := client.CreateAccountSubscription("acc", Subscription{RatePlan: RatePlan{ID: "stream"}})
+	if assert.NoError(t, err) {
+		assert.Equal(t, "sub2", sub.ID)
+	}
+
+	sub, err = client.UpdateAccountSubscription("acc", "sub1", Subscription{Frequency: "yearly"})
+	if assert.NoError(t, err) {
+		assert.Equal(t, "yearly", sub.Frequency)
+	}
+
+	assert.NoError(t, client.DeleteAccountSubscription("acc", "sub1"))
+}
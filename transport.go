@@ -0,0 +1,102 @@
+package cloudflare
+
+import (
+	"crypto/tls"
+	"net"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// Defaults for the transport used when no HTTP client is supplied. Go's
+// defaults only keep two idle connections per host, which forces new TLS
+// handshakes when many API calls are made concurrently.
+const (
+	defaultMaxIdleConnsPerHost = 100
+	defaultIdleConnTimeout     = 90 * time.Second
+	defaultTLSHandshakeTimeout = 10 * time.Second
+	defaultDialTimeout         = 30 * time.Second
+	defaultKeepAlive           = 30 * time.Second
+)
+
+// transportConfig holds the settings for the transport created by New when the
+// package user does not provide their own HTTP client.
+type transportConfig struct {
+	// set records whether any transport option was used, tuned whether one
+	// configuring the tuned transport was, and replaced whether RoundTripper
+	// was.
+	set      bool
+	tuned    bool
+	replaced bool
+
+	maxIdleConnsPerHost int
+	idleConnTimeout     time.Duration
+	tlsHandshakeTimeout time.Duration
+	forceHTTP2          bool
+	keepAlive           time.Duration
+	disableKeepAlives   bool
+	certificates        []tls.Certificate
+	tlsConfig           *tls.Config
+	proxy               *url.URL
+	dialer              *net.Dialer
+
+	// roundTripper replaces the tuned transport entirely; it conflicts with
+	// the other settings.
+	roundTripper http.RoundTripper
+	// wrappers are applied to the transport in order, whether it was created
+	// from this configuration or supplied with an HTTP client.
+	wrappers []func(http.RoundTripper) http.RoundTripper
+}
+
+func defaultTransportConfig() transportConfig {
+	return transportConfig{
+		maxIdleConnsPerHost: defaultMaxIdleConnsPerHost,
+		idleConnTimeout:     defaultIdleConnTimeout,
+		tlsHandshakeTimeout: defaultTLSHandshakeTimeout,
+		forceHTTP2:          true,
+	}
+}
+
+// newTransport creates an *http.Transport from the configuration, based on
+// http.DefaultTransport.
+func (c transportConfig) newTransport() *http.Transport {
+	t := http.DefaultTransport.(*http.Transport).Clone()
+	t.MaxIdleConns = c.maxIdleConnsPerHost
+	t.MaxIdleConnsPerHost = c.maxIdleConnsPerHost
+	t.IdleConnTimeout = c.idleConnTimeout
+	t.TLSHandshakeTimeout = c.tlsHandshakeTimeout
+	t.ForceAttemptHTTP2 = c.forceHTTP2
+	if c.tlsConfig != nil {
+		t.TLSClientConfig = c.tlsConfig.Clone()
+	}
+	if len(c.certificates) > 0 {
+		if t.TLSClientConfig == nil {
+			t.TLSClientConfig = &tls.Config{}
+		}
+		t.TLSClientConfig.Certificates = append(t.TLSClientConfig.Certificates, c.certificates...)
+	}
+	if c.proxy != nil {
+		t.Proxy = http.ProxyURL(c.proxy)
+	}
+	if c.dialer != nil || c.keepAlive != 0 {
+		d := &net.Dialer{Timeout: defaultDialTimeout, KeepAlive: defaultKeepAlive}
+		if c.dialer != nil {
+			copied := *c.dialer
+			d = &copied
+		}
+		if c.keepAlive != 0 {
+			d.KeepAlive = c.keepAlive
+		}
+		t.DialContext = d.DialContext
+	}
+	t.DisableKeepAlives = c.disableKeepAlives
+	return t
+}
+
+// wrap applies the configured wrappers to rt.
+func (c transportConfig) wrap(rt http.RoundTripper) http.RoundTripper {
+	for _, w := range c.wrappers {
+		rt = w(rt)
+	}
+	return rt
+}
@@ -0,0 +1,98 @@
+package cloudflare
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// newTLSTestServer starts an HTTP/2 capable TLS server answering every
+// request with an empty successful response, counting the connections made to
+// it.
+func newTLSTestServer(protos *[]string) (*httptest.Server, *int32) {
+	var conns int32
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if protos != nil {
+			*protos = append(*protos, r.Proto)
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {}}`)
+	}))
+	server.EnableHTTP2 = true
+	server.Config.ConnState = func(_ net.Conn, state http.ConnState) {
+		if state == http.StateNew {
+			atomic.AddInt32(&conns, 1)
+		}
+	}
+	server.StartTLS()
+	return server, &conns
+}
+
+// tlsTestClient returns a client trusting the certificate of server.
+func tlsTestClient(t testing.TB, server *httptest.Server, opts ...Option) *API {
+	pool := x509.NewCertPool()
+	pool.AddCert(server.Certificate())
+	opts = append(opts, BaseURL(server.URL), TLSConfig(&tls.Config{RootCAs: pool}))
+	api, err := New("deadbeef", "cloudflare@example.org", opts...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return api
+}
+
+func TestConnectionReuse(t *testing.T) {
+	var protos []string
+	server, conns := newTLSTestServer(&protos)
+	defer server.Close()
+
+	api := tlsTestClient(t, server, KeepAlive(-1))
+	for i := 0; i < 5; i++ {
+		_, err := api.IPs()
+		assert.NoError(t, err)
+	}
+	_, err := api.With(RequestHeader("X-Test", "1")).IPs()
+	assert.NoError(t, err)
+	assert.Equal(t, int32(1), atomic.LoadInt32(conns))
+	for _, proto := range protos {
+		assert.Equal(t, "HTTP/2.0", proto)
+	}
+
+	atomic.StoreInt32(conns, 0)
+	api = tlsTestClient(t, server, DisableKeepAlives(), ForceHTTP2(false))
+	for i := 0; i < 3; i++ {
+		_, err := api.IPs()
+		assert.NoError(t, err)
+	}
+	assert.Equal(t, int32(3), atomic.LoadInt32(conns))
+}
+
+// BenchmarkSequentialCalls compares bursts of sequential API calls over a
+// reused HTTP/2 connection with calls which each make a new connection.
+func BenchmarkSequentialCalls(b *testing.B) {
+	server, _ := newTLSTestServer(nil)
+	defer server.Close()
+
+	for _, bm := range []struct {
+		name string
+		opts []Option
+	}{
+		{"reused", nil},
+		{"new connections", []Option{DisableKeepAlives(), ForceHTTP2(false)}},
+	} {
+		b.Run(bm.name, func(b *testing.B) {
+			api := tlsTestClient(b, server, bm.opts...)
+			for i := 0; i < b.N; i++ {
+				if _, err := api.IPs(); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,63 @@
+package cloudflare
+
+// UserAgentRule is a User Agent Blocking rule, which applies an action to
+// requests with exactly the given User-Agent header.
+//
+// Valid modes are "block", "challenge" and "js_challenge".
+type UserAgentRule struct {
+	ID            string              `json:"id,omitempty"`
+	Description   string              `json:"description,omitempty"`
+	Mode          string              `json:"mode"`
+	Configuration UserAgentRuleConfig `json:"configuration"`
+	Paused        bool                `json:"paused"`
+}
+
+// UserAgentRuleConfig is the User-Agent matched by a User Agent Blocking rule.
+// Target is always "ua".
+type UserAgentRuleConfig struct {
+	Target string `json:"target"`
+	Value  string `json:"value"`
+}
+
+// ListUserAgentRules returns all User Agent Blocking rules for a zone.
+//
+// API reference: https://api.cloudflare.com/#user-agent-blocking-rules-list-useragent-rules
+func (api *API) ListUserAgentRules(zoneID string) ([]UserAgentRule, error) {
+	var rules []UserAgentRule
+	if err := api.paginateInto("/zones/"+zoneID+"/firewall/ua_rules", nil, &rules); err != nil {
+		return nil, err
+	}
+	return rules, nil
+}
+
+// CreateUserAgentRule creates a User Agent Blocking rule.
+//
+// API reference: https://api.cloudflare.com/#user-agent-blocking-rules-create-useragent-rule
+func (api *API) CreateUserAgentRule(zoneID string, rule UserAgentRule) (UserAgentRule, error) {
+	var result UserAgentRule
+	if _, err := api.makeRequestResult("POST", "/zones/"+zoneID+"/firewall/ua_rules", rule, &result); err != nil {
+		return UserAgentRule{}, err
+	}
+	return result, nil
+}
+
+// UpdateUserAgentRule replaces a User Agent Blocking rule.
+//
+// API reference: https://api.cloudflare.com/#user-agent-blocking-rules-update-useragent-rule
+func (api *API) UpdateUserAgentRule(zoneID, ruleID string, rule UserAgentRule) (UserAgentRule, error) {
+	var result UserAgentRule
+	if _, err := api.makeRequestResult("PUT", "/zones/"+zoneID+"/firewall/ua_rules/"+ruleID, rule, &result); err != nil {
+		return UserAgentRule{}, err
+	}
+	return result, nil
+}
+
+// DeleteUserAgentRule deletes a User Agent Blocking rule.
+//
+// API reference: https://api.cloudflare.com/#user-agent-blocking-rules-delete-useragent-rule
+func (api *API) DeleteUserAgentRule(zoneID, ruleID string) error {
+	if _, err := api.makeRequestResult("DELETE", "/zones/"+zoneID+"/firewall/ua_rules/"+ruleID, nil, nil); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,72 @@
+package cloudflare
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListUserAgentRules(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/foo/firewall/ua_rules", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": [
+				{
+					"id": "372e67954025e0ba6aaa6d586b9e0b59",
+					"description": "Block a misbehaving crawler",
+					"mode": "block",
+					"configuration": {"target": "ua", "value": "BadBot/1.0"},
+					"paused": false
+				}
+			]
+		}`)
+	})
+
+	rules, err := client.ListUserAgentRules("foo")
+	if assert.NoError(t, err) && assert.Len(t, rules, 1) {
+		assert.Equal(t, "block", rules[0].Mode)
+		assert.Equal(t, UserAgentRuleConfig{Target: "ua", Value: "BadBot/1.0"}, rules[0].Configuration)
+	}
+}
+
+func TestUpdateUserAgentRule(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/foo/firewall/ua_rules/372e67954025e0ba6aaa6d586b9e0b59", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PUT", r.Method, "Expected method 'PUT', got %s", r.Method)
+		var rule UserAgentRule
+		if assert.NoError(t, json.NewDecoder(r.Body).Decode(&rule)) {
+			assert.Equal(t, "challenge", rule.Mode)
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {
+				"id": "372e67954025e0ba6aaa6d586b9e0b59",
+				"mode": "challenge",
+				"configuration": {"target": "ua", "value": "BadBot/1.0"}
+			}
+		}`)
+	})
+
+	rule, err := client.UpdateUserAgentRule("foo", "372e67954025e0ba6aaa6d586b9e0b59", UserAgentRule{
+		Mode:          "challenge",
+		Configuration: UserAgentRuleConfig{Target: "ua", Value: "BadBot/1.0"},
+	})
+	if assert.NoError(t, err) {
+		assert.Equal(t, "challenge", rule.Mode)
+	}
+}
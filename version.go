@@ -0,0 +1,17 @@
+package cloudflare
+
+// Version is the version of this library, sent to the API in the User-Agent
+// header.
+const Version = "0.1.0"
+
+// defaultUserAgent identifies this library to the API.
+const defaultUserAgent = "cloudflare-go/" + Version
+
+// userAgentHeader returns the User-Agent header sent with requests: the
+// library's, followed by any product tokens added with UserAgent.
+func (api *API) userAgentHeader() string {
+	if api.userAgent == "" {
+		return defaultUserAgent
+	}
+	return defaultUserAgent + " " + api.userAgent
+}
@@ -1,11 +1,5 @@
 package cloudflare
 
-import (
-	"encoding/json"
-
-	"github.com/pkg/errors"
-)
-
 // VirtualDNS represents a Virtual DNS configuration.
 type VirtualDNS struct {
 	ID                   string   `json:"id"`
@@ -19,15 +13,20 @@ type VirtualDNS struct {
 }
 
 // VirtualDNSResponse represents a Virtual DNS response.
+//
+// Deprecated: see Response.
 type VirtualDNSResponse struct {
 	Response
 	Result *VirtualDNS `json:"result"`
 }
 
 // VirtualDNSListResponse represents an array of Virtual DNS responses.
+//
+// Deprecated: see Response.
 type VirtualDNSListResponse struct {
 	Response
-	Result []*VirtualDNS `json:"result"`
+	Result     []*VirtualDNS `json:"result"`
+	ResultInfo ResultInfo    `json:"result_info"`
 }
 
 // CreateVirtualDNS creates a new Virtual DNS cluster.
@@ -35,18 +34,12 @@ type VirtualDNSListResponse struct {
 //   https://api.cloudflare.com/#virtual-dns-users--create-a-virtual-dns-cluster
 //   POST /user/virtual_dns
 func (api *API) CreateVirtualDNS(v *VirtualDNS) (*VirtualDNS, error) {
-	res, err := api.makeRequest("POST", "/user/virtual_dns", v)
-	if err != nil {
-		return nil, errors.Wrap(err, errMakeRequestError)
-	}
-
-	response := &VirtualDNSResponse{}
-	err = json.Unmarshal(res, &response)
-	if err != nil {
-		return nil, errors.Wrap(err, errUnmarshalError)
+	var result *VirtualDNS
+	if _, err := api.makeRequestResult("POST", "/user/virtual_dns", v, &result); err != nil {
+		return nil, err
 	}
 
-	return response.Result, nil
+	return result, nil
 }
 
 // VirtualDNS fetches a single virtual DNS cluster.
@@ -55,18 +48,12 @@ func (api *API) CreateVirtualDNS(v *VirtualDNS) (*VirtualDNS, error) {
 //   GET /user/virtual_dns/:identifier
 func (api *API) VirtualDNS(virtualDNSID string) (*VirtualDNS, error) {
 	uri := "/user/virtual_dns/" + virtualDNSID
-	res, err := api.makeRequest("GET", uri, nil)
-	if err != nil {
-		return nil, errors.Wrap(err, errMakeRequestError)
-	}
-
-	response := &VirtualDNSResponse{}
-	err = json.Unmarshal(res, &response)
-	if err != nil {
-		return nil, errors.Wrap(err, errUnmarshalError)
+	var result *VirtualDNS
+	if _, err := api.makeRequestResult("GET", uri, nil, &result); err != nil {
+		return nil, err
 	}
 
-	return response.Result, nil
+	return result, nil
 }
 
 // ListVirtualDNS lists the virtual DNS clusters associated with an account.
@@ -74,18 +61,12 @@ func (api *API) VirtualDNS(virtualDNSID string) (*VirtualDNS, error) {
 //   https://api.cloudflare.com/#virtual-dns-users--get-virtual-dns-clusters
 //   GET /user/virtual_dns
 func (api *API) ListVirtualDNS() ([]*VirtualDNS, error) {
-	res, err := api.makeRequest("GET", "/user/virtual_dns", nil)
-	if err != nil {
-		return nil, errors.Wrap(err, errMakeRequestError)
+	var result []*VirtualDNS
+	if _, err := api.makeRequestResult("GET", "/user/virtual_dns", nil, &result); err != nil {
+		return nil, err
 	}
 
-	response := &VirtualDNSListResponse{}
-	err = json.Unmarshal(res, &response)
-	if err != nil {
-		return nil, errors.Wrap(err, errUnmarshalError)
-	}
-
-	return response.Result, nil
+	return result, nil
 }
 
 // UpdateVirtualDNS updates a Virtual DNS cluster.
@@ -94,15 +75,8 @@ func (api *API) ListVirtualDNS() ([]*VirtualDNS, error) {
 //   PATCH /user/virtual_dns/:identifier
 func (api *API) UpdateVirtualDNS(virtualDNSID string, vv VirtualDNS) error {
 	uri := "/user/virtual_dns/" + virtualDNSID
-	res, err := api.makeRequest("PUT", uri, vv)
-	if err != nil {
-		return errors.Wrap(err, errMakeRequestError)
-	}
-
-	response := &VirtualDNSResponse{}
-	err = json.Unmarshal(res, &response)
-	if err != nil {
-		return errors.Wrap(err, errUnmarshalError)
+	if _, err := api.makeRequestResult("PUT", uri, vv, nil); err != nil {
+		return err
 	}
 
 	return nil
@@ -115,15 +89,8 @@ func (api *API) UpdateVirtualDNS(virtualDNSID string, vv VirtualDNS) error {
 //   DELETE /user/virtual_dns/:identifier
 func (api *API) DeleteVirtualDNS(virtualDNSID string) error {
 	uri := "/user/virtual_dns/" + virtualDNSID
-	res, err := api.makeRequest("DELETE", uri, nil)
-	if err != nil {
-		return errors.Wrap(err, errMakeRequestError)
-	}
-
-	response := &VirtualDNSResponse{}
-	err = json.Unmarshal(res, &response)
-	if err != nil {
-		return errors.Wrap(err, errUnmarshalError)
+	if _, err := api.makeRequestResult("DELETE", uri, nil, nil); err != nil {
+		return err
 	}
 
 	return nil
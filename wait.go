@@ -0,0 +1,74 @@
+package cloudflare
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// The delays between the polls made by WaitFor.
+var (
+	waitInitialDelay = time.Second
+	waitMaxDelay     = 30 * time.Second
+)
+
+// WaitFor polls until cond reports true, for changes which the API applies
+// asynchronously. cond is called immediately and then with exponential
+// backoff, from one second up to 30 seconds between calls. An error returned
+// by cond stops polling and is returned; if ctx is done first, an error
+// wrapping its error is returned.
+func WaitFor(ctx context.Context, cond func() (bool, error)) error {
+	delay := waitInitialDelay
+	for {
+		done, err := cond()
+		if err != nil {
+			return err
+		}
+		if done {
+			return nil
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return errors.Wrap(ctx.Err(), "condition not met")
+		}
+		if delay *= 2; delay > waitMaxDelay {
+			delay = waitMaxDelay
+		}
+	}
+}
+
+// WaitForPageRuleStatus waits until a page rule has the given status, or ctx
+// is done. See WaitFor.
+func (api *API) WaitForPageRuleStatus(ctx context.Context, zoneID, ruleID string, status PageRuleStatus) (PageRule, error) {
+	var rule PageRule
+	err := WaitFor(ctx, func() (bool, error) {
+		var err error
+		rule, err = api.PageRule(zoneID, ruleID)
+		return err == nil && rule.Status == status, err
+	})
+	if err != nil {
+		return PageRule{}, errors.Wrapf(err, "page rule %s did not become %s", ruleID, status)
+	}
+	return rule, nil
+}
+
+// WaitForZoneActive waits until a zone is active, when its nameservers have
+// been changed to Cloudflare's and verified, or ctx is done. See WaitFor and
+// ZoneActivationCheck, which asks for the verification to be rerun.
+func (api *API) WaitForZoneActive(ctx context.Context, zoneID string) (Zone, error) {
+	var zone Zone
+	err := WaitFor(ctx, func() (bool, error) {
+		var err error
+		zone, err = api.ZoneDetails(zoneID)
+		return err == nil && zone.Status == "active", err
+	})
+	if err != nil {
+		return Zone{}, errors.Wrapf(err, "zone %s did not become active", zoneID)
+	}
+	return zone, nil
+}
@@ -0,0 +1,52 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWaitFor(t *testing.T) {
+	defer func(initial, max time.Duration) {
+		waitInitialDelay, waitMaxDelay = initial, max
+	}(waitInitialDelay, waitMaxDelay)
+	waitInitialDelay, waitMaxDelay = time.Millisecond, 4*time.Millisecond
+
+	setup()
+	defer teardown()
+
+	var polls int
+	mux.HandleFunc("/zones/z1/pagerules/r1", func(w http.ResponseWriter, r *http.Request) {
+		polls++
+		status := "paused"
+		if polls >= 3 {
+			status = "active"
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{"success": true, "errors": [], "messages": [], "result": {"id": "r1", "status": %q}}`, status)
+	})
+	mux.HandleFunc("/zones/z1", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"id": "z1", "status": "pending"}}`)
+	})
+
+	rule, err := client.WaitForPageRuleStatus(context.Background(), "z1", "r1", PageRuleStatusActive)
+	if assert.NoError(t, err) {
+		assert.Equal(t, PageRuleStatusActive, rule.Status)
+	}
+	assert.Equal(t, 3, polls)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	_, err = client.WaitForZoneActive(ctx, "z1")
+	assert.Equal(t, context.DeadlineExceeded, errors.Cause(err))
+
+	_, err = client.WaitForZoneActive(context.Background(), "missing")
+	_, ok := AsAPIError(err)
+	assert.True(t, ok)
+}
@@ -0,0 +1,162 @@
+package cloudflare
+
+import (
+	"bytes"
+	"encoding/json"
+	"mime/multipart"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// WorkerScriptSettings are the settings of a Workers script which can be
+// changed without uploading the script again. Nil and empty fields are left
+// unchanged by UpdateWorkerScriptSettings.
+type WorkerScriptSettings struct {
+	UsageModel         string                `json:"usage_model,omitempty"`
+	CompatibilityDate  string                `json:"compatibility_date,omitempty"`
+	CompatibilityFlags []string              `json:"compatibility_flags,omitempty"`
+	Logpush            *bool                 `json:"logpush,omitempty"`
+	TailConsumers      *[]WorkerTailConsumer `json:"tail_consumers,omitempty"`
+}
+
+// WorkerTailConsumer is a Worker which receives the trace events of another.
+type WorkerTailConsumer struct {
+	Service     string `json:"service"`
+	Environment string `json:"environment,omitempty"`
+	Namespace   string `json:"namespace,omitempty"`
+}
+
+// WorkerScriptSettings returns the settings of a Workers script.
+//
+// API reference: https://developers.cloudflare.com/api/operations/worker-script-get-settings
+func (api *API) WorkerScriptSettings(accountID, scriptName string) (WorkerScriptSettings, error) {
+	var settings WorkerScriptSettings
+	if _, err := api.makeRequestResult("GET", "/accounts/"+accountID+"/workers/scripts/"+scriptName+"/settings", nil, &settings); err != nil {
+		return WorkerScriptSettings{}, err
+	}
+	return settings, nil
+}
+
+// UpdateWorkerScriptSettings changes the settings of a Workers script without
+// redeploying its code, returning the updated settings.
+//
+// API reference: https://developers.cloudflare.com/api/operations/worker-script-patch-settings
+func (api *API) UpdateWorkerScriptSettings(accountID, scriptName string, settings WorkerScriptSettings) (WorkerScriptSettings, error) {
+	// The endpoint takes the settings as a JSON part of a multipart form.
+	var buf bytes.Buffer
+	mw := multipart.NewWriter(&buf)
+	part, err := mw.CreateFormField("settings")
+	if err != nil {
+		return WorkerScriptSettings{}, errors.Wrap(err, "could not create multipart body")
+	}
+	if err := json.NewEncoder(part).Encode(settings); err != nil {
+		return WorkerScriptSettings{}, errors.Wrap(err, "error marshalling params to JSON")
+	}
+	if err := mw.Close(); err != nil {
+		return WorkerScriptSettings{}, errors.Wrap(err, "could not create multipart body")
+	}
+
+	var result WorkerScriptSettings
+	if err := api.makeRequestBody("PATCH", "/accounts/"+accountID+"/workers/scripts/"+scriptName+"/settings", mw.FormDataContentType(), &buf, &result); err != nil {
+		return WorkerScriptSettings{}, err
+	}
+	return result, nil
+}
+
+// WorkerVersion is an uploaded version of a Workers script.
+type WorkerVersion struct {
+	ID       string                `json:"id"`
+	Number   int                   `json:"number"`
+	Metadata WorkerVersionMetadata `json:"metadata"`
+}
+
+// WorkerVersionMetadata describes the origin of a Workers script version.
+type WorkerVersionMetadata struct {
+	AuthorEmail string    `json:"author_email,omitempty"`
+	Source      string    `json:"source,omitempty"`
+	CreatedOn   time.Time `json:"created_on,omitempty"`
+	ModifiedOn  time.Time `json:"modified_on,omitempty"`
+}
+
+// WorkerDeployment is a deployment of one or more versions of a Workers
+// script, with traffic split between them by percentage.
+type WorkerDeployment struct {
+	ID          string                    `json:"id,omitempty"`
+	Strategy    string                    `json:"strategy"`
+	Versions    []WorkerDeploymentVersion `json:"versions"`
+	Annotations map[string]string         `json:"annotations,omitempty"`
+	AuthorEmail string                    `json:"author_email,omitempty"`
+	Source      string                    `json:"source,omitempty"`
+	CreatedOn   time.Time                 `json:"created_on,omitempty"`
+}
+
+// WorkerDeploymentVersion is the share of traffic sent to a version by a
+// deployment.
+type WorkerDeploymentVersion struct {
+	VersionID  string  `json:"version_id"`
+	Percentage float64 `json:"percentage"`
+}
+
+// WorkerVersions lists the versions of a Workers script, newest first.
+//
+// API reference: https://developers.cloudflare.com/api/operations/worker-versions-list-versions
+func (api *API) WorkerVersions(accountID, scriptName string) ([]WorkerVersion, error) {
+	var result struct {
+		Items []WorkerVersion `json:"items"`
+	}
+	if _, err := api.makeRequestResult("GET", "/accounts/"+accountID+"/workers/scripts/"+scriptName+"/versions", nil, &result); err != nil {
+		return nil, err
+	}
+	return result.Items, nil
+}
+
+// CreateWorkerDeployment deploys versions of a Workers script, e.g. to send a
+// small percentage of traffic to a new version. The percentages of the
+// versions must add up to 100. If the strategy is not set, "percentage" is
+// used.
+//
+// API reference: https://developers.cloudflare.com/api/operations/worker-deployments-create-deployment
+func (api *API) CreateWorkerDeployment(accountID, scriptName string, deployment WorkerDeployment) (WorkerDeployment, error) {
+	var total float64
+	for _, v := range deployment.Versions {
+		total += v.Percentage
+	}
+	if len(deployment.Versions) == 0 || total != 100 {
+		return WorkerDeployment{}, errors.Errorf("deployment version percentages must add up to 100, not %g", total)
+	}
+	if deployment.Strategy == "" {
+		deployment.Strategy = "percentage"
+	}
+
+	var result WorkerDeployment
+	if _, err := api.makeRequestResult("POST", "/accounts/"+accountID+"/workers/scripts/"+scriptName+"/deployments", deployment, &result); err != nil {
+		return WorkerDeployment{}, err
+	}
+	return result, nil
+}
+
+// WorkerDeployments lists the deployments of a Workers script. The first
+// deployment is the one currently serving traffic.
+//
+// API reference: https://developers.cloudflare.com/api/operations/worker-deployments-list-deployments
+func (api *API) WorkerDeployments(accountID, scriptName string) ([]WorkerDeployment, error) {
+	var result struct {
+		Deployments []WorkerDeployment `json:"deployments"`
+	}
+	if _, err := api.makeRequestResult("GET", "/accounts/"+accountID+"/workers/scripts/"+scriptName+"/deployments", nil, &result); err != nil {
+		return nil, err
+	}
+	return result.Deployments, nil
+}
+
+// WorkerDeployment returns a deployment of a Workers script.
+//
+// API reference: https://developers.cloudflare.com/api/operations/worker-deployments-get-deployment
+func (api *API) WorkerDeployment(accountID, scriptName, deploymentID string) (WorkerDeployment, error) {
+	var deployment WorkerDeployment
+	if _, err := api.makeRequestResult("GET", "/accounts/"+accountID+"/workers/scripts/"+scriptName+"/deployments/"+deploymentID, nil, &deployment); err != nil {
+		return WorkerDeployment{}, err
+	}
+	return deployment, nil
+}
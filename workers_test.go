@@ -0,0 +1,157 @@
+package cloudflare
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWorkerScriptSettings(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/accounts/acc/workers/scripts/app/settings", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		switch r.Method {
+		case "GET":
+			fmt.Fprint(w, `{
+				"success": true,
+				"errors": [],
+				"messages": [],
+				"result": {
+					"usage_model": "standard",
+					"compatibility_date": "2024-01-01",
+					"compatibility_flags": ["nodejs_compat"],
+					"logpush": true,
+					"tail_consumers": [{"service": "tail"}]
+				}
+			}`)
+		case "PATCH":
+			var settings map[string]interface{}
+			if assert.NoError(t, json.Unmarshal([]byte(r.FormValue("settings")), &settings)) {
+				assert.Equal(t, map[string]interface{}{"logpush": false}, settings)
+			}
+			fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"usage_model": "standard", "logpush": false}}`)
+		default:
+			t.Errorf("unexpected method %s", r.Method)
+		}
+	})
+
+	settings, err := client.WorkerScriptSettings("acc", "app")
+	if assert.NoError(t, err) {
+		logpush := true
+		assert.Equal(t, WorkerScriptSettings{
+			UsageModel:         "standard",
+			CompatibilityDate:  "2024-01-01",
+			CompatibilityFlags: []string{"nodejs_compat"},
+			Logpush:            &logpush,
+			TailConsumers:      &[]WorkerTailConsumer{{Service: "tail"}},
+		}, settings)
+	}
+
+	logpush := false
+	settings, err = client.UpdateWorkerScriptSettings("acc", "app", WorkerScriptSettings{Logpush: &logpush})
+	if assert.NoError(t, err) && assert.NotNil(t, settings.Logpush) {
+		assert.False(t, *settings.Logpush)
+	}
+}
+
+func TestWorkerVersions(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/accounts/acc/workers/scripts/app/versions", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {
+				"items": [
+					{"id": "v2", "number": 2, "metadata": {"author_email": "dev@example.com", "source": "wrangler"}},
+					{"id": "v1", "number": 1, "metadata": {"source": "api"}}
+				]
+			}
+		}`)
+	})
+
+	versions, err := client.WorkerVersions("acc", "app")
+	if assert.NoError(t, err) && assert.Len(t, versions, 2) {
+		assert.Equal(t, "v2", versions[0].ID)
+		assert.Equal(t, 2, versions[0].Number)
+		assert.Equal(t, "dev@example.com", versions[0].Metadata.AuthorEmail)
+	}
+}
+
+func TestWorkerDeployments(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/accounts/acc/workers/scripts/app/deployments", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		switch r.Method {
+		case "GET":
+			fmt.Fprint(w, `{
+				"success": true,
+				"errors": [],
+				"messages": [],
+				"result": {
+					"deployments": [
+						{"id": "d1", "strategy": "percentage", "versions": [{"version_id": "v1", "percentage": 100}]}
+					]
+				}
+			}`)
+		case "POST":
+			var d WorkerDeployment
+			if assert.NoError(t, json.NewDecoder(r.Body).Decode(&d)) {
+				assert.Equal(t, "percentage", d.Strategy)
+				assert.Equal(t, []WorkerDeploymentVersion{
+					{VersionID: "v2", Percentage: 10},
+					{VersionID: "v1", Percentage: 90},
+				}, d.Versions)
+				assert.Equal(t, "canary", d.Annotations["workers/message"])
+			}
+			d.ID = "d2"
+			b, _ := json.Marshal(d)
+			fmt.Fprintf(w, `{"success": true, "errors": [], "messages": [], "result": %s}`, b)
+		default:
+			t.Errorf("unexpected method %s", r.Method)
+		}
+	})
+	mux.HandleFunc("/accounts/acc/workers/scripts/app/deployments/d1", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"id": "d1", "strategy": "percentage"}}`)
+	})
+
+	deployments, err := client.WorkerDeployments("acc", "app")
+	if assert.NoError(t, err) && assert.Len(t, deployments, 1) {
+		assert.Equal(t, "d1", deployments[0].ID)
+		assert.Equal(t, []WorkerDeploymentVersion{{VersionID: "v1", Percentage: 100}}, deployments[0].Versions)
+	}
+
+	d, err := client.CreateWorkerDeployment("acc", "app", WorkerDeployment{
+		Versions: []WorkerDeploymentVersion{
+			{VersionID: "v2", Percentage: 10},
+			{VersionID: "v1", Percentage: 90},
+		},
+		Annotations: map[string]string{"workers/message": "canary"},
+	})
+	if assert.NoError(t, err) {
+		assert.Equal(t, "d2", d.ID)
+	}
+
+	_, err = client.CreateWorkerDeployment("acc", "app", WorkerDeployment{
+		Versions: []WorkerDeploymentVersion{{VersionID: "v2", Percentage: 10}},
+	})
+	assert.Error(t, err)
+
+	d, err = client.WorkerDeployment("acc", "app", "d1")
+	if assert.NoError(t, err) {
+		assert.Equal(t, "d1", d.ID)
+	}
+}
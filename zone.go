@@ -35,6 +35,7 @@ type Zone struct {
 	Betas       []string `json:"betas"`
 	DeactReason string   `json:"deactivation_reason"`
 	Meta        ZoneMeta `json:"meta"`
+	Account     Account  `json:"account"`
 }
 
 // ZoneMeta metadata about a zone.
@@ -64,24 +65,33 @@ type ZoneID struct {
 }
 
 // ZoneResponse represents the response from the Zone endpoint containing a single zone.
+//
+// Deprecated: see Response.
 type ZoneResponse struct {
 	Response
 	Result Zone `json:"result"`
 }
 
 // ZonesResponse represents the response from the Zone endpoint containing an array of zones.
+//
+// Deprecated: see Response.
 type ZonesResponse struct {
 	Response
-	Result []Zone `json:"result"`
+	Result     []Zone     `json:"result"`
+	ResultInfo ResultInfo `json:"result_info"`
 }
 
 // ZoneIDResponse represents the response from the Zone endpoint, containing only a zone ID.
+//
+// Deprecated: see Response.
 type ZoneIDResponse struct {
 	Response
 	Result ZoneID `json:"result"`
 }
 
 // AvailableZonePlansResponse represents the response from the Available Plans endpoint.
+//
+// Deprecated: see Response.
 type AvailableZonePlansResponse struct {
 	Response
 	Result []ZonePlan `json:"result"`
@@ -89,12 +99,16 @@ type AvailableZonePlansResponse struct {
 }
 
 // ZonePlanResponse represents the response from the Plan Details endpoint.
+//
+// Deprecated: see Response.
 type ZonePlanResponse struct {
 	Response
 	Result ZonePlan `json:"result"`
 }
 
-// ZoneSetting contains settings for a zone.
+// ZoneSetting contains settings for a zone. Value may be set to one of the
+// typed values, such as a CacheLevel, SecurityLevel or SSLMode, but such values
+// are read back from the API as plain strings.
 type ZoneSetting struct {
 	ID            string      `json:"id"`
 	Editable      bool        `json:"editable"`
@@ -103,7 +117,47 @@ type ZoneSetting struct {
 	TimeRemaining int         `json:"time_remaining"`
 }
 
+// CacheLevel is the value of the cache_level zone setting and page rule
+// action.
+type CacheLevel string
+
+// Cache levels.
+const (
+	CacheLevelBypass          CacheLevel = "bypass"
+	CacheLevelBasic           CacheLevel = "basic"
+	CacheLevelSimplified      CacheLevel = "simplified"
+	CacheLevelAggressive      CacheLevel = "aggressive"
+	CacheLevelCacheEverything CacheLevel = "cache_everything"
+)
+
+// SecurityLevel is the value of the security_level zone setting and page rule
+// action.
+type SecurityLevel string
+
+// Security levels.
+const (
+	SecurityLevelOff            SecurityLevel = "off"
+	SecurityLevelEssentiallyOff SecurityLevel = "essentially_off"
+	SecurityLevelLow            SecurityLevel = "low"
+	SecurityLevelMedium         SecurityLevel = "medium"
+	SecurityLevelHigh           SecurityLevel = "high"
+	SecurityLevelUnderAttack    SecurityLevel = "under_attack"
+)
+
+// SSLMode is the value of the ssl zone setting and page rule action.
+type SSLMode string
+
+// SSL modes.
+const (
+	SSLModeOff      SSLMode = "off"
+	SSLModeFlexible SSLMode = "flexible"
+	SSLModeFull     SSLMode = "full"
+	SSLModeStrict   SSLMode = "strict"
+)
+
 // ZoneSettingResponse represents the response from the Zone Setting endpoint.
+//
+// Deprecated: see Response.
 type ZoneSettingResponse struct {
 	Response
 	Result []ZoneSetting `json:"result"`
@@ -120,24 +174,12 @@ type ZoneAnalyticsData struct {
 	Timeseries []ZoneAnalytics `json:"timeseries"`
 }
 
-// zoneAnalyticsDataResponse represents the response from the Zone Analytics Dashboard endpoint.
-type zoneAnalyticsDataResponse struct {
-	Response
-	Result ZoneAnalyticsData `json:"result"`
-}
-
 // ZoneAnalyticsColocation contains analytics data by datacenter.
 type ZoneAnalyticsColocation struct {
 	ColocationID string          `json:"colo_id"`
 	Timeseries   []ZoneAnalytics `json:"timeseries"`
 }
 
-// zoneAnalyticsColocationResponse represents the response from the Zone Analytics By Co-location endpoint.
-type zoneAnalyticsColocationResponse struct {
-	Response
-	Result []ZoneAnalyticsColocation `json:"result"`
-}
-
 // ZoneAnalytics contains analytics data for a zone.
 type ZoneAnalytics struct {
 	Since    time.Time `json:"since"`
@@ -191,9 +233,13 @@ type ZoneAnalyticsOptions struct {
 type newZone struct {
 	Name      string `json:"name"`
 	JumpStart bool   `json:"jump_start"`
+	Type      string `json:"type,omitempty"`
 	// We use a pointer to get a nil type when the field is empty.
 	// This allows us to completely omit this with json.Marshal().
 	Organization *Organization `json:"organization,omitempty"`
+	Account      *struct {
+		ID string `json:"id"`
+	} `json:"account,omitempty"`
 }
 
 // CreateZone creates a zone on an account.
@@ -206,34 +252,59 @@ func (api *API) CreateZone(name string, jumpstart bool, org Organization) (Zone,
 	if org.ID != "" {
 		newzone.Organization = &org
 	}
+	return api.createZone(newzone)
+}
 
-	res, err := api.makeRequest("POST", "/zones", newzone)
-	if err != nil {
-		return Zone{}, errors.Wrap(err, errMakeRequestError)
+// ZoneCreateParams describes a zone to create with CreateZoneWithParams.
+type ZoneCreateParams struct {
+	Name string
+	// JumpStart scans for common DNS records and imports them.
+	JumpStart bool
+	// AccountID is the account to create the zone in. If empty, the client's
+	// AccountID is used (see UsingAccount), and if that is empty too, the
+	// API's default for the credentials.
+	AccountID string
+	// Type is "full" (the default), for zones using Cloudflare's name
+	// servers, or "partial", for zones set up with CNAME records.
+	Type string
+}
+
+// CreateZoneWithParams creates a zone, in a given account if required.
+//
+// API reference: https://api.cloudflare.com/#zone-create-a-zone
+func (api *API) CreateZoneWithParams(params ZoneCreateParams) (Zone, error) {
+	newzone := newZone{Name: params.Name, JumpStart: params.JumpStart, Type: params.Type}
+	accountID := params.AccountID
+	if accountID == "" {
+		accountID = api.AccountID
+	}
+	if accountID != "" {
+		newzone.Account = &struct {
+			ID string `json:"id"`
+		}{accountID}
 	}
+	return api.createZone(newzone)
+}
 
-	var r ZoneResponse
-	err = json.Unmarshal(res, &r)
-	if err != nil {
-		return Zone{}, errors.Wrap(err, errUnmarshalError)
+// createZone creates a zone and remembers its ID.
+func (api *API) createZone(newzone newZone) (Zone, error) {
+	var result Zone
+	if _, err := api.makeRequestResult("POST", "/zones", newzone, &result); err != nil {
+		return Zone{}, err
 	}
-	return r.Result, nil
+	api.cacheZoneID(result.Name, result.ID)
+	return result, nil
 }
 
 // ZoneActivationCheck initiates another zone activation check for newly-created zones.
 //
 // API reference: https://api.cloudflare.com/#zone-initiate-another-zone-activation-check
 func (api *API) ZoneActivationCheck(zoneID string) (Response, error) {
-	res, err := api.makeRequest("PUT", "/zones/"+zoneID+"/activation_check", nil)
+	r, err := api.makeRequestResult("PUT", "/zones/"+zoneID+"/activation_check", nil, nil)
 	if err != nil {
-		return Response{}, errors.Wrap(err, errMakeRequestError)
+		return Response{}, err
 	}
-	var r Response
-	err = json.Unmarshal(res, &r)
-	if err != nil {
-		return Response{}, errors.Wrap(err, errUnmarshalError)
-	}
-	return r, nil
+	return r.Response, nil
 }
 
 // ListZones lists zones on an account. Optionally takes a list of zone names
@@ -241,69 +312,99 @@ func (api *API) ZoneActivationCheck(zoneID string) (Response, error) {
 //
 // API reference: https://api.cloudflare.com/#zone-list-zones
 func (api *API) ListZones(z ...string) ([]Zone, error) {
-	v := url.Values{}
-	var res []byte
-	var r ZonesResponse
+	if len(z) == 0 {
+		return api.ListZonesWithParams(ZoneListParams{})
+	}
 	var zones []Zone
-	var err error
-	if len(z) > 0 {
-		for _, zone := range z {
-			v.Set("name", zone)
-			res, err = api.makeRequest("GET", "/zones?"+v.Encode(), nil)
-			if err != nil {
-				return []Zone{}, errors.Wrap(err, errMakeRequestError)
-			}
-			err = json.Unmarshal(res, &r)
-			if err != nil {
-				return []Zone{}, errors.Wrap(err, errUnmarshalError)
-			}
-			if !r.Success {
-				// TODO: Provide an actual error message instead of always returning nil
-				return []Zone{}, err
-			}
-			for zi := range r.Result {
-				zones = append(zones, r.Result[zi])
-			}
-		}
-	} else {
-		// TODO: Paginate here. We only grab the first page of results.
-		// Could do this concurrently after the first request by creating a
-		// sync.WaitGroup or just a channel + workers.
-		res, err = api.makeRequest("GET", "/zones", nil)
-		if err != nil {
-			return []Zone{}, errors.Wrap(err, errMakeRequestError)
-		}
-		err = json.Unmarshal(res, &r)
+	for _, name := range z {
+		result, err := api.ListZonesWithParams(ZoneListParams{Name: name})
 		if err != nil {
-			return []Zone{}, errors.Wrap(err, errUnmarshalError)
+			return []Zone{}, err
 		}
-		zones = r.Result
+		zones = append(zones, result...)
 	}
+	return zones, nil
+}
+
+// ZoneListParams filters the zones returned by ListZonesWithParams. Empty
+// fields are not filtered on.
+type ZoneListParams struct {
+	// Name is a domain name, such as "example.com".
+	Name string
+	// Status is a zone status, such as "active" or "pending".
+	Status    string
+	AccountID string
+	// Match is whether zones must match "all" the filters or "any" of them.
+	Match string
+	// PerPage is the number of zones requested at a time.
+	PerPage int
+}
 
+func (p ZoneListParams) encode() url.Values {
+	v := url.Values{}
+	if p.Name != "" {
+		v.Set("name", p.Name)
+	}
+	if p.Status != "" {
+		v.Set("status", p.Status)
+	}
+	if p.AccountID != "" {
+		v.Set("account.id", p.AccountID)
+	}
+	if p.Match != "" {
+		v.Set("match", p.Match)
+	}
+	PaginationOptions{PerPage: p.PerPage}.encode(v)
+	return v
+}
+
+// ListZonesWithParams lists the zones matching params, requesting every page
+// of results.
+//
+// API reference: https://api.cloudflare.com/#zone-list-zones
+func (api *API) ListZonesWithParams(params ZoneListParams) ([]Zone, error) {
+	var zones []Zone
+	if err := api.paginateInto("/zones", params.encode(), &zones); err != nil {
+		return []Zone{}, err
+	}
 	return zones, nil
 }
 
+// ListZonesPage returns a page of the zones on an account, with the
+// pagination metadata of the response.
+//
+// API reference: https://api.cloudflare.com/#zone-list-zones
+func (api *API) ListZonesPage(opts PaginationOptions) ([]Zone, ResultInfo, error) {
+	v := url.Values{}
+	opts.encode(v)
+	uri := "/zones"
+	if len(v) > 0 {
+		uri += "?" + v.Encode()
+	}
+	var zones []Zone
+	r, err := api.makeRequestResult("GET", uri, nil, &zones)
+	if err != nil {
+		return []Zone{}, ResultInfo{}, err
+	}
+	return zones, r.ResultInfo, nil
+}
+
 // ZoneDetails fetches information about a zone.
 //
 // API reference: https://api.cloudflare.com/#zone-zone-details
 func (api *API) ZoneDetails(zoneID string) (Zone, error) {
-	res, err := api.makeRequest("GET", "/zones"+zoneID, nil)
-	if err != nil {
-		return Zone{}, errors.Wrap(err, errMakeRequestError)
-	}
-	var r ZoneResponse
-	err = json.Unmarshal(res, &r)
-	if err != nil {
-		return Zone{}, errors.Wrap(err, errUnmarshalError)
+	var result Zone
+	if _, err := api.makeRequestResult("GET", "/zones/"+zoneID, nil, &result); err != nil {
+		return Zone{}, err
 	}
-	return r.Result, nil
+	return result, nil
 }
 
-// ZoneOptions is a subset of Zone, for editable options.
+// ZoneOptions is a subset of Zone, for editable options. Nil or empty fields
+// are left unchanged.
 type ZoneOptions struct {
-	// FIXME(jamesog): Using omitempty here means we can't disable Paused.
-	// Currently unsure how to work around this.
-	Paused   bool      `json:"paused,omitempty"`
+	// Paused is a pointer so that a zone can be unpaused as well as paused.
+	Paused   *bool     `json:"paused,omitempty"`
 	VanityNS []string  `json:"vanity_name_servers,omitempty"`
 	Plan     *ZonePlan `json:"plan,omitempty"`
 }
@@ -311,7 +412,7 @@ type ZoneOptions struct {
 // ZoneSetPaused pauses CloudFlare service for the entire zone, sending all
 // traffic direct to the origin.
 func (api *API) ZoneSetPaused(zoneID string, paused bool) (Zone, error) {
-	zoneopts := ZoneOptions{Paused: paused}
+	zoneopts := ZoneOptions{Paused: &paused}
 	zone, err := api.EditZone(zoneID, zoneopts)
 	if err != nil {
 		return Zone{}, err
@@ -348,17 +449,11 @@ func (api *API) ZoneSetPlan(zoneID string, plan ZonePlan) (Zone, error) {
 //
 // API reference: https://api.cloudflare.com/#zone-edit-zone-properties
 func (api *API) EditZone(zoneID string, zoneOpts ZoneOptions) (Zone, error) {
-	res, err := api.makeRequest("PATCH", "/zones/"+zoneID, zoneOpts)
-	if err != nil {
-		return Zone{}, errors.Wrap(err, errMakeRequestError)
-	}
-	var r ZoneResponse
-	err = json.Unmarshal(res, &r)
-	if err != nil {
-		return Zone{}, errors.Wrap(err, errUnmarshalError)
+	var result Zone
+	if _, err := api.makeRequestResult("PATCH", "/zones/"+zoneID, zoneOpts, &result); err != nil {
+		return Zone{}, err
 	}
-
-	return r.Result, nil
+	return result, nil
 }
 
 // PurgeEverything purges the cache for the given zone.
@@ -368,16 +463,11 @@ func (api *API) EditZone(zoneID string, zoneOpts ZoneOptions) (Zone, error) {
 // API reference: https://api.cloudflare.com/#zone-purge-all-files
 func (api *API) PurgeEverything(zoneID string) (PurgeCacheResponse, error) {
 	uri := "/zones/" + zoneID + "/purge_cache"
-	res, err := api.makeRequest("DELETE", uri, PurgeCacheRequest{true, nil, nil})
+	r, err := api.makeRequestResult("DELETE", uri, PurgeCacheRequest{true, nil, nil}, nil)
 	if err != nil {
-		return PurgeCacheResponse{}, errors.Wrap(err, errMakeRequestError)
+		return PurgeCacheResponse{}, err
 	}
-	var r PurgeCacheResponse
-	err = json.Unmarshal(res, &r)
-	if err != nil {
-		return PurgeCacheResponse{}, errors.Wrap(err, errUnmarshalError)
-	}
-	return r, nil
+	return PurgeCacheResponse{Response: r.Response}, nil
 }
 
 // PurgeCache purges the cache using the given PurgeCacheRequest (zone/url/tag).
@@ -385,32 +475,23 @@ func (api *API) PurgeEverything(zoneID string) (PurgeCacheResponse, error) {
 // API reference: https://api.cloudflare.com/#zone-purge-individual-files-by-url-and-cache-tags
 func (api *API) PurgeCache(zoneID string, pcr PurgeCacheRequest) (PurgeCacheResponse, error) {
 	uri := "/zones/" + zoneID + "/purge_cache"
-	res, err := api.makeRequest("DELETE", uri, pcr)
-	if err != nil {
-		return PurgeCacheResponse{}, errors.Wrap(err, errMakeRequestError)
-	}
-	var r PurgeCacheResponse
-	err = json.Unmarshal(res, &r)
+	r, err := api.makeRequestResult("DELETE", uri, pcr, nil)
 	if err != nil {
-		return PurgeCacheResponse{}, errors.Wrap(err, errUnmarshalError)
+		return PurgeCacheResponse{}, err
 	}
-	return r, nil
+	return PurgeCacheResponse{Response: r.Response}, nil
 }
 
 // DeleteZone deletes the given zone.
 //
 // API reference: https://api.cloudflare.com/#zone-delete-a-zone
 func (api *API) DeleteZone(zoneID string) (ZoneID, error) {
-	res, err := api.makeRequest("DELETE", "/zones"+zoneID, nil)
-	if err != nil {
-		return ZoneID{}, errors.Wrap(err, errMakeRequestError)
-	}
-	var r ZoneIDResponse
-	err = json.Unmarshal(res, &r)
-	if err != nil {
-		return ZoneID{}, errors.Wrap(err, errUnmarshalError)
+	var result ZoneID
+	if _, err := api.makeRequestResult("DELETE", "/zones/"+zoneID, nil, &result); err != nil {
+		return ZoneID{}, err
 	}
-	return r.Result, nil
+	api.forgetZoneID(zoneID)
+	return result, nil
 }
 
 // AvailableZonePlans returns information about all plans available to the specified zone.
@@ -418,16 +499,11 @@ func (api *API) DeleteZone(zoneID string) (ZoneID, error) {
 // API reference: https://api.cloudflare.com/#zone-plan-available-plans
 func (api *API) AvailableZonePlans(zoneID string) ([]ZonePlan, error) {
 	uri := "/zones/" + zoneID + "/available_plans"
-	res, err := api.makeRequest("GET", uri, nil)
-	if err != nil {
-		return []ZonePlan{}, errors.Wrap(err, errMakeRequestError)
+	var result []ZonePlan
+	if _, err := api.makeRequestResult("GET", uri, nil, &result); err != nil {
+		return []ZonePlan{}, err
 	}
-	var r AvailableZonePlansResponse
-	err = json.Unmarshal(res, &r)
-	if err != nil {
-		return []ZonePlan{}, errors.Wrap(err, errUnmarshalError)
-	}
-	return r.Result, nil
+	return result, nil
 }
 
 // ZonePlanDetails returns information about a zone plan.
@@ -435,16 +511,11 @@ func (api *API) AvailableZonePlans(zoneID string) ([]ZonePlan, error) {
 // API reference: https://api.cloudflare.com/#zone-plan-plan-details
 func (api *API) ZonePlanDetails(zoneID, planID string) (ZonePlan, error) {
 	uri := "/zones/" + zoneID + "/available_plans/" + planID
-	res, err := api.makeRequest("GET", uri, nil)
-	if err != nil {
-		return ZonePlan{}, errors.Wrap(err, errMakeRequestError)
+	var result ZonePlan
+	if _, err := api.makeRequestResult("GET", uri, nil, &result); err != nil {
+		return ZonePlan{}, err
 	}
-	var r ZonePlanResponse
-	err = json.Unmarshal(res, &r)
-	if err != nil {
-		return ZonePlan{}, errors.Wrap(err, errUnmarshalError)
-	}
-	return r.Result, nil
+	return result, nil
 }
 
 // encode encodes non-nil fields into URL encoded form.
@@ -469,14 +540,22 @@ func (o ZoneAnalyticsOptions) encode() string {
 //  GET /zones/:zone_identifier/analytics/dashboard
 func (api *API) ZoneAnalyticsDashboard(zoneID string, options ZoneAnalyticsOptions) (ZoneAnalyticsData, error) {
 	uri := "/zones/" + zoneID + "/analytics/dashboard" + "?" + options.encode()
-	res, err := api.makeRequest("GET", uri, nil)
-	if err != nil {
-		return ZoneAnalyticsData{}, errors.Wrap(err, errMakeRequestError)
+	var result ZoneAnalyticsData
+	if _, err := api.makeRequestResult("GET", uri, nil, &result); err != nil {
+		return ZoneAnalyticsData{}, err
 	}
-	var r zoneAnalyticsDataResponse
-	err = json.Unmarshal(res, &r)
+	return result, nil
+}
+
+// ZoneAnalyticsDashboardRaw is like ZoneAnalyticsDashboard, but returns the
+// undecoded result so that callers can decode only the parts they need.
+//
+// API reference: https://api.cloudflare.com/#zone-analytics-dashboard
+func (api *API) ZoneAnalyticsDashboardRaw(zoneID string, options ZoneAnalyticsOptions) (json.RawMessage, error) {
+	uri := "/zones/" + zoneID + "/analytics/dashboard" + "?" + options.encode()
+	r, err := api.makeRequestResult("GET", uri, nil, nil)
 	if err != nil {
-		return ZoneAnalyticsData{}, errors.Wrap(err, errUnmarshalError)
+		return nil, err
 	}
 	return r.Result, nil
 }
@@ -488,32 +567,45 @@ func (api *API) ZoneAnalyticsDashboard(zoneID string, options ZoneAnalyticsOptio
 //  GET /zones/:zone_identifier/analytics/colos
 func (api *API) ZoneAnalyticsByColocation(zoneID string, options ZoneAnalyticsOptions) ([]ZoneAnalyticsColocation, error) {
 	uri := "/zones/" + zoneID + "/analytics/colos" + "?" + options.encode()
-	res, err := api.makeRequest("GET", uri, nil)
-	if err != nil {
-		return nil, errors.Wrap(err, errMakeRequestError)
+	var result []ZoneAnalyticsColocation
+	if _, err := api.makeRequestResult("GET", uri, nil, &result); err != nil {
+		return nil, err
 	}
-	var r zoneAnalyticsColocationResponse
-	err = json.Unmarshal(res, &r)
+	return result, nil
+}
+
+// StreamZoneAnalyticsByColocation is like ZoneAnalyticsByColocation, but calls
+// fn with each datacenter's analytics as the response is decoded rather than
+// returning them all at once, so very large responses can be processed
+// incrementally. An error returned by fn stops decoding and is returned.
+//
+// API reference: https://api.cloudflare.com/#zone-analytics-analytics-by-co-locations
+func (api *API) StreamZoneAnalyticsByColocation(zoneID string, options ZoneAnalyticsOptions, fn func(ZoneAnalyticsColocation) error) error {
+	uri := "/zones/" + zoneID + "/analytics/colos" + "?" + options.encode()
+	body, err := api.makeRequestStream("GET", uri, nil, nil)
 	if err != nil {
-		return nil, errors.Wrap(err, errUnmarshalError)
+		return errors.Wrap(err, errMakeRequestError)
 	}
-	return r.Result, nil
+	defer body.Close()
+
+	return decodeResultItems(body, api.json(), nil, func(item json.RawMessage) error {
+		var colo ZoneAnalyticsColocation
+		if err := api.json().Unmarshal(item, &colo); err != nil {
+			return errors.Wrap(err, errUnmarshalError)
+		}
+		return fn(colo)
+	})
 }
 
 // Zone Settings
 // https://api.cloudflare.com/#zone-settings-for-a-zone-get-all-zone-settings
 func (api *API) GetZoneSettings(zoneID string) ([]ZoneSetting, error) {
 	uri := "/zones/" + zoneID + "/settings"
-	res, err := api.makeRequest("GET", uri, nil)
-	if err != nil {
-		return nil, errors.Wrap(err, errMakeRequestError)
+	var result []ZoneSetting
+	if _, err := api.makeRequestResult("GET", uri, nil, &result); err != nil {
+		return nil, err
 	}
-	var r ZoneSettingResponse
-	err = json.Unmarshal(res, &r)
-	if err != nil {
-		return nil, errors.Wrap(err, errUnmarshalError)
-	}
-	return r.Result, nil
+	return result, nil
 }
 
 // e.g.
@@ -522,14 +614,9 @@ func (api *API) GetZoneSettings(zoneID string) ([]ZoneSetting, error) {
 func (api *API) EditZoneSettings(zoneID string, settings []ZoneSetting) ([]ZoneSetting, error) {
 	uri := "/zones/" + zoneID + "/settings"
 	s := zoneSettingRequest{Items: settings}
-	res, err := api.makeRequest("PATCH", uri, s)
-	if err != nil {
-		return nil, errors.Wrap(err, errMakeRequestError)
+	var result []ZoneSetting
+	if _, err := api.makeRequestResult("PATCH", uri, s, &result); err != nil {
+		return nil, err
 	}
-	var r ZoneSettingResponse
-	err = json.Unmarshal(res, &r)
-	if err != nil {
-		return nil, errors.Wrap(err, errUnmarshalError)
-	}
-	return r.Result, nil
+	return result, nil
 }
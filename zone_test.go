@@ -1,6 +1,7 @@
 package cloudflare
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"testing"
@@ -329,6 +330,19 @@ func TestZoneAnalyticsDashboard(t *testing.T) {
 		assert.Equal(t, want, d)
 	}
 
+	raw, err := client.ZoneAnalyticsDashboardRaw("foo", ZoneAnalyticsOptions{
+		Since:      &since,
+		Until:      &until,
+		Continuous: &continuous,
+	})
+	if assert.NoError(t, err) {
+		var totals struct {
+			Totals ZoneAnalytics `json:"totals"`
+		}
+		assert.NoError(t, json.Unmarshal(raw, &totals))
+		assert.Equal(t, data, totals.Totals)
+	}
+
 	_, err = client.ZoneAnalyticsDashboard("bar", ZoneAnalyticsOptions{})
 	assert.Error(t, err)
 }
@@ -579,6 +593,168 @@ func TestZoneAnalyticsByColocation(t *testing.T) {
 		assert.Equal(t, want, d)
 	}
 
+	var colos []ZoneAnalyticsColocation
+	err = client.StreamZoneAnalyticsByColocation("foo", ZoneAnalyticsOptions{
+		Since:      &since,
+		Until:      &until,
+		Continuous: &continuous,
+	}, func(colo ZoneAnalyticsColocation) error {
+		colos = append(colos, colo)
+		return nil
+	})
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, colos)
+	}
+
 	_, err = client.ZoneAnalyticsDashboard("bar", ZoneAnalyticsOptions{})
 	assert.Error(t, err)
 }
+
+func TestZoneIDByName(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var lists int
+	mux.HandleFunc("/zones", func(w http.ResponseWriter, r *http.Request) {
+		lists++
+		assert.Equal(t, "example.com", r.URL.Query().Get("name"))
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": [{"id": "z1", "name": "example.com"}]}`)
+	})
+
+	for i := 0; i < 2; i++ {
+		id, err := client.ZoneIDByName("example.com")
+		assert.NoError(t, err)
+		assert.Equal(t, "z1", id)
+	}
+	assert.Equal(t, 1, lists)
+
+	client.ForgetZoneIDs("example.com")
+	_, err := client.ZoneIDByName("example.com")
+	assert.NoError(t, err)
+	assert.Equal(t, 2, lists)
+
+	// Expired entries are looked up again.
+	client.cache.zoneIDs["example.com"] = zoneIDEntry{id: "old", expires: time.Now().Add(-time.Second)}
+	id, err := client.ZoneIDByName("example.com")
+	assert.NoError(t, err)
+	assert.Equal(t, "z1", id)
+	assert.Equal(t, 3, lists)
+
+	assert.NoError(t, ZoneIDCacheTTL(0)(client))
+	client.ForgetZoneIDs()
+	_, err = client.ZoneIDByName("example.com")
+	assert.NoError(t, err)
+	_, err = client.ZoneIDByName("example.com")
+	assert.NoError(t, err)
+	assert.Equal(t, 5, lists)
+}
+
+func TestCreateZoneWithParams(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method, "Expected method 'POST', got %s", r.Method)
+		var body map[string]interface{}
+		if assert.NoError(t, json.NewDecoder(r.Body).Decode(&body)) {
+			assert.Equal(t, map[string]interface{}{
+				"name":       "example.com",
+				"jump_start": true,
+				"type":       "partial",
+				"account":    map[string]interface{}{"id": "acct"},
+			}, body)
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"id": "z1", "name": "example.com", "account": {"id": "acct", "name": "Example"}}}`)
+	})
+
+	assert.NoError(t, UsingAccount("acct")(client))
+	zone, err := client.CreateZoneWithParams(ZoneCreateParams{Name: "example.com", JumpStart: true, Type: "partial"})
+	if assert.NoError(t, err) {
+		assert.Equal(t, "z1", zone.ID)
+		assert.Equal(t, "Example", zone.Account.Name)
+	}
+}
+
+func TestListZonesWithParams(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		q := r.URL.Query()
+		assert.Equal(t, "active", q.Get("status"))
+		assert.Equal(t, "acct", q.Get("account.id"))
+		w.Header().Set("content-type", "application/json")
+		if q.Get("page") == "1" {
+			fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": [{"id": "z1"}],
+				"result_info": {"page": 1, "per_page": 1, "total_pages": 2, "count": 1, "total_count": 2}}`)
+			return
+		}
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": [{"id": "z2"}],
+			"result_info": {"page": 2, "per_page": 1, "total_pages": 2, "count": 1, "total_count": 2}}`)
+	})
+
+	zones, err := client.ListZonesWithParams(ZoneListParams{Status: "active", AccountID: "acct", PerPage: 1})
+	if assert.NoError(t, err) && assert.Len(t, zones, 2) {
+		assert.Equal(t, "z2", zones[1].ID)
+	}
+}
+
+func TestDeleteZone(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/z1", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "DELETE", r.Method, "Expected method 'DELETE', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"id": "z1"}}`)
+	})
+
+	id, err := client.DeleteZone("z1")
+	if assert.NoError(t, err) {
+		assert.Equal(t, "z1", id.ID)
+	}
+}
+
+func TestZoneSetPaused(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/z1", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PATCH", r.Method, "Expected method 'PATCH', got %s", r.Method)
+		var body map[string]interface{}
+		if assert.NoError(t, json.NewDecoder(r.Body).Decode(&body)) {
+			// Unpausing must send paused=false rather than leaving it out.
+			assert.Equal(t, map[string]interface{}{"paused": false}, body)
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"id": "z1", "paused": false}}`)
+	})
+
+	zone, err := client.ZoneSetPaused("z1", false)
+	if assert.NoError(t, err) {
+		assert.False(t, zone.Paused)
+	}
+}
+
+func TestZoneActivationCheck(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/z1/activation_check", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PUT", r.Method, "Expected method 'PUT', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"id": "z1"}}`)
+	})
+
+	resp, err := client.ZoneActivationCheck("z1")
+	if assert.NoError(t, err) {
+		assert.True(t, resp.Success)
+	}
+	resp, err = client.Zone("z1").ActivationCheck()
+	if assert.NoError(t, err) {
+		assert.True(t, resp.Success)
+	}
+}
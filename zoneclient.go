@@ -0,0 +1,154 @@
+package cloudflare
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// ZoneClient makes calls to the endpoints of one zone without the zone ID
+// being passed to every call, for tools which operate on one zone at a time.
+// Its methods are equivalent to those of API taking a zoneID argument. Create
+// one with API.Zone.
+type ZoneClient struct {
+	api *API
+	// ID is the zone's identifier.
+	ID string
+}
+
+// Zone returns a client for the endpoints of a zone.
+func (api *API) Zone(zoneID string) *ZoneClient {
+	return &ZoneClient{api: api, ID: zoneID}
+}
+
+// Raw makes a request to an endpoint of the zone which this package does not
+// yet support, as API.Raw does. path is relative to /zones/:zone_identifier,
+// e.g. "/rulesets".
+func (z *ZoneClient) Raw(ctx context.Context, method, path string, body interface{}, header http.Header) ([]byte, error) {
+	if !strings.HasPrefix(path, "/") {
+		return nil, errors.Errorf("path %q must start with /", path)
+	}
+	return z.api.Raw(ctx, method, "/zones/"+z.ID+path, body, header)
+}
+
+// Details returns the zone's details.
+func (z *ZoneClient) Details() (Zone, error) {
+	return z.api.ZoneDetails(z.ID)
+}
+
+// ActivationCheck asks Cloudflare to check the zone's name servers again, to
+// activate a pending zone sooner after it has been delegated.
+func (z *ZoneClient) ActivationCheck() (Response, error) {
+	return z.api.ZoneActivationCheck(z.ID)
+}
+
+// Settings returns the zone's settings.
+func (z *ZoneClient) Settings() ([]ZoneSetting, error) {
+	return z.api.GetZoneSettings(z.ID)
+}
+
+// EditSettings changes the zone's settings.
+func (z *ZoneClient) EditSettings(settings []ZoneSetting) ([]ZoneSetting, error) {
+	return z.api.EditZoneSettings(z.ID, settings)
+}
+
+// PurgeCache purges the zone's cache of the given files, tags or hosts.
+func (z *ZoneClient) PurgeCache(pcr PurgeCacheRequest) (PurgeCacheResponse, error) {
+	return z.api.PurgeCache(z.ID, pcr)
+}
+
+// PurgeEverything purges the zone's entire cache.
+func (z *ZoneClient) PurgeEverything() (PurgeCacheResponse, error) {
+	return z.api.PurgeEverything(z.ID)
+}
+
+// CustomPages lists the zone's custom error and challenge pages.
+func (z *ZoneClient) CustomPages() ([]CustomPage, error) {
+	return z.api.CustomPages(CustomPageOptions{ZoneID: z.ID})
+}
+
+// CustomPage returns one of the zone's custom pages, such as
+// CustomPage500Errors.
+func (z *ZoneClient) CustomPage(pageID string) (CustomPage, error) {
+	return z.api.CustomPage(CustomPageOptions{ZoneID: z.ID}, pageID)
+}
+
+// UpdateCustomPage sets the URL and state of one of the zone's custom pages.
+func (z *ZoneClient) UpdateCustomPage(pageID string, params CustomPageParameters) (CustomPage, error) {
+	return z.api.UpdateCustomPage(CustomPageOptions{ZoneID: z.ID}, pageID, params)
+}
+
+// PageRules lists the zone's page rules.
+func (z *ZoneClient) PageRules() ([]PageRule, error) {
+	return z.api.ListPageRules(z.ID)
+}
+
+// PageRule returns one of the zone's page rules.
+func (z *ZoneClient) PageRule(ruleID string) (PageRule, error) {
+	return z.api.PageRule(z.ID, ruleID)
+}
+
+// FindPageRuleByTarget returns the zone's page rule with the given URL
+// pattern. See API.FindPageRuleByTarget.
+func (z *ZoneClient) FindPageRuleByTarget(urlPattern string) (PageRule, error) {
+	return z.api.FindPageRuleByTarget(z.ID, urlPattern)
+}
+
+// AvailablePageRuleSettings returns the page rule actions which the zone's
+// plan supports.
+func (z *ZoneClient) AvailablePageRuleSettings() ([]PageRuleSetting, error) {
+	return z.api.ListAvailablePageRuleSettings(z.ID)
+}
+
+// CreatePageRule creates a page rule for the zone.
+func (z *ZoneClient) CreatePageRule(rule PageRule) (PageRule, error) {
+	return z.api.CreatePageRule(z.ID, rule)
+}
+
+// UpdatePageRule replaces one of the zone's page rules.
+func (z *ZoneClient) UpdatePageRule(ruleID string, rule PageRule) (PageRule, error) {
+	return z.api.UpdatePageRule(z.ID, ruleID, rule)
+}
+
+// SetPageRuleStatus pauses or resumes one of the zone's page rules.
+func (z *ZoneClient) SetPageRuleStatus(ruleID string, status PageRuleStatus) (PageRule, error) {
+	return z.api.SetPageRuleStatus(z.ID, ruleID, status)
+}
+
+// DeletePageRule deletes one of the zone's page rules.
+func (z *ZoneClient) DeletePageRule(ruleID string) error {
+	return z.api.DeletePageRule(z.ID, ruleID)
+}
+
+// ReorderPageRules orders the zone's page rules as listed by orderedIDs. See
+// API.ReorderPageRules.
+func (z *ZoneClient) ReorderPageRules(orderedIDs []string) error {
+	return z.api.ReorderPageRules(z.ID, orderedIDs)
+}
+
+// DNSRecords lists the zone's DNS records matching rr; see API.DNSRecords.
+func (z *ZoneClient) DNSRecords(rr DNSRecord) ([]DNSRecord, error) {
+	return z.api.DNSRecords(z.ID, rr)
+}
+
+// DNSRecord returns one of the zone's DNS records.
+func (z *ZoneClient) DNSRecord(recordID string) (DNSRecord, error) {
+	return z.api.DNSRecord(z.ID, recordID)
+}
+
+// CreateDNSRecord creates a DNS record in the zone.
+func (z *ZoneClient) CreateDNSRecord(rr DNSRecord) (*DNSRecordResponse, error) {
+	return z.api.CreateDNSRecord(z.ID, rr)
+}
+
+// UpdateDNSRecord updates one of the zone's DNS records.
+func (z *ZoneClient) UpdateDNSRecord(recordID string, rr DNSRecord) error {
+	return z.api.UpdateDNSRecord(z.ID, recordID, rr)
+}
+
+// DeleteDNSRecord deletes one of the zone's DNS records.
+func (z *ZoneClient) DeleteDNSRecord(recordID string) error {
+	return z.api.DeleteDNSRecord(z.ID, recordID)
+}
@@ -0,0 +1,46 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestZoneClient(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/z1/pagerules", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": [{"id": "r1", "status": "active"}]}`)
+	})
+	mux.HandleFunc("/zones/z1/purge_cache", func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		assert.JSONEq(t, `{"purge_everything": true}`, string(body))
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"id": "z1"}}`)
+	})
+	mux.HandleFunc("/zones/z1/rulesets", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": []}`)
+	})
+
+	zone := client.Zone("z1")
+	rules, err := zone.PageRules()
+	if assert.NoError(t, err) && assert.Len(t, rules, 1) {
+		assert.Equal(t, PageRuleStatusActive, rules[0].Status)
+	}
+
+	resp, err := zone.PurgeEverything()
+	if assert.NoError(t, err) {
+		assert.True(t, resp.Success)
+	}
+
+	_, err = zone.Raw(context.Background(), "GET", "/rulesets", nil, nil)
+	assert.NoError(t, err)
+}
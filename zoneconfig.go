@@ -0,0 +1,691 @@
+package cloudflare
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// ZoneConfig describes the desired state of a zone's configuration.
+//
+// A nil slice means that the corresponding resource is not managed and will be
+// left untouched. A non-nil slice (even an empty one) is authoritative:
+// resources which exist on the zone but are not listed are deleted. Zone
+// settings are never deleted; only the listed settings are changed.
+//
+// Firewall Rules are matched on their Ref or Description (see
+// SyncFirewallRules), IP Access Rules and User Agent Blocking rules on their
+// configuration, Zone Lockdown rules on their URLs, and Rate Limiting rules on
+// their description and the traffic they match.
+type ZoneConfig struct {
+	DNSRecords     []DNSRecord     `json:"dns_records"`
+	PageRules      []PageRule      `json:"page_rules"`
+	Settings       []ZoneSetting   `json:"settings"`
+	FirewallRules  []FirewallRule  `json:"firewall_rules"`
+	AccessRules    []AccessRule    `json:"access_rules"`
+	ZoneLockdowns  []ZoneLockdown  `json:"zone_lockdowns"`
+	UserAgentRules []UserAgentRule `json:"user_agent_rules"`
+	RateLimits     []ZoneRateLimit `json:"rate_limits"`
+}
+
+// Actions which may be taken by a ZoneConfigChange.
+const (
+	ZoneConfigCreate = "create"
+	ZoneConfigUpdate = "update"
+	ZoneConfigDelete = "delete"
+)
+
+// Resource types which may be modified by a ZoneConfigChange.
+const (
+	ZoneConfigDNSRecord     = "dns_record"
+	ZoneConfigPageRule      = "page_rule"
+	ZoneConfigSetting       = "setting"
+	ZoneConfigFirewallRule  = "firewall_rule"
+	ZoneConfigAccessRule    = "access_rule"
+	ZoneConfigZoneLockdown  = "zone_lockdown"
+	ZoneConfigUserAgentRule = "user_agent_rule"
+	ZoneConfigRateLimit     = "rate_limit"
+)
+
+// ZoneConfigChange is a single change required to bring a zone in line with a
+// ZoneConfig.
+//
+// ID is the identifier of the existing resource for updates and deletes. Value
+// holds the desired resource (a DNSRecord, PageRule, ZoneSetting,
+// FirewallRule, AccessRule, ZoneLockdown, UserAgentRule or ZoneRateLimit) for
+// creates and updates, and the existing resource for deletes.
+type ZoneConfigChange struct {
+	Action   string      `json:"action"`
+	Resource string      `json:"resource"`
+	ID       string      `json:"id,omitempty"`
+	Value    interface{} `json:"value"`
+}
+
+// UnmarshalJSON decodes a change, decoding Value into the type for its
+// Resource, so that a plan saved as JSON can be applied once loaded again.
+func (c *ZoneConfigChange) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		Action   string          `json:"action"`
+		Resource string          `json:"resource"`
+		ID       string          `json:"id"`
+		Value    json.RawMessage `json:"value"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	decode := func(v interface{}) error {
+		if len(raw.Value) == 0 {
+			return nil
+		}
+		return json.Unmarshal(raw.Value, v)
+	}
+
+	var value interface{}
+	var err error
+	switch raw.Resource {
+	case ZoneConfigDNSRecord:
+		var v DNSRecord
+		err = decode(&v)
+		value = v
+	case ZoneConfigPageRule:
+		var v PageRule
+		err = decode(&v)
+		value = v
+	case ZoneConfigSetting:
+		var v ZoneSetting
+		err = decode(&v)
+		value = v
+	case ZoneConfigFirewallRule:
+		var v FirewallRule
+		err = decode(&v)
+		value = v
+	case ZoneConfigAccessRule:
+		var v AccessRule
+		err = decode(&v)
+		value = v
+	case ZoneConfigZoneLockdown:
+		var v ZoneLockdown
+		err = decode(&v)
+		value = v
+	case ZoneConfigUserAgentRule:
+		var v UserAgentRule
+		err = decode(&v)
+		value = v
+	case ZoneConfigRateLimit:
+		var v ZoneRateLimit
+		err = decode(&v)
+		value = v
+	default:
+		return errors.Errorf("unknown resource type %q", raw.Resource)
+	}
+	if err != nil {
+		return errors.Wrapf(err, "could not decode %s", raw.Resource)
+	}
+
+	*c = ZoneConfigChange{Action: raw.Action, Resource: raw.Resource, ID: raw.ID, Value: value}
+	return nil
+}
+
+// valueError returns the error for a change whose Value does not have the type
+// of its Resource.
+func (c ZoneConfigChange) valueError() error {
+	return errors.Errorf("%s change has a value of type %T", c.Resource, c.Value)
+}
+
+// String returns a human-readable description of the change.
+func (c ZoneConfigChange) String() string {
+	var desc string
+	switch v := c.Value.(type) {
+	case DNSRecord:
+		desc = v.Type + " " + v.Name + " " + v.Content
+	case PageRule:
+		desc = pageRuleTargetValue(v)
+	case ZoneSetting:
+		desc = fmt.Sprintf("%s=%v", v.ID, v.Value)
+	case FirewallRule:
+		desc = strings.TrimPrefix(strings.TrimPrefix(firewallRuleKey(v), "ref:"), "description:")
+	case AccessRule:
+		desc = v.Configuration.Target + "=" + v.Configuration.Value + " " + v.Mode
+	case ZoneLockdown:
+		desc = strings.Join(v.URLs, ",")
+	case UserAgentRule:
+		desc = v.Configuration.Value + " " + v.Mode
+	case ZoneRateLimit:
+		desc = v.Match.Request.URLPattern + " " + v.Action.Mode
+	}
+	if c.ID != "" {
+		return fmt.Sprintf("%s %s %s (%s)", c.Action, c.Resource, desc, c.ID)
+	}
+	return fmt.Sprintf("%s %s %s", c.Action, c.Resource, desc)
+}
+
+// ZoneConfigPlan is the set of changes required to bring a zone in line with a
+// ZoneConfig, in the order in which they will be applied.
+type ZoneConfigPlan struct {
+	ZoneID  string             `json:"zone_id"`
+	Changes []ZoneConfigChange `json:"changes"`
+}
+
+// PlanZoneConfig compares the desired configuration against the live zone and
+// returns the changes required to reconcile them. No changes are made.
+func (api *API) PlanZoneConfig(zoneID string, config ZoneConfig) (ZoneConfigPlan, error) {
+	plan := ZoneConfigPlan{ZoneID: zoneID}
+
+	if config.DNSRecords != nil {
+		existing, err := api.DNSRecords(zoneID, DNSRecord{})
+		if err != nil {
+			return ZoneConfigPlan{}, errors.Wrap(err, "could not list DNS records")
+		}
+		plan.Changes = append(plan.Changes, diffDNSRecords(existing, config.DNSRecords)...)
+	}
+
+	if config.PageRules != nil {
+		existing, err := api.ListPageRules(zoneID)
+		if err != nil {
+			return ZoneConfigPlan{}, errors.Wrap(err, "could not list Page Rules")
+		}
+		plan.Changes = append(plan.Changes, diffPageRules(existing, config.PageRules)...)
+	}
+
+	if config.Settings != nil {
+		existing, err := api.GetZoneSettings(zoneID)
+		if err != nil {
+			return ZoneConfigPlan{}, errors.Wrap(err, "could not get zone settings")
+		}
+		plan.Changes = append(plan.Changes, diffZoneSettings(existing, config.Settings)...)
+	}
+
+	if config.FirewallRules != nil {
+		existing, err := api.FirewallRules(zoneID)
+		if err != nil {
+			return ZoneConfigPlan{}, errors.Wrap(err, "could not list Firewall Rules")
+		}
+		changes, err := diffFirewallRules(existing, config.FirewallRules)
+		if err != nil {
+			return ZoneConfigPlan{}, err
+		}
+		plan.Changes = append(plan.Changes, changes...)
+	}
+
+	if config.AccessRules != nil {
+		existing, err := api.ZoneAccessRules(zoneID)
+		if err != nil {
+			return ZoneConfigPlan{}, errors.Wrap(err, "could not list IP Access Rules")
+		}
+		plan.Changes = append(plan.Changes, diffAccessRules(existing, config.AccessRules)...)
+	}
+
+	if config.ZoneLockdowns != nil {
+		existing, err := api.ZoneLockdowns(zoneID)
+		if err != nil {
+			return ZoneConfigPlan{}, errors.Wrap(err, "could not list Zone Lockdown rules")
+		}
+		plan.Changes = append(plan.Changes, diffZoneLockdowns(existing, config.ZoneLockdowns)...)
+	}
+
+	if config.UserAgentRules != nil {
+		existing, err := api.ListUserAgentRules(zoneID)
+		if err != nil {
+			return ZoneConfigPlan{}, errors.Wrap(err, "could not list User Agent Blocking rules")
+		}
+		plan.Changes = append(plan.Changes, diffUserAgentRules(existing, config.UserAgentRules)...)
+	}
+
+	if config.RateLimits != nil {
+		existing, err := api.ListAllRateLimits(zoneID)
+		if err != nil {
+			return ZoneConfigPlan{}, errors.Wrap(err, "could not list Rate Limiting rules")
+		}
+		plan.Changes = append(plan.Changes, diffRateLimits(existing, config.RateLimits)...)
+	}
+
+	return plan, nil
+}
+
+// ApplyZoneConfigPlan makes the changes in a plan created by PlanZoneConfig.
+// Changes are applied in order, stopping at the first failure. Consecutive
+// setting changes are made together in a single request.
+func (api *API) ApplyZoneConfigPlan(plan ZoneConfigPlan) error {
+	var settings []ZoneSetting
+	flush := func() error {
+		if len(settings) == 0 {
+			return nil
+		}
+		if _, err := api.EditZoneSettings(plan.ZoneID, settings); err != nil {
+			return errors.Wrap(err, "failed to update zone settings")
+		}
+		settings = nil
+		return nil
+	}
+
+	for _, c := range plan.Changes {
+		if c.Resource == ZoneConfigSetting {
+			setting, ok := c.Value.(ZoneSetting)
+			if !ok {
+				return c.valueError()
+			}
+			settings = append(settings, setting)
+			continue
+		}
+		if err := flush(); err != nil {
+			return err
+		}
+		if err := api.applyZoneConfigChange(plan.ZoneID, c); err != nil {
+			return errors.Wrap(err, "failed to "+c.String())
+		}
+	}
+
+	return flush()
+}
+
+// applyZoneConfigChange makes a single change to the given zone.
+func (api *API) applyZoneConfigChange(zoneID string, c ZoneConfigChange) error {
+	var err error
+	switch c.Resource {
+	case ZoneConfigDNSRecord:
+		rr, ok := c.Value.(DNSRecord)
+		if !ok {
+			return c.valueError()
+		}
+		switch c.Action {
+		case ZoneConfigCreate:
+			_, err = api.CreateDNSRecord(zoneID, rr)
+		case ZoneConfigUpdate:
+			err = api.UpdateDNSRecord(zoneID, c.ID, rr)
+		case ZoneConfigDelete:
+			err = api.DeleteDNSRecord(zoneID, c.ID)
+		}
+	case ZoneConfigPageRule:
+		rule, ok := c.Value.(PageRule)
+		if !ok {
+			return c.valueError()
+		}
+		switch c.Action {
+		case ZoneConfigCreate:
+			_, err = api.CreatePageRule(zoneID, rule)
+		case ZoneConfigUpdate:
+			_, err = api.UpdatePageRule(zoneID, c.ID, rule)
+		case ZoneConfigDelete:
+			err = api.DeletePageRule(zoneID, c.ID)
+		}
+	case ZoneConfigSetting:
+		setting, ok := c.Value.(ZoneSetting)
+		if !ok {
+			return c.valueError()
+		}
+		_, err = api.EditZoneSettings(zoneID, []ZoneSetting{setting})
+	case ZoneConfigFirewallRule:
+		rule, ok := c.Value.(FirewallRule)
+		if !ok {
+			return c.valueError()
+		}
+		switch c.Action {
+		case ZoneConfigCreate:
+			_, err = api.CreateFirewallRules(zoneID, []FirewallRule{rule})
+		case ZoneConfigUpdate:
+			if _, err = api.UpdateFilters(zoneID, []Filter{rule.Filter}); err == nil {
+				_, err = api.UpdateFirewallRules(zoneID, []FirewallRule{rule})
+			}
+		case ZoneConfigDelete:
+			if err = api.DeleteFirewallRules(zoneID, []string{c.ID}); err == nil {
+				err = api.DeleteFilters(zoneID, []string{rule.Filter.ID})
+			}
+		}
+	case ZoneConfigAccessRule:
+		rule, ok := c.Value.(AccessRule)
+		if !ok {
+			return c.valueError()
+		}
+		switch c.Action {
+		case ZoneConfigCreate:
+			_, err = api.CreateZoneAccessRule(zoneID, rule)
+		case ZoneConfigUpdate:
+			_, err = api.UpdateZoneAccessRule(zoneID, c.ID, rule)
+		case ZoneConfigDelete:
+			err = api.DeleteZoneAccessRule(zoneID, c.ID)
+		}
+	case ZoneConfigZoneLockdown:
+		rule, ok := c.Value.(ZoneLockdown)
+		if !ok {
+			return c.valueError()
+		}
+		switch c.Action {
+		case ZoneConfigCreate:
+			_, err = api.CreateZoneLockdown(zoneID, rule)
+		case ZoneConfigUpdate:
+			_, err = api.UpdateZoneLockdown(zoneID, c.ID, rule)
+		case ZoneConfigDelete:
+			err = api.DeleteZoneLockdown(zoneID, c.ID)
+		}
+	case ZoneConfigUserAgentRule:
+		rule, ok := c.Value.(UserAgentRule)
+		if !ok {
+			return c.valueError()
+		}
+		switch c.Action {
+		case ZoneConfigCreate:
+			_, err = api.CreateUserAgentRule(zoneID, rule)
+		case ZoneConfigUpdate:
+			_, err = api.UpdateUserAgentRule(zoneID, c.ID, rule)
+		case ZoneConfigDelete:
+			err = api.DeleteUserAgentRule(zoneID, c.ID)
+		}
+	case ZoneConfigRateLimit:
+		limit, ok := c.Value.(ZoneRateLimit)
+		if !ok {
+			return c.valueError()
+		}
+		switch c.Action {
+		case ZoneConfigCreate:
+			_, err = api.CreateRateLimit(zoneID, limit)
+		case ZoneConfigUpdate:
+			_, err = api.UpdateRateLimit(zoneID, c.ID, limit)
+		case ZoneConfigDelete:
+			err = api.DeleteRateLimit(zoneID, c.ID)
+		}
+	default:
+		err = errors.Errorf("unknown resource type %q", c.Resource)
+	}
+	return err
+}
+
+// ApplyZoneConfig reconciles the live zone against the desired configuration,
+// returning the plan which was applied.
+func (api *API) ApplyZoneConfig(zoneID string, config ZoneConfig) (ZoneConfigPlan, error) {
+	plan, err := api.PlanZoneConfig(zoneID, config)
+	if err != nil {
+		return ZoneConfigPlan{}, err
+	}
+	return plan, api.ApplyZoneConfigPlan(plan)
+}
+
+// diffDNSRecords returns the changes needed to turn the existing records into
+// the desired ones. Records are matched on type and name; where several
+// records share a type and name (e.g. round-robin A records), those with
+// identical content are paired first.
+func diffDNSRecords(existing, desired []DNSRecord) []ZoneConfigChange {
+	type key struct{ typ, name string }
+	current := make(map[key][]DNSRecord)
+	for _, rr := range existing {
+		k := key{rr.Type, rr.Name}
+		current[k] = append(current[k], rr)
+	}
+
+	var creates, updates, deletes []ZoneConfigChange
+	var unmatched []DNSRecord
+
+	// Pair records which have the same content first, so that adding a
+	// record to a set doesn't rewrite the existing ones.
+	for _, want := range desired {
+		k := key{want.Type, want.Name}
+		matched := false
+		for i, have := range current[k] {
+			if have.Content == want.Content {
+				if dnsRecordChanged(have, want) {
+					updates = append(updates, ZoneConfigChange{Action: ZoneConfigUpdate, Resource: ZoneConfigDNSRecord, ID: have.ID, Value: want})
+				}
+				current[k] = append(current[k][:i], current[k][i+1:]...)
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			unmatched = append(unmatched, want)
+		}
+	}
+
+	for _, want := range unmatched {
+		k := key{want.Type, want.Name}
+		if len(current[k]) > 0 {
+			have := current[k][0]
+			current[k] = current[k][1:]
+			updates = append(updates, ZoneConfigChange{Action: ZoneConfigUpdate, Resource: ZoneConfigDNSRecord, ID: have.ID, Value: want})
+			continue
+		}
+		creates = append(creates, ZoneConfigChange{Action: ZoneConfigCreate, Resource: ZoneConfigDNSRecord, Value: want})
+	}
+
+	// Iterate over existing rather than the map to keep the order stable.
+	for _, have := range existing {
+		for _, rr := range current[key{have.Type, have.Name}] {
+			if rr.ID == have.ID {
+				deletes = append(deletes, ZoneConfigChange{Action: ZoneConfigDelete, Resource: ZoneConfigDNSRecord, ID: have.ID, Value: have})
+			}
+		}
+	}
+
+	// Deletes go first so that conflicting records (e.g. a CNAME replacing an
+	// A record) can be created.
+	return append(append(deletes, updates...), creates...)
+}
+
+// dnsRecordChanged reports whether the desired record differs from the
+// existing one. Zero values in the desired record are treated as unspecified.
+func dnsRecordChanged(have, want DNSRecord) bool {
+	if have.Content != want.Content || have.Proxied != want.Proxied {
+		return true
+	}
+	if want.TTL != 0 && have.TTL != want.TTL {
+		return true
+	}
+	if want.Priority != 0 && have.Priority != want.Priority {
+		return true
+	}
+	return false
+}
+
+// pageRuleTargetValue returns the URL pattern matched by a Page Rule, which is
+// used as its natural key.
+func pageRuleTargetValue(rule PageRule) string {
+	for _, t := range rule.Targets {
+		if t.Target == "url" {
+			return t.Constraint.Value
+		}
+	}
+	return ""
+}
+
+// diffPageRules returns the changes needed to turn the existing Page Rules
+// into the desired ones. Rules are matched on their target URL pattern.
+func diffPageRules(existing, desired []PageRule) []ZoneConfigChange {
+	current := make(map[string]PageRule)
+	for _, rule := range existing {
+		current[pageRuleTargetValue(rule)] = rule
+	}
+
+	var changes []ZoneConfigChange
+	seen := make(map[string]bool)
+	for _, want := range desired {
+		target := pageRuleTargetValue(want)
+		seen[target] = true
+		have, ok := current[target]
+		if !ok {
+			changes = append(changes, ZoneConfigChange{Action: ZoneConfigCreate, Resource: ZoneConfigPageRule, Value: want})
+			continue
+		}
+		if want.Priority == 0 {
+			want.Priority = have.Priority
+		}
+		if want.Status == "" {
+			want.Status = have.Status
+		}
+		if have.Priority != want.Priority || have.Status != want.Status || !samePageRuleActions(have.Actions, want.Actions) {
+			changes = append(changes, ZoneConfigChange{Action: ZoneConfigUpdate, Resource: ZoneConfigPageRule, ID: have.ID, Value: want})
+		}
+	}
+
+	for _, have := range existing {
+		if !seen[pageRuleTargetValue(have)] {
+			changes = append(changes, ZoneConfigChange{Action: ZoneConfigDelete, Resource: ZoneConfigPageRule, ID: have.ID, Value: have})
+		}
+	}
+
+	return changes
+}
+
+// samePageRuleActions reports whether two lists of Page Rule actions have the
+// same effect. Lists which decode to PageRuleSettings are compared as
+// settings, so that the order of their actions does not matter.
+func samePageRuleActions(a, b []PageRuleAction) bool {
+	sa, errA := PageRule{Actions: a}.Settings()
+	sb, errB := PageRule{Actions: b}.Settings()
+	if errA != nil || errB != nil {
+		return jsonEqual(a, b)
+	}
+	return reflect.DeepEqual(sa, sb)
+}
+
+// diffZoneLockdowns returns the changes needed to turn the existing Zone
+// Lockdown rules into the desired ones. Rules are matched on their URLs, in any
+// order.
+func diffZoneLockdowns(existing, desired []ZoneLockdown) []ZoneConfigChange {
+	key := func(rule ZoneLockdown) string {
+		urls := append([]string(nil), rule.URLs...)
+		sort.Strings(urls)
+		return strings.Join(urls, " ")
+	}
+	current := make(map[string]ZoneLockdown)
+	for _, rule := range existing {
+		current[key(rule)] = rule
+	}
+
+	var deletes, updates, creates []ZoneConfigChange
+	seen := make(map[string]bool)
+	for _, want := range desired {
+		k := key(want)
+		seen[k] = true
+		have, ok := current[k]
+		want.ID = ""
+		if !ok {
+			creates = append(creates, ZoneConfigChange{Action: ZoneConfigCreate, Resource: ZoneConfigZoneLockdown, Value: want})
+			continue
+		}
+		if want.Priority == 0 {
+			want.Priority = have.Priority
+		}
+		if want.Description != have.Description || want.Paused != have.Paused || want.Priority != have.Priority ||
+			!jsonEqual(want.Configurations, have.Configurations) {
+			want.ID = have.ID
+			updates = append(updates, ZoneConfigChange{Action: ZoneConfigUpdate, Resource: ZoneConfigZoneLockdown, ID: have.ID, Value: want})
+		}
+	}
+
+	for _, have := range existing {
+		if !seen[key(have)] || current[key(have)].ID != have.ID {
+			deletes = append(deletes, ZoneConfigChange{Action: ZoneConfigDelete, Resource: ZoneConfigZoneLockdown, ID: have.ID, Value: have})
+		}
+	}
+
+	return append(append(deletes, updates...), creates...)
+}
+
+// diffUserAgentRules returns the changes needed to turn the existing User
+// Agent Blocking rules into the desired ones. Rules are matched on their
+// configuration.
+func diffUserAgentRules(existing, desired []UserAgentRule) []ZoneConfigChange {
+	current := make(map[UserAgentRuleConfig]UserAgentRule)
+	for _, rule := range existing {
+		current[rule.Configuration] = rule
+	}
+
+	var deletes, updates, creates []ZoneConfigChange
+	seen := make(map[UserAgentRuleConfig]bool)
+	for _, want := range desired {
+		seen[want.Configuration] = true
+		have, ok := current[want.Configuration]
+		want.ID = ""
+		if !ok {
+			creates = append(creates, ZoneConfigChange{Action: ZoneConfigCreate, Resource: ZoneConfigUserAgentRule, Value: want})
+			continue
+		}
+		if want.Mode != have.Mode || want.Description != have.Description || want.Paused != have.Paused {
+			want.ID = have.ID
+			updates = append(updates, ZoneConfigChange{Action: ZoneConfigUpdate, Resource: ZoneConfigUserAgentRule, ID: have.ID, Value: want})
+		}
+	}
+
+	for _, have := range existing {
+		if !seen[have.Configuration] || current[have.Configuration].ID != have.ID {
+			deletes = append(deletes, ZoneConfigChange{Action: ZoneConfigDelete, Resource: ZoneConfigUserAgentRule, ID: have.ID, Value: have})
+		}
+	}
+
+	return append(append(deletes, updates...), creates...)
+}
+
+// diffRateLimits returns the changes needed to turn the existing Rate Limiting
+// rules into the desired ones. Rules are matched on their description and the
+// traffic they match.
+func diffRateLimits(existing, desired []ZoneRateLimit) []ZoneConfigChange {
+	key := func(limit ZoneRateLimit) string {
+		b, _ := json.Marshal(limit.Match)
+		return limit.Description + "\x00" + string(b)
+	}
+	current := make(map[string]ZoneRateLimit)
+	for _, limit := range existing {
+		current[key(limit)] = limit
+	}
+
+	var deletes, updates, creates []ZoneConfigChange
+	seen := make(map[string]bool)
+	for _, want := range desired {
+		k := key(want)
+		seen[k] = true
+		have, ok := current[k]
+		want.ID = ""
+		if !ok {
+			creates = append(creates, ZoneConfigChange{Action: ZoneConfigCreate, Resource: ZoneConfigRateLimit, Value: want})
+			continue
+		}
+		have.ID = ""
+		if !jsonEqual(want, have) {
+			want.ID = current[k].ID
+			updates = append(updates, ZoneConfigChange{Action: ZoneConfigUpdate, Resource: ZoneConfigRateLimit, ID: want.ID, Value: want})
+		}
+	}
+
+	for _, have := range existing {
+		if !seen[key(have)] || current[key(have)].ID != have.ID {
+			deletes = append(deletes, ZoneConfigChange{Action: ZoneConfigDelete, Resource: ZoneConfigRateLimit, ID: have.ID, Value: have})
+		}
+	}
+
+	return append(append(deletes, updates...), creates...)
+}
+
+// diffZoneSettings returns the changes needed to apply the desired settings.
+func diffZoneSettings(existing, desired []ZoneSetting) []ZoneConfigChange {
+	current := make(map[string]ZoneSetting)
+	for _, s := range existing {
+		current[s.ID] = s
+	}
+
+	var changes []ZoneConfigChange
+	for _, want := range desired {
+		if have, ok := current[want.ID]; ok && jsonEqual(have.Value, want.Value) {
+			continue
+		}
+		changes = append(changes, ZoneConfigChange{Action: ZoneConfigUpdate, Resource: ZoneConfigSetting, ID: want.ID, Value: ZoneSetting{ID: want.ID, Value: want.Value}})
+	}
+	return changes
+}
+
+// jsonEqual reports whether a and b have the same JSON representation. This
+// allows values decoded from the API (where numbers are float64 and objects are
+// maps) to be compared with values constructed by the caller.
+func jsonEqual(a, b interface{}) bool {
+	var x, y interface{}
+	ja, err := json.Marshal(a)
+	if err != nil || json.Unmarshal(ja, &x) != nil {
+		return false
+	}
+	jb, err := json.Marshal(b)
+	if err != nil || json.Unmarshal(jb, &y) != nil {
+		return false
+	}
+	return reflect.DeepEqual(x, y)
+}
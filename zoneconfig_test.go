@@ -0,0 +1,224 @@
+package cloudflare
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiffDNSRecords(t *testing.T) {
+	existing := []DNSRecord{
+		{ID: "1", Type: "A", Name: "www.example.com", Content: "192.0.2.1", TTL: 1},
+		{ID: "2", Type: "A", Name: "www.example.com", Content: "192.0.2.2", TTL: 1},
+		{ID: "3", Type: "TXT", Name: "example.com", Content: "v=spf1 -all", TTL: 1},
+		{ID: "4", Type: "A", Name: "old.example.com", Content: "192.0.2.9", TTL: 1},
+	}
+	desired := []DNSRecord{
+		{Type: "A", Name: "www.example.com", Content: "192.0.2.2"},
+		{Type: "A", Name: "www.example.com", Content: "192.0.2.3"},
+		{Type: "TXT", Name: "example.com", Content: "v=spf1 -all", TTL: 120},
+		{Type: "MX", Name: "example.com", Content: "mx.example.com", Priority: 10},
+	}
+
+	want := []ZoneConfigChange{
+		{Action: ZoneConfigDelete, Resource: ZoneConfigDNSRecord, ID: "4", Value: existing[3]},
+		{Action: ZoneConfigUpdate, Resource: ZoneConfigDNSRecord, ID: "3", Value: desired[2]},
+		{Action: ZoneConfigUpdate, Resource: ZoneConfigDNSRecord, ID: "1", Value: desired[1]},
+		{Action: ZoneConfigCreate, Resource: ZoneConfigDNSRecord, Value: desired[3]},
+	}
+
+	assert.Equal(t, want, diffDNSRecords(existing, desired))
+}
+
+func TestDiffPageRules(t *testing.T) {
+	rule := func(id, pattern string, status PageRuleStatus, priority int, value interface{}) PageRule {
+		r := PageRule{
+			ID:       id,
+			Actions:  []PageRuleAction{{ID: "browser_cache_ttl", Value: value}},
+			Priority: FlexibleInt(priority),
+			Status:   status,
+		}
+		var t PageRuleTarget
+		t.Target = "url"
+		t.Constraint.Operator = "matches"
+		t.Constraint.Value = pattern
+		r.Targets = []PageRuleTarget{t}
+		return r
+	}
+
+	existing := []PageRule{
+		rule("1", "example.com/static/*", "active", 1, float64(3600)),
+		rule("2", "example.com/old/*", "active", 2, float64(3600)),
+		rule("3", "example.com/api/*", "active", 3, float64(3600)),
+	}
+	desired := []PageRule{
+		rule("", "example.com/static/*", "", 0, 3600),
+		rule("", "example.com/api/*", "paused", 0, 3600),
+		rule("", "example.com/new/*", "active", 0, 7200),
+	}
+
+	changes := diffPageRules(existing, desired)
+	if assert.Len(t, changes, 3) {
+		assert.Equal(t, ZoneConfigUpdate, changes[0].Action)
+		assert.Equal(t, "3", changes[0].ID)
+		assert.Equal(t, FlexibleInt(3), changes[0].Value.(PageRule).Priority)
+		assert.Equal(t, ZoneConfigCreate, changes[1].Action)
+		assert.Equal(t, ZoneConfigDelete, changes[2].Action)
+		assert.Equal(t, "2", changes[2].ID)
+	}
+}
+
+func TestApplyZoneConfig(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/foo/dns_records", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		switch r.Method {
+		case "GET":
+			fmt.Fprint(w, `{
+				"success": true,
+				"errors": [],
+				"messages": [],
+				"result": [
+					{"id": "1", "type": "A", "name": "www.example.com", "content": "192.0.2.1", "ttl": 1}
+				]
+			}`)
+		case "POST":
+			var rr DNSRecord
+			if assert.NoError(t, json.NewDecoder(r.Body).Decode(&rr)) {
+				assert.Equal(t, "CNAME", rr.Type)
+				assert.Equal(t, "blog.example.com", rr.Name)
+				assert.Equal(t, "example.com", rr.Content)
+			}
+			fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"id": "2"}}`)
+		default:
+			t.Errorf("unexpected method %s", r.Method)
+		}
+	})
+	mux.HandleFunc("/zones/foo/settings", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		switch r.Method {
+		case "GET":
+			fmt.Fprint(w, `{
+				"success": true,
+				"errors": [],
+				"messages": [],
+				"result": [
+					{"id": "always_online", "value": "on", "editable": true},
+					{"id": "ssl", "value": "flexible", "editable": true}
+				]
+			}`)
+		case "PATCH":
+			b, err := ioutil.ReadAll(r.Body)
+			if assert.NoError(t, err) {
+				assert.Contains(t, string(b), `"id":"ssl"`)
+				assert.NotContains(t, string(b), `"id":"always_online"`)
+			}
+			fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": []}`)
+		default:
+			t.Errorf("unexpected method %s", r.Method)
+		}
+	})
+
+	plan, err := client.ApplyZoneConfig("foo", ZoneConfig{
+		DNSRecords: []DNSRecord{
+			{Type: "A", Name: "www.example.com", Content: "192.0.2.1"},
+			{Type: "CNAME", Name: "blog.example.com", Content: "example.com"},
+		},
+		Settings: []ZoneSetting{
+			{ID: "always_online", Value: "on"},
+			{ID: "ssl", Value: "full"},
+		},
+	})
+	if assert.NoError(t, err) {
+		assert.Equal(t, []string{
+			"create dns_record CNAME blog.example.com example.com",
+			"update setting ssl=full (ssl)",
+		}, []string{plan.Changes[0].String(), plan.Changes[1].String()})
+	}
+}
+
+func TestApplyZoneConfigPlanOrder(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var calls []string
+	mux.HandleFunc("/zones/foo/dns_records", func(w http.ResponseWriter, r *http.Request) {
+		calls = append(calls, r.Method+" dns_records")
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"id": "2"}}`)
+	})
+	mux.HandleFunc("/zones/foo/settings", func(w http.ResponseWriter, r *http.Request) {
+		var body struct{ Items []ZoneSetting }
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		call := r.Method + " settings"
+		for _, s := range body.Items {
+			call += " " + s.ID
+		}
+		calls = append(calls, call)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": []}`)
+	})
+
+	err := client.ApplyZoneConfigPlan(ZoneConfigPlan{ZoneID: "foo", Changes: []ZoneConfigChange{
+		{Action: ZoneConfigUpdate, Resource: ZoneConfigSetting, ID: "ssl", Value: ZoneSetting{ID: "ssl", Value: "full"}},
+		{Action: ZoneConfigUpdate, Resource: ZoneConfigSetting, ID: "always_use_https", Value: ZoneSetting{ID: "always_use_https", Value: "on"}},
+		{Action: ZoneConfigCreate, Resource: ZoneConfigDNSRecord, Value: DNSRecord{Type: "A", Name: "www.example.com", Content: "192.0.2.1"}},
+		{Action: ZoneConfigUpdate, Resource: ZoneConfigSetting, ID: "always_online", Value: ZoneSetting{ID: "always_online", Value: "on"}},
+	}})
+	if assert.NoError(t, err) {
+		assert.Equal(t, []string{
+			"PATCH settings ssl always_use_https",
+			"POST dns_records",
+			"PATCH settings always_online",
+		}, calls)
+	}
+}
+
+func TestZoneConfigPlanJSON(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var calls []string
+	mux.HandleFunc("/zones/foo/dns_records", func(w http.ResponseWriter, r *http.Request) {
+		var rr DNSRecord
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&rr))
+		calls = append(calls, r.Method+" dns_records "+rr.Name)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"id": "2"}}`)
+	})
+	mux.HandleFunc("/zones/foo/settings", func(w http.ResponseWriter, r *http.Request) {
+		calls = append(calls, r.Method+" settings")
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": []}`)
+	})
+
+	plan := ZoneConfigPlan{ZoneID: "foo", Changes: []ZoneConfigChange{
+		{Action: ZoneConfigCreate, Resource: ZoneConfigDNSRecord, Value: DNSRecord{Type: "A", Name: "www.example.com", Content: "192.0.2.1"}},
+		{Action: ZoneConfigUpdate, Resource: ZoneConfigSetting, ID: "ssl", Value: ZoneSetting{ID: "ssl", Value: "full"}},
+	}}
+	b, err := json.Marshal(plan)
+	assert.NoError(t, err)
+
+	var loaded ZoneConfigPlan
+	if assert.NoError(t, json.Unmarshal(b, &loaded)) {
+		assert.Equal(t, plan, loaded)
+		assert.NoError(t, client.ApplyZoneConfigPlan(loaded))
+		assert.Equal(t, []string{"POST dns_records www.example.com", "PATCH settings"}, calls)
+	}
+
+	assert.Error(t, json.Unmarshal([]byte(`{"action": "create", "resource": "zone", "value": {}}`), &ZoneConfigChange{}))
+
+	// A change whose value has the wrong type is reported, not a panic.
+	err = client.ApplyZoneConfigPlan(ZoneConfigPlan{ZoneID: "foo", Changes: []ZoneConfigChange{
+		{Action: ZoneConfigCreate, Resource: ZoneConfigDNSRecord, Value: map[string]interface{}{"type": "A"}},
+	}})
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "dns_record change has a value of type map[string]interface {}")
+	}
+}
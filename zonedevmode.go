@@ -0,0 +1,56 @@
+package cloudflare
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// ZoneDevelopmentMode is the state of a zone's development mode, which
+// bypasses the cache so that changes to the origin are seen immediately. It
+// turns itself off after three hours; TimeRemaining is the time until then,
+// or zero if development mode is off.
+type ZoneDevelopmentMode struct {
+	On            bool
+	TimeRemaining time.Duration
+	ModifiedOn    string
+}
+
+// ZoneDevelopmentMode returns the state of a zone's development mode.
+//
+// API reference: https://api.cloudflare.com/#zone-settings-get-development-mode-setting
+func (api *API) ZoneDevelopmentMode(zoneID string) (ZoneDevelopmentMode, error) {
+	var result ZoneSetting
+	if _, err := api.makeRequestResult("GET", "/zones/"+zoneID+"/settings/development_mode", nil, &result); err != nil {
+		return ZoneDevelopmentMode{}, err
+	}
+	return developmentMode(result)
+}
+
+// SetZoneDevelopmentMode turns a zone's development mode on or off, returning
+// its new state. Turning it on while it is already on restarts the three hour
+// period after which it turns itself off.
+//
+// API reference: https://api.cloudflare.com/#zone-settings-change-development-mode-setting
+func (api *API) SetZoneDevelopmentMode(zoneID string, on bool) (ZoneDevelopmentMode, error) {
+	params := struct {
+		Value string `json:"value"`
+	}{onOff(on)}
+	var result ZoneSetting
+	if _, err := api.makeRequestResult("PATCH", "/zones/"+zoneID+"/settings/development_mode", params, &result); err != nil {
+		return ZoneDevelopmentMode{}, err
+	}
+	return developmentMode(result)
+}
+
+// developmentMode decodes the development_mode zone setting.
+func developmentMode(s ZoneSetting) (ZoneDevelopmentMode, error) {
+	if s.Value != "on" && s.Value != "off" {
+		return ZoneDevelopmentMode{}, errors.Errorf(`development mode must be "on" or "off", not %v`, s.Value)
+	}
+	return ZoneDevelopmentMode{
+		On:            s.Value == "on",
+		TimeRemaining: time.Duration(s.TimeRemaining) * time.Second,
+		ModifiedOn:    s.ModifiedOn,
+	}, nil
+}
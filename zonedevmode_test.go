@@ -0,0 +1,49 @@
+package cloudflare
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestZoneDevelopmentMode(t *testing.T) {
+	setup()
+	defer teardown()
+
+	value, remaining := "off", 0
+	mux.HandleFunc("/zones/z1/settings/development_mode", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "PATCH":
+			body, _ := ioutil.ReadAll(r.Body)
+			assert.Equal(t, `{"value":"on"}`, strings.TrimSpace(string(body)))
+			value, remaining = "on", 10800
+		default:
+			assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {"id": "development_mode", "value": %q, "editable": true, "modified_on": "2014-01-01T05:20:00.12345Z", "time_remaining": %d}
+		}`, value, remaining)
+	})
+
+	mode, err := client.ZoneDevelopmentMode("z1")
+	if assert.NoError(t, err) {
+		assert.False(t, mode.On)
+		assert.Zero(t, mode.TimeRemaining)
+	}
+
+	mode, err = client.SetZoneDevelopmentMode("z1", true)
+	if assert.NoError(t, err) {
+		assert.True(t, mode.On)
+		assert.Equal(t, 3*time.Hour, mode.TimeRemaining)
+		assert.Equal(t, "2014-01-01T05:20:00.12345Z", mode.ModifiedOn)
+	}
+}
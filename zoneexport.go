@@ -0,0 +1,305 @@
+package cloudflare
+
+import (
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// ZoneExportVersion is the version of the document format produced by
+// ExportZone. It is incremented whenever the format changes incompatibly.
+const ZoneExportVersion = 1
+
+// ZoneExport is a versioned snapshot of a zone's configuration, suitable for
+// backups. The embedded ZoneConfig can be passed directly to ApplyZoneConfig.
+type ZoneExport struct {
+	Version    int       `json:"version"`
+	ExportedOn time.Time `json:"exported_on"`
+	ZoneID     string    `json:"zone_id"`
+	ZoneName   string    `json:"zone_name"`
+	ZoneConfig
+}
+
+// ExportZone gathers the configuration of a zone into a single document which
+// can be serialised to JSON.
+//
+// The export holds DNS records, Page Rules, zone settings, Firewall Rules, the
+// zone's own IP Access Rules, Zone Lockdown rules, User Agent Blocking rules
+// and Rate Limiting rules. Any other configuration is not exported, so a
+// backup made with ExportZone does not restore it.
+func (api *API) ExportZone(zoneID string) (ZoneExport, error) {
+	zone, err := api.ZoneDetails(zoneID)
+	if err != nil {
+		return ZoneExport{}, errors.Wrap(err, "could not get zone details")
+	}
+
+	records, err := api.DNSRecords(zoneID, DNSRecord{})
+	if err != nil {
+		return ZoneExport{}, errors.Wrap(err, "could not list DNS records")
+	}
+
+	rules, err := api.ListPageRules(zoneID)
+	if err != nil {
+		return ZoneExport{}, errors.Wrap(err, "could not list Page Rules")
+	}
+
+	settings, err := api.GetZoneSettings(zoneID)
+	if err != nil {
+		return ZoneExport{}, errors.Wrap(err, "could not get zone settings")
+	}
+
+	firewallRules, err := api.FirewallRules(zoneID)
+	if err != nil {
+		return ZoneExport{}, errors.Wrap(err, "could not list Firewall Rules")
+	}
+
+	accessRules, err := api.ZoneAccessRules(zoneID)
+	if err != nil {
+		return ZoneExport{}, errors.Wrap(err, "could not list IP Access Rules")
+	}
+	accessRules = zoneAccessRules(accessRules)
+
+	lockdowns, err := api.ZoneLockdowns(zoneID)
+	if err != nil {
+		return ZoneExport{}, errors.Wrap(err, "could not list Zone Lockdown rules")
+	}
+
+	uaRules, err := api.ListUserAgentRules(zoneID)
+	if err != nil {
+		return ZoneExport{}, errors.Wrap(err, "could not list User Agent Blocking rules")
+	}
+
+	rateLimits, err := api.ListAllRateLimits(zoneID)
+	if err != nil {
+		return ZoneExport{}, errors.Wrap(err, "could not list Rate Limiting rules")
+	}
+
+	// Make sure that empty resource lists are exported as such, rather than
+	// being omitted and later treated as unmanaged.
+	if records == nil {
+		records = []DNSRecord{}
+	}
+	if rules == nil {
+		rules = []PageRule{}
+	}
+	if firewallRules == nil {
+		firewallRules = []FirewallRule{}
+	}
+	if accessRules == nil {
+		accessRules = []AccessRule{}
+	}
+	if lockdowns == nil {
+		lockdowns = []ZoneLockdown{}
+	}
+	if uaRules == nil {
+		uaRules = []UserAgentRule{}
+	}
+	if rateLimits == nil {
+		rateLimits = []ZoneRateLimit{}
+	}
+
+	return ZoneExport{
+		Version:    ZoneExportVersion,
+		ExportedOn: time.Now().UTC(),
+		ZoneID:     zone.ID,
+		ZoneName:   zone.Name,
+		ZoneConfig: ZoneConfig{
+			DNSRecords:     records,
+			PageRules:      rules,
+			Settings:       settings,
+			FirewallRules:  firewallRules,
+			AccessRules:    accessRules,
+			ZoneLockdowns:  lockdowns,
+			UserAgentRules: uaRules,
+			RateLimits:     rateLimits,
+		},
+	}, nil
+}
+
+// ZoneImportResult is the outcome of a single change made by ImportZone.
+// Skipped holds the reason a change was not attempted, and Err holds the error
+// returned by the API if an attempted change failed.
+type ZoneImportResult struct {
+	Change  ZoneConfigChange
+	Skipped string
+	Err     error
+}
+
+// ImportZone replays a document created by ExportZone into the given zone,
+// which need not be the zone it was exported from.
+//
+// Identifiers from the source zone are discarded, and DNS record names, Page
+// Rule targets, Zone Lockdown URLs and Rate Limiting URL patterns are rewritten
+// from the source zone's name to the destination zone's name. As the export is
+// authoritative, resources in the destination zone which are not in the export
+// are deleted. Settings which the destination zone does not support or cannot
+// edit (e.g. because of its plan) are skipped rather than treated as failures.
+//
+// A failure to change one resource does not prevent the others from being
+// imported; the outcome of every change is reported in the returned results.
+// An error is only returned if the import could not be attempted at all.
+func (api *API) ImportZone(zoneID string, export ZoneExport) ([]ZoneImportResult, error) {
+	if export.Version > ZoneExportVersion {
+		return nil, errors.Errorf("unsupported zone export version %d", export.Version)
+	}
+
+	zone, err := api.ZoneDetails(zoneID)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get zone details")
+	}
+
+	var results []ZoneImportResult
+	config := ZoneConfig{}
+
+	if export.DNSRecords != nil {
+		config.DNSRecords = make([]DNSRecord, 0, len(export.DNSRecords))
+		for _, rr := range export.DNSRecords {
+			rr.ID = ""
+			rr.ZoneID = ""
+			rr.ZoneName = ""
+			rr.Name = rewriteZoneHost(rr.Name, export.ZoneName, zone.Name)
+			config.DNSRecords = append(config.DNSRecords, rr)
+		}
+	}
+
+	if export.PageRules != nil {
+		config.PageRules = make([]PageRule, 0, len(export.PageRules))
+		for _, rule := range export.PageRules {
+			rule.ID = ""
+			targets := make([]PageRuleTarget, len(rule.Targets))
+			for i, t := range rule.Targets {
+				t.Constraint.Value = rewriteZoneURLPattern(t.Constraint.Value, export.ZoneName, zone.Name)
+				targets[i] = t
+			}
+			rule.Targets = targets
+			config.PageRules = append(config.PageRules, rule)
+		}
+	}
+
+	if export.FirewallRules != nil {
+		config.FirewallRules = make([]FirewallRule, 0, len(export.FirewallRules))
+		for _, rule := range export.FirewallRules {
+			rule.ID = ""
+			rule.Filter.ID = ""
+			config.FirewallRules = append(config.FirewallRules, rule)
+		}
+	}
+
+	if export.AccessRules != nil {
+		config.AccessRules = make([]AccessRule, 0, len(export.AccessRules))
+		for _, rule := range export.AccessRules {
+			if rule.inherited() {
+				continue
+			}
+			rule.ID = ""
+			rule.Scope = nil
+			config.AccessRules = append(config.AccessRules, rule)
+		}
+	}
+
+	if export.ZoneLockdowns != nil {
+		config.ZoneLockdowns = make([]ZoneLockdown, 0, len(export.ZoneLockdowns))
+		for _, rule := range export.ZoneLockdowns {
+			rule.ID = ""
+			urls := make([]string, len(rule.URLs))
+			for i, u := range rule.URLs {
+				urls[i] = rewriteZoneURLPattern(u, export.ZoneName, zone.Name)
+			}
+			rule.URLs = urls
+			config.ZoneLockdowns = append(config.ZoneLockdowns, rule)
+		}
+	}
+
+	if export.UserAgentRules != nil {
+		config.UserAgentRules = make([]UserAgentRule, 0, len(export.UserAgentRules))
+		for _, rule := range export.UserAgentRules {
+			rule.ID = ""
+			config.UserAgentRules = append(config.UserAgentRules, rule)
+		}
+	}
+
+	if export.RateLimits != nil {
+		config.RateLimits = make([]ZoneRateLimit, 0, len(export.RateLimits))
+		for _, limit := range export.RateLimits {
+			limit.ID = ""
+			limit.Match.Request.URLPattern = rewriteZoneURLPattern(limit.Match.Request.URLPattern, export.ZoneName, zone.Name)
+			config.RateLimits = append(config.RateLimits, limit)
+		}
+	}
+
+	if export.Settings != nil {
+		existing, err := api.GetZoneSettings(zoneID)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not get zone settings")
+		}
+		editable := make(map[string]bool)
+		for _, s := range existing {
+			editable[s.ID] = s.Editable
+		}
+
+		config.Settings = []ZoneSetting{}
+		for _, s := range export.Settings {
+			change := ZoneConfigChange{Action: ZoneConfigUpdate, Resource: ZoneConfigSetting, ID: s.ID, Value: ZoneSetting{ID: s.ID, Value: s.Value}}
+			if e, ok := editable[s.ID]; !ok {
+				results = append(results, ZoneImportResult{Change: change, Skipped: "setting is not available on this zone"})
+			} else if !e {
+				results = append(results, ZoneImportResult{Change: change, Skipped: "setting is not editable on this zone"})
+			} else {
+				config.Settings = append(config.Settings, s)
+			}
+		}
+	}
+
+	plan, err := api.PlanZoneConfig(zoneID, config)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, c := range plan.Changes {
+		results = append(results, ZoneImportResult{Change: c, Err: api.applyZoneConfigChange(zoneID, c)})
+	}
+
+	return results, nil
+}
+
+// rewriteZoneHost rewrites a hostname within the zone from to the equivalent
+// hostname within the zone to. Hostnames outside of the zone are returned
+// unchanged.
+func rewriteZoneHost(host, from, to string) string {
+	if from == "" || from == to {
+		return host
+	}
+	if host == from {
+		return to
+	}
+	if strings.HasSuffix(host, "."+from) {
+		return strings.TrimSuffix(host, from) + to
+	}
+	return host
+}
+
+// rewriteZoneURLPattern rewrites the host portion of a Page Rule URL pattern
+// (e.g. "*.example.com/images/*") from the zone from to the zone to.
+func rewriteZoneURLPattern(pattern, from, to string) string {
+	var scheme string
+	if i := strings.Index(pattern, "://"); i >= 0 {
+		scheme, pattern = pattern[:i+3], pattern[i+3:]
+	}
+	host, path := pattern, ""
+	if i := strings.Index(pattern, "/"); i >= 0 {
+		host, path = pattern[:i], pattern[i:]
+	}
+	var port string
+	if i := strings.LastIndex(host, ":"); i >= 0 {
+		host, port = host[:i], host[i:]
+	}
+	// A leading wildcard (e.g. "*example.com") matches the apex too, so
+	// rewrite the remainder as a hostname.
+	if from != "" && host == "*"+from {
+		host = "*" + to
+	} else {
+		host = rewriteZoneHost(host, from, to)
+	}
+	return scheme + host + port + path
+}
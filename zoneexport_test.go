@@ -0,0 +1,280 @@
+package cloudflare
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExportZone(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/foo", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"id": "foo", "name": "example.com"}}`)
+	})
+	mux.HandleFunc("/zones/foo/dns_records", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": [{"id": "1", "type": "A", "name": "example.com", "content": "192.0.2.1", "ttl": 1}]
+		}`)
+	})
+	mux.HandleFunc("/zones/foo/pagerules", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": []}`)
+	})
+	mux.HandleFunc("/zones/foo/settings", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": [{"id": "ssl", "value": "full", "editable": true}]
+		}`)
+	})
+
+	mux.HandleFunc("/zones/foo/firewall/rules", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": []}`)
+	})
+	mux.HandleFunc("/zones/foo/firewall/access_rules/rules", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": [
+			{"id": "a1", "mode": "block", "configuration": {"target": "ip", "value": "192.0.2.1"}, "scope": {"id": "foo", "type": "zone"}},
+			{"id": "a2", "mode": "block", "configuration": {"target": "ip", "value": "192.0.2.2"}, "scope": {"id": "u1", "type": "user"}}
+		]}`)
+	})
+
+	mux.HandleFunc("/zones/foo/firewall/lockdowns", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": [
+			{"id": "l1", "urls": ["example.com/admin/*"], "configurations": [{"target": "ip", "value": "192.0.2.1"}]}
+		]}`)
+	})
+	mux.HandleFunc("/zones/foo/firewall/ua_rules", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": [
+			{"id": "u1", "mode": "block", "configuration": {"target": "ua", "value": "BadBot"}}
+		]}`)
+	})
+	mux.HandleFunc("/zones/foo/rate_limits", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": []}`)
+	})
+
+	export, err := client.ExportZone("foo")
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, ZoneExportVersion, export.Version)
+	assert.Equal(t, "example.com", export.ZoneName)
+	assert.Len(t, export.DNSRecords, 1)
+	// The rule inherited from the user is not the zone's to export.
+	if assert.Len(t, export.AccessRules, 1) {
+		assert.Equal(t, "a1", export.AccessRules[0].ID)
+	}
+	assert.Equal(t, []ZoneSetting{{ID: "ssl", Value: "full", Editable: true}}, export.Settings)
+	if assert.Len(t, export.ZoneLockdowns, 1) {
+		assert.Equal(t, []string{"example.com/admin/*"}, export.ZoneLockdowns[0].URLs)
+	}
+	if assert.Len(t, export.UserAgentRules, 1) {
+		assert.Equal(t, UserAgentRuleConfig{Target: "ua", Value: "BadBot"}, export.UserAgentRules[0].Configuration)
+	}
+	assert.NotNil(t, export.RateLimits)
+	assert.Empty(t, export.RateLimits)
+
+	// An empty list of Page Rules must survive a round trip through JSON so
+	// that it is still treated as managed.
+	b, err := json.Marshal(export)
+	if assert.NoError(t, err) {
+		var decoded ZoneExport
+		if assert.NoError(t, json.Unmarshal(b, &decoded)) {
+			assert.NotNil(t, decoded.PageRules)
+			assert.Empty(t, decoded.PageRules)
+			assert.NotNil(t, decoded.RateLimits)
+			assert.Empty(t, decoded.RateLimits)
+		}
+	}
+}
+
+func TestRewriteZoneURLPattern(t *testing.T) {
+	tests := []struct {
+		pattern, want string
+	}{
+		{"example.com/*", "example.org/*"},
+		{"*example.com/*", "*example.org/*"},
+		{"https://www.example.com:8443/a/*", "https://www.example.org:8443/a/*"},
+		{"*.example.com/*", "*.example.org/*"},
+		{"notexample.com/*", "notexample.com/*"},
+		{"*notexample.com/*", "*notexample.com/*"},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, rewriteZoneURLPattern(tt.pattern, "example.com", "example.org"), tt.pattern)
+	}
+}
+
+func TestImportZone(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/bar", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"id": "bar", "name": "example.org"}}`)
+	})
+	mux.HandleFunc("/zones/bar/dns_records", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		switch r.Method {
+		case "GET":
+			fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": []}`)
+		case "POST":
+			var rr DNSRecord
+			if assert.NoError(t, json.NewDecoder(r.Body).Decode(&rr)) {
+				assert.Equal(t, "www.example.org", rr.Name)
+				assert.Empty(t, rr.ID)
+			}
+			fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"id": "2"}}`)
+		}
+	})
+	mux.HandleFunc("/zones/bar/settings", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		switch r.Method {
+		case "GET":
+			fmt.Fprint(w, `{
+				"success": true,
+				"errors": [],
+				"messages": [],
+				"result": [
+					{"id": "ssl", "value": "flexible", "editable": true},
+					{"id": "polish", "value": "off", "editable": false}
+				]
+			}`)
+		case "PATCH":
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, `{"success": false, "errors": [{"code": 1007, "message": "Invalid value"}], "messages": [], "result": null}`)
+		}
+	})
+
+	export := ZoneExport{
+		Version:  ZoneExportVersion,
+		ZoneID:   "foo",
+		ZoneName: "example.com",
+		ZoneConfig: ZoneConfig{
+			DNSRecords: []DNSRecord{{ID: "1", Type: "A", Name: "www.example.com", Content: "192.0.2.1", ZoneID: "foo"}},
+			Settings: []ZoneSetting{
+				{ID: "ssl", Value: "strict"},
+				{ID: "polish", Value: "lossless"},
+				{ID: "mirage", Value: "on"},
+			},
+		},
+	}
+
+	results, err := client.ImportZone("bar", export)
+	if !assert.NoError(t, err) || !assert.Len(t, results, 4) {
+		return
+	}
+	assert.Equal(t, "polish", results[0].Change.ID)
+	assert.Equal(t, "setting is not editable on this zone", results[0].Skipped)
+	assert.Equal(t, "mirage", results[1].Change.ID)
+	assert.Equal(t, "setting is not available on this zone", results[1].Skipped)
+	assert.Equal(t, ZoneConfigDNSRecord, results[2].Change.Resource)
+	assert.NoError(t, results[2].Err)
+	assert.Equal(t, "ssl", results[3].Change.ID)
+	assert.Error(t, results[3].Err)
+}
+
+func TestImportZoneFirewall(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/bar", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"id": "bar", "name": "example.org"}}`)
+	})
+	mux.HandleFunc("/zones/bar/firewall/lockdowns", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		switch r.Method {
+		case "GET":
+			fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": []}`)
+		case "POST":
+			var rule ZoneLockdown
+			if assert.NoError(t, json.NewDecoder(r.Body).Decode(&rule)) {
+				assert.Empty(t, rule.ID)
+				assert.Equal(t, []string{"example.org/admin/*"}, rule.URLs)
+			}
+			fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"id": "l2"}}`)
+		}
+	})
+	mux.HandleFunc("/zones/bar/firewall/ua_rules", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		switch r.Method {
+		case "GET":
+			fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": [
+				{"id": "u9", "mode": "challenge", "configuration": {"target": "ua", "value": "Stale"}}
+			]}`)
+		}
+	})
+	mux.HandleFunc("/zones/bar/firewall/ua_rules/u9", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "DELETE", r.Method, "Expected method 'DELETE', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"id": "u9"}}`)
+	})
+	mux.HandleFunc("/zones/bar/rate_limits", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		switch r.Method {
+		case "GET":
+			fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": []}`)
+		case "POST":
+			var limit ZoneRateLimit
+			if assert.NoError(t, json.NewDecoder(r.Body).Decode(&limit)) {
+				assert.Empty(t, limit.ID)
+				assert.Equal(t, "*example.org/login", limit.Match.Request.URLPattern)
+			}
+			fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"id": "r2"}}`)
+		}
+	})
+
+	export := ZoneExport{
+		Version:  ZoneExportVersion,
+		ZoneID:   "foo",
+		ZoneName: "example.com",
+		ZoneConfig: ZoneConfig{
+			ZoneLockdowns: []ZoneLockdown{{
+				ID:             "l1",
+				URLs:           []string{"example.com/admin/*"},
+				Configurations: []ZoneLockdownConfig{{Target: "ip", Value: "192.0.2.1"}},
+			}},
+			UserAgentRules: []UserAgentRule{},
+			RateLimits: []ZoneRateLimit{{
+				ID:        "r1",
+				Match:     RateLimitTrafficMatcher{Request: RateLimitRequestMatcher{URLPattern: "*example.com/login"}},
+				Threshold: 10,
+				Period:    60,
+				Action:    RateLimitAction{Mode: "ban", Timeout: 60},
+			}},
+		},
+	}
+
+	results, err := client.ImportZone("bar", export)
+	if !assert.NoError(t, err) || !assert.Len(t, results, 3) {
+		return
+	}
+	for _, res := range results {
+		assert.NoError(t, res.Err, res.Change.String())
+	}
+}
@@ -0,0 +1,61 @@
+package cloudflare
+
+import (
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// ZoneHold is the hold on a zone, which prevents the zone (and optionally its
+// subdomains) from being added to any other Cloudflare account. HoldAfter is
+// set when the hold has been removed temporarily, and is the time at which it
+// takes effect again.
+type ZoneHold struct {
+	Hold              bool       `json:"hold"`
+	IncludeSubdomains bool       `json:"include_subdomains"`
+	HoldAfter         *time.Time `json:"hold_after,omitempty"`
+}
+
+// GetZoneHold returns the hold on a zone.
+//
+// API reference: https://developers.cloudflare.com/api/operations/zones-0-hold-get
+func (api *API) GetZoneHold(zoneID string) (ZoneHold, error) {
+	var result ZoneHold
+	if _, err := api.makeRequestResult("GET", "/zones/"+zoneID+"/hold", nil, &result); err != nil {
+		return ZoneHold{}, err
+	}
+	return result, nil
+}
+
+// CreateZoneHold puts a hold on a zone. If includeSubdomains is set, its
+// subdomains cannot be added to other accounts either.
+//
+// API reference: https://developers.cloudflare.com/api/operations/zones-0-hold-post
+func (api *API) CreateZoneHold(zoneID string, includeSubdomains bool) (ZoneHold, error) {
+	uri := "/zones/" + zoneID + "/hold"
+	if includeSubdomains {
+		uri += "?include_subdomains=" + strconv.FormatBool(includeSubdomains)
+	}
+	var result ZoneHold
+	if _, err := api.makeRequestResult("POST", uri, nil, &result); err != nil {
+		return ZoneHold{}, err
+	}
+	return result, nil
+}
+
+// DeleteZoneHold removes the hold on a zone. If holdAfter is not zero, the
+// hold is only removed until then, e.g. to allow a zone to be added to
+// another account during a migration window.
+//
+// API reference: https://developers.cloudflare.com/api/operations/zones-0-hold-delete
+func (api *API) DeleteZoneHold(zoneID string, holdAfter time.Time) (ZoneHold, error) {
+	uri := "/zones/" + zoneID + "/hold"
+	if !holdAfter.IsZero() {
+		uri += "?" + url.Values{"hold_after": {holdAfter.UTC().Format(time.RFC3339)}}.Encode()
+	}
+	var result ZoneHold
+	if _, err := api.makeRequestResult("DELETE", uri, nil, &result); err != nil {
+		return ZoneHold{}, err
+	}
+	return result, nil
+}
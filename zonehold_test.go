@@ -0,0 +1,48 @@
+package cloudflare
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestZoneHold(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/z1/hold", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		q := r.URL.Query()
+		switch r.Method {
+		case "GET":
+			fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"hold": false, "include_subdomains": false}}`)
+		case "POST":
+			assert.Equal(t, "true", q.Get("include_subdomains"))
+			fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"hold": true, "include_subdomains": true}}`)
+		case "DELETE":
+			assert.Equal(t, "2030-01-31T15:56:36Z", q.Get("hold_after"))
+			fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"hold": false, "hold_after": "2030-01-31T15:56:36Z"}}`)
+		}
+	})
+
+	hold, err := client.GetZoneHold("z1")
+	if assert.NoError(t, err) {
+		assert.False(t, hold.Hold)
+		assert.Nil(t, hold.HoldAfter)
+	}
+
+	hold, err = client.CreateZoneHold("z1", true)
+	if assert.NoError(t, err) {
+		assert.True(t, hold.Hold)
+		assert.True(t, hold.IncludeSubdomains)
+	}
+
+	after := time.Date(2030, 1, 31, 15, 56, 36, 0, time.UTC)
+	hold, err = client.DeleteZoneHold("z1", after)
+	if assert.NoError(t, err) && assert.NotNil(t, hold.HoldAfter) {
+		assert.True(t, after.Equal(*hold.HoldAfter))
+	}
+}
@@ -0,0 +1,100 @@
+package cloudflare
+
+import (
+	"reflect"
+
+	"github.com/pkg/errors"
+)
+
+// ZoneSettings is the typed form of commonly used zone settings, one field
+// per setting. Nil fields are unset: ZoneSettings leaves them nil if the zone
+// does not have the setting, and UpdateZoneSettings leaves them unchanged.
+// Settings which the API sets to "on" or "off" are booleans. Other settings
+// remain available through GetZoneSettings and EditZoneSettings.
+type ZoneSettings struct {
+	AlwaysOnline            *bool          `zonesetting:"always_online"`
+	AlwaysUseHTTPS          *bool          `zonesetting:"always_use_https"`
+	AutomaticHTTPSRewrites  *bool          `zonesetting:"automatic_https_rewrites"`
+	Brotli                  *bool          `zonesetting:"brotli"`
+	BrowserCacheTTL         *int           `zonesetting:"browser_cache_ttl"`
+	BrowserCheck            *bool          `zonesetting:"browser_check"`
+	CacheLevel              *CacheLevel    `zonesetting:"cache_level"`
+	DevelopmentMode         *bool          `zonesetting:"development_mode"`
+	EarlyHints              *bool          `zonesetting:"early_hints"`
+	EmailObfuscation        *bool          `zonesetting:"email_obfuscation"`
+	HTTP2                   *bool          `zonesetting:"http2"`
+	HTTP3                   *bool          `zonesetting:"http3"`
+	IPv6                    *bool          `zonesetting:"ipv6"`
+	MinTLSVersion           *string        `zonesetting:"min_tls_version"`
+	OpportunisticEncryption *bool          `zonesetting:"opportunistic_encryption"`
+	RocketLoader            *bool          `zonesetting:"rocket_loader"`
+	SecurityLevel           *SecurityLevel `zonesetting:"security_level"`
+	SSL                     *SSLMode       `zonesetting:"ssl"`
+	// TLS13 is "on", "off" or "zrt" (on, with 0-RTT).
+	TLS13      *string `zonesetting:"tls_1_3"`
+	WebSockets *bool   `zonesetting:"websockets"`
+	ZeroRTT    *bool   `zonesetting:"0rtt"`
+}
+
+// ZoneSettings returns the typed settings of a zone.
+//
+// API reference: https://api.cloudflare.com/#zone-settings-for-a-zone-get-all-zone-settings
+func (api *API) ZoneSettings(zoneID string) (ZoneSettings, error) {
+	settings, err := api.GetZoneSettings(zoneID)
+	if err != nil {
+		return ZoneSettings{}, err
+	}
+	return decodeZoneSettings(settings)
+}
+
+// UpdateZoneSettings changes the settings of a zone which are set in settings,
+// in a single request, returning the updated settings.
+//
+// API reference: https://api.cloudflare.com/#zone-settings-for-a-zone-edit-zone-settings-info
+func (api *API) UpdateZoneSettings(zoneID string, settings ZoneSettings) (ZoneSettings, error) {
+	items := settings.items()
+	if len(items) == 0 {
+		return ZoneSettings{}, &UserError{Err: errors.New("no zone settings to update")}
+	}
+	result, err := api.EditZoneSettings(zoneID, items)
+	if err != nil {
+		return ZoneSettings{}, err
+	}
+	return decodeZoneSettings(result)
+}
+
+// items encodes the settings which are set as ZoneSettings, in the order of
+// the fields of ZoneSettings.
+func (s ZoneSettings) items() []ZoneSetting {
+	var items []ZoneSetting
+	v := reflect.ValueOf(s)
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		if f := v.Field(i); !f.IsNil() {
+			items = append(items, ZoneSetting{ID: t.Field(i).Tag.Get("zonesetting"), Value: encodeSettingValue(f)})
+		}
+	}
+	return items
+}
+
+// decodeZoneSettings decodes the settings which ZoneSettings has fields for,
+// ignoring the others.
+func decodeZoneSettings(settings []ZoneSetting) (ZoneSettings, error) {
+	var s ZoneSettings
+	v := reflect.ValueOf(&s).Elem()
+	t := v.Type()
+	fields := make(map[string]int, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		fields[t.Field(i).Tag.Get("zonesetting")] = i
+	}
+	for _, setting := range settings {
+		i, ok := fields[setting.ID]
+		if !ok {
+			continue
+		}
+		if err := decodeSettingValue(v.Field(i), setting.Value); err != nil {
+			return ZoneSettings{}, errors.Wrapf(err, "zone setting %q", setting.ID)
+		}
+	}
+	return s, nil
+}
@@ -0,0 +1,74 @@
+package cloudflare
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestZoneSettings(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/z1/settings", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		switch r.Method {
+		case "GET":
+			fmt.Fprint(w, `{
+				"success": true,
+				"errors": [],
+				"messages": [],
+				"result": [
+					{"id": "always_use_https", "value": "on", "editable": true},
+					{"id": "browser_cache_ttl", "value": 14400, "editable": true},
+					{"id": "min_tls_version", "value": "1.2", "editable": true},
+					{"id": "ssl", "value": "full", "editable": true},
+					{"id": "polish", "value": "off", "editable": false}
+				]
+			}`)
+		case "PATCH":
+			var body struct {
+				Items []ZoneSetting `json:"items"`
+			}
+			if assert.NoError(t, json.NewDecoder(r.Body).Decode(&body)) {
+				assert.Equal(t, []ZoneSetting{
+					{ID: "development_mode", Value: "on"},
+					{ID: "security_level", Value: "high"},
+				}, body.Items)
+			}
+			fmt.Fprint(w, `{
+				"success": true,
+				"errors": [],
+				"messages": [],
+				"result": [
+					{"id": "development_mode", "value": "on", "editable": true, "time_remaining": 10800},
+					{"id": "security_level", "value": "high", "editable": true}
+				]
+			}`)
+		}
+	})
+
+	s, err := client.ZoneSettings("z1")
+	if assert.NoError(t, err) {
+		on, ttl, tls, ssl := true, 14400, "1.2", SSLModeFull
+		assert.Equal(t, ZoneSettings{
+			AlwaysUseHTTPS:  &on,
+			BrowserCacheTTL: &ttl,
+			MinTLSVersion:   &tls,
+			SSL:             &ssl,
+		}, s)
+	}
+
+	on, level := true, SecurityLevelHigh
+	s, err = client.UpdateZoneSettings("z1", ZoneSettings{DevelopmentMode: &on, SecurityLevel: &level})
+	if assert.NoError(t, err) && assert.NotNil(t, s.DevelopmentMode) && assert.NotNil(t, s.SecurityLevel) {
+		assert.True(t, *s.DevelopmentMode)
+		assert.Equal(t, SecurityLevelHigh, *s.SecurityLevel)
+	}
+
+	_, err = client.UpdateZoneSettings("z1", ZoneSettings{})
+	assert.IsType(t, &UserError{}, err)
+}